@@ -2,41 +2,1102 @@ package api
 
 import (
 	"context"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/middlewares"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/event_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/pvz_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/reception_service"
 	pvz_v1 "github.com/Dmitrii-Dmitrii/pvz/proto/generated/pvz/v1"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"time"
 )
 
 type GrpcHandler struct {
 	pvz_v1.UnimplementedPVZServiceServer
-	pvzService pvz_service.IPvzService
+	pvzService       pvz_service.IPvzService
+	receptionService reception_service.IReceptionService
+	productService   product_service.IProductService
+	eventService     event_service.IEventService
 }
 
-func NewGrpcHandler(pvzService pvz_service.IPvzService) *GrpcHandler {
-	return &GrpcHandler{pvzService: pvzService}
+func NewGrpcHandler(pvzService pvz_service.IPvzService, receptionService reception_service.IReceptionService, productService product_service.IProductService, eventService event_service.IEventService) *GrpcHandler {
+	return &GrpcHandler{
+		pvzService:       pvzService,
+		receptionService: receptionService,
+		productService:   productService,
+		eventService:     eventService,
+	}
 }
 
 func (h *GrpcHandler) GetPVZList(ctx context.Context, req *pvz_v1.GetPVZListRequest) (*pvz_v1.GetPVZListResponse, error) {
 	log.Info().Msg("GetPVZList started")
 
-	pvzList, err := h.pvzService.GetAllPvz(ctx)
+	var limit *int
+	if req.Limit != nil {
+		limitValue := int(req.GetLimit())
+		limit = &limitValue
+	}
+
+	pvzList, nextCursor, err := h.pvzService.GetPvzList(ctx, limit, req.Cursor)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		return nil, mapErrorToStatus(err)
+	}
+
 	if err != nil {
 		return &pvz_v1.GetPVZListResponse{}, err
 	}
 
 	var pvzs []*pvz_v1.PVZ
 	for _, pvz := range pvzList {
-		pvzs = append(pvzs, &pvz_v1.PVZ{
-			Id:               pvz.Id.String(),
-			RegistrationDate: timestamppb.New(pvz.RegistrationDate),
-			City:             string(pvz.City),
-		})
+		pvzs = append(pvzs, mapPvzModelToProto(pvz))
 	}
 
 	log.Info().Msgf("GetPVZList result: %v", pvzs)
 
 	return &pvz_v1.GetPVZListResponse{
-		Pvzs: pvzs,
+		Pvzs:       pvzs,
+		NextCursor: nextCursor,
 	}, nil
 }
+
+func (h *GrpcHandler) GetPVZFullInfo(ctx context.Context, req *pvz_v1.GetPVZFullInfoRequest) (*pvz_v1.GetPVZFullInfoResponse, error) {
+	log.Info().Msg("GetPVZFullInfo started")
+
+	var params generated.GetPvzParams
+	if req.StartDate != nil {
+		startDate := req.StartDate.AsTime()
+		params.StartDate = &startDate
+	}
+
+	if req.EndDate != nil {
+		endDate := req.EndDate.AsTime()
+		params.EndDate = &endDate
+	}
+
+	if req.Page != nil {
+		page := int(req.GetPage())
+		params.Page = &page
+	}
+
+	if req.Limit != nil {
+		limit := int(req.GetLimit())
+		params.Limit = &limit
+	}
+
+	if req.City != "" {
+		params.City = &req.City
+	}
+
+	if req.ReceptionStatus != nil {
+		receptionStatus := string(mapReceptionStatusFromProto(req.GetReceptionStatus()))
+		params.ReceptionStatus = &receptionStatus
+	}
+
+	if req.ProductType != "" {
+		params.ProductType = &req.ProductType
+	}
+
+	if req.MinProducts != nil {
+		minProducts := int(req.GetMinProducts())
+		params.MinProducts = &minProducts
+	}
+
+	if req.SortBy == pvz_v1.PVZSortBy_PVZ_SORT_BY_LAST_ACTIVITY {
+		sortBy := generated.LastActivity
+		params.SortBy = &sortBy
+	}
+
+	if req.Cursor != "" {
+		params.Cursor = &req.Cursor
+	}
+
+	pvzPage, err := h.pvzService.GetPvzFullInfo(ctx, params)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	pvzs := make([]*pvz_v1.PVZWithReceptions, 0, len(pvzPage.Pvzs))
+	for _, pvz := range pvzPage.Pvzs {
+		pvzs = append(pvzs, mapPvzWithReceptionsToProto(pvz))
+	}
+
+	log.Info().Msgf("GetPVZFullInfo result: %v", pvzs)
+
+	return &pvz_v1.GetPVZFullInfoResponse{
+		Pvzs:       pvzs,
+		Page:       int32(pvzPage.Page),
+		Limit:      int32(pvzPage.Limit),
+		Total:      int32(pvzPage.Total),
+		TotalPages: int32(pvzPage.TotalPages()),
+		NextCursor: pvzPage.NextCursor,
+	}, nil
+}
+
+func (h *GrpcHandler) CreatePVZ(ctx context.Context, req *pvz_v1.CreatePVZRequest) (*pvz_v1.CreatePVZResponse, error) {
+	log.Info().Msg("CreatePVZ started")
+
+	pvzReq := generated.PVZ{
+		City:         req.City,
+		Address:      req.Address,
+		WorkingHours: req.WorkingHours,
+		Capacity:     int32PtrToInt(req.Capacity),
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+	}
+	if req.Id != "" {
+		id, err := parseUuid(req.Id)
+		if err != nil {
+			return nil, err
+		}
+		pvzReq.Id = &id
+	}
+
+	if req.RegistrationDate != nil {
+		registrationDate := req.RegistrationDate.AsTime()
+		pvzReq.RegistrationDate = &registrationDate
+	}
+
+	pvzResp, err := h.pvzService.CreatePvz(ctx, pvzReq)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("CreatePVZ result: %v", pvzResp)
+
+	return &pvz_v1.CreatePVZResponse{Pvz: mapPvzToProto(*pvzResp)}, nil
+}
+
+func (h *GrpcHandler) UpdatePVZ(ctx context.Context, req *pvz_v1.UpdatePVZRequest) (*pvz_v1.UpdatePVZResponse, error) {
+	log.Info().Msg("UpdatePVZ started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzReq := generated.PutPvzPvzIdJSONRequestBody{
+		Address:      req.Address,
+		WorkingHours: req.WorkingHours,
+		Capacity:     int32PtrToInt(req.Capacity),
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+	}
+	pvzResp, err := h.pvzService.UpdatePvz(ctx, pvzId, pvzReq)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("UpdatePVZ result: %v", pvzResp)
+
+	return &pvz_v1.UpdatePVZResponse{Pvz: mapPvzToProto(*pvzResp)}, nil
+}
+
+func (h *GrpcHandler) DeactivatePVZ(ctx context.Context, req *pvz_v1.DeactivatePVZRequest) (*pvz_v1.DeactivatePVZResponse, error) {
+	log.Info().Msg("DeactivatePVZ started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzResp, err := h.pvzService.DeactivatePvz(ctx, pvzId)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("DeactivatePVZ result: %v", pvzResp)
+
+	return &pvz_v1.DeactivatePVZResponse{Pvz: mapPvzToProto(*pvzResp)}, nil
+}
+
+func (h *GrpcHandler) ActivatePVZ(ctx context.Context, req *pvz_v1.ActivatePVZRequest) (*pvz_v1.ActivatePVZResponse, error) {
+	log.Info().Msg("ActivatePVZ started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzResp, err := h.pvzService.ActivatePvz(ctx, pvzId)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("ActivatePVZ result: %v", pvzResp)
+
+	return &pvz_v1.ActivatePVZResponse{Pvz: mapPvzToProto(*pvzResp)}, nil
+}
+
+func (h *GrpcHandler) ArchivePVZ(ctx context.Context, req *pvz_v1.ArchivePVZRequest) (*pvz_v1.ArchivePVZResponse, error) {
+	log.Info().Msg("ArchivePVZ started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzResp, err := h.pvzService.ArchivePvz(ctx, pvzId)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("ArchivePVZ result: %v", pvzResp)
+
+	return &pvz_v1.ArchivePVZResponse{Pvz: mapPvzToProto(*pvzResp)}, nil
+}
+
+func (h *GrpcHandler) GetNearbyPVZ(ctx context.Context, req *pvz_v1.GetNearbyPVZRequest) (*pvz_v1.GetNearbyPVZResponse, error) {
+	log.Info().Msg("GetNearbyPVZ started")
+
+	pvzList, err := h.pvzService.GetNearbyPvz(ctx, req.Latitude, req.Longitude, req.Radius, int32PtrToInt(req.Limit))
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	pvzs := make([]*pvz_v1.NearbyPVZ, 0, len(pvzList))
+	for _, nearbyPvz := range pvzList {
+		pvzs = append(pvzs, &pvz_v1.NearbyPVZ{Pvz: mapPvzModelToProto(nearbyPvz.Pvz), Distance: nearbyPvz.Distance})
+	}
+
+	log.Info().Msgf("GetNearbyPVZ result: %d pvz", len(pvzs))
+
+	return &pvz_v1.GetNearbyPVZResponse{Pvzs: pvzs}, nil
+}
+
+func (h *GrpcHandler) GetPVZOccupancy(ctx context.Context, req *pvz_v1.GetPVZOccupancyRequest) (*pvz_v1.GetPVZOccupancyResponse, error) {
+	log.Info().Msg("GetPVZOccupancy started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	occupancyResp, err := h.pvzService.GetPvzOccupancy(ctx, pvzId)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("GetPVZOccupancy result: %v", occupancyResp)
+
+	return &pvz_v1.GetPVZOccupancyResponse{Occupancy: mapPvzOccupancyToProto(*occupancyResp)}, nil
+}
+
+func (h *GrpcHandler) UpdatePVZCapacity(ctx context.Context, req *pvz_v1.UpdatePVZCapacityRequest) (*pvz_v1.UpdatePVZCapacityResponse, error) {
+	log.Info().Msg("UpdatePVZCapacity started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	capacityReq := generated.PutPvzPvzIdCapacityJSONRequestBody{StorageHours: int(req.StorageHours)}
+	for _, typeCapacity := range req.ProductTypes {
+		capacityReq.ProductTypes = append(capacityReq.ProductTypes, generated.ProductTypeCapacity{
+			Type:     typeCapacity.Type,
+			Capacity: int(typeCapacity.Capacity),
+		})
+	}
+
+	occupancyResp, err := h.pvzService.UpdatePvzCapacity(ctx, pvzId, capacityReq)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("UpdatePVZCapacity result: %v", occupancyResp)
+
+	return &pvz_v1.UpdatePVZCapacityResponse{Occupancy: mapPvzOccupancyToProto(*occupancyResp)}, nil
+}
+
+func (h *GrpcHandler) CreateReception(ctx context.Context, req *pvz_v1.CreateReceptionRequest) (*pvz_v1.CreateReceptionResponse, error) {
+	log.Info().Msg("CreateReception started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	receptionResp, err := h.receptionService.CreateReception(ctx, pvzId)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("CreateReception result: %v", receptionResp)
+
+	return &pvz_v1.CreateReceptionResponse{Reception: mapReceptionToProto(*receptionResp)}, nil
+}
+
+func (h *GrpcHandler) CloseLastReception(ctx context.Context, req *pvz_v1.CloseLastReceptionRequest) (*pvz_v1.CloseLastReceptionResponse, error) {
+	log.Info().Msg("CloseLastReception started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	receptionResp, err := h.receptionService.CloseReception(ctx, pvzId)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("CloseLastReception result: %v", receptionResp)
+
+	return &pvz_v1.CloseLastReceptionResponse{Reception: mapReceptionToProto(*receptionResp)}, nil
+}
+
+func (h *GrpcHandler) GetReception(ctx context.Context, req *pvz_v1.GetReceptionRequest) (*pvz_v1.GetReceptionResponse, error) {
+	log.Info().Msg("GetReception started")
+
+	receptionId, err := parseUuid(req.ReceptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	receptionResp, err := h.receptionService.GetReception(ctx, receptionId)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("GetReception result: %d products", len(receptionResp.Products))
+
+	return &pvz_v1.GetReceptionResponse{Reception: mapReceptionWithProductsToProto(*receptionResp)}, nil
+}
+
+func (h *GrpcHandler) GetPVZReceptions(ctx context.Context, req *pvz_v1.GetPVZReceptionsRequest) (*pvz_v1.GetPVZReceptionsResponse, error) {
+	log.Info().Msg("GetPVZReceptions started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	var params generated.GetPvzPvzIdReceptionsParams
+	if req.Status != nil {
+		receptionStatus := string(mapReceptionStatusFromProto(req.GetStatus()))
+		params.Status = &receptionStatus
+	}
+
+	if req.StartDate != nil {
+		startDate := req.StartDate.AsTime()
+		params.StartDate = &startDate
+	}
+
+	if req.EndDate != nil {
+		endDate := req.EndDate.AsTime()
+		params.EndDate = &endDate
+	}
+
+	if req.Page != nil {
+		page := int(req.GetPage())
+		params.Page = &page
+	}
+
+	if req.Limit != nil {
+		limit := int(req.GetLimit())
+		params.Limit = &limit
+	}
+
+	receptionPage, err := h.receptionService.GetPvzReceptions(ctx, pvzId, params)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	receptions := make([]*pvz_v1.ReceptionWithProducts, 0, len(receptionPage.Receptions))
+	for _, reception := range receptionPage.Receptions {
+		receptions = append(receptions, mapReceptionWithProductsToProto(reception))
+	}
+
+	log.Info().Msgf("GetPVZReceptions result: %d receptions of %d", len(receptions), receptionPage.Total)
+
+	return &pvz_v1.GetPVZReceptionsResponse{
+		Receptions: receptions,
+		Page:       int32(receptionPage.Page),
+		Limit:      int32(receptionPage.Limit),
+		Total:      int32(receptionPage.Total),
+		TotalPages: int32(receptionPage.TotalPages),
+	}, nil
+}
+
+func (h *GrpcHandler) ReopenReception(ctx context.Context, req *pvz_v1.ReopenReceptionRequest) (*pvz_v1.ReopenReceptionResponse, error) {
+	log.Info().Msg("ReopenReception started")
+
+	receptionId, err := parseUuid(req.ReceptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	receptionResp, err := h.receptionService.ReopenReception(ctx, receptionId, req.Reason, getGrpcUserId(ctx))
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("ReopenReception result: %v", receptionResp)
+
+	return &pvz_v1.ReopenReceptionResponse{Reception: mapReceptionToProto(*receptionResp)}, nil
+}
+
+func (h *GrpcHandler) ChangeReceptionStatus(ctx context.Context, req *pvz_v1.ChangeReceptionStatusRequest) (*pvz_v1.ChangeReceptionStatusResponse, error) {
+	log.Info().Msg("ChangeReceptionStatus started")
+
+	receptionId, err := parseUuid(req.ReceptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	statusReq := generated.PostReceptionsReceptionIdStatusJSONRequestBody{
+		Status: mapReceptionStatusFromProto(req.Status),
+		Reason: req.Reason,
+	}
+	receptionResp, err := h.receptionService.ChangeReceptionStatus(ctx, receptionId, statusReq, getGrpcUserId(ctx), getGrpcUserRole(ctx))
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("ChangeReceptionStatus result: %v", receptionResp)
+
+	return &pvz_v1.ChangeReceptionStatusResponse{Reception: mapReceptionToProto(*receptionResp)}, nil
+}
+
+func (h *GrpcHandler) GetReceptionCorrections(ctx context.Context, req *pvz_v1.GetReceptionCorrectionsRequest) (*pvz_v1.GetReceptionCorrectionsResponse, error) {
+	log.Info().Msg("GetReceptionCorrections started")
+
+	receptionId, err := parseUuid(req.ReceptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	correctionsResp, err := h.receptionService.GetReceptionCorrections(ctx, receptionId)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	corrections := make([]*pvz_v1.ReceptionCorrection, 0, len(correctionsResp))
+	for _, correction := range correctionsResp {
+		corrections = append(corrections, mapReceptionCorrectionToProto(correction))
+	}
+
+	log.Info().Msgf("GetReceptionCorrections result: %d corrections", len(corrections))
+
+	return &pvz_v1.GetReceptionCorrectionsResponse{Corrections: corrections}, nil
+}
+
+func (h *GrpcHandler) AddProduct(ctx context.Context, req *pvz_v1.AddProductRequest) (*pvz_v1.AddProductResponse, error) {
+	log.Info().Msg("AddProduct started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	productReq := generated.PostProductsJSONRequestBody{PvzId: pvzId, Type: req.Type}
+	setProductDetailsFromProto(&productReq, req)
+
+	productResp, err := h.productService.CreateProduct(ctx, productReq)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("AddProduct result: %v", productResp)
+
+	return &pvz_v1.AddProductResponse{Product: mapProductToProto(*productResp)}, nil
+}
+
+func (h *GrpcHandler) AddProducts(stream grpc.ClientStreamingServer[pvz_v1.AddProductRequest, pvz_v1.AddProductsResponse]) error {
+	log.Info().Msg("AddProducts started")
+
+	var batchReq generated.PostProductsBatchJSONRequestBody
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		pvzId, err := parseUuid(req.PvzId)
+		if err != nil {
+			return err
+		}
+
+		if len(batchReq.Items) == 0 {
+			batchReq.PvzId = pvzId
+		} else if batchReq.PvzId != pvzId {
+			return mapErrorToStatus(custom_errors.ErrBatchPvz)
+		}
+
+		if len(batchReq.Items) == product_service.MaxBatchSize {
+			return mapErrorToStatus(custom_errors.ErrBatchSize)
+		}
+
+		productReq := generated.PostProductsJSONRequestBody{PvzId: pvzId, Type: req.Type}
+		setProductDetailsFromProto(&productReq, req)
+		batchReq.Items = append(batchReq.Items, generated.ProductBatchItem{
+			Type:        productReq.Type,
+			Barcode:     productReq.Barcode,
+			Sku:         productReq.Sku,
+			OrderId:     productReq.OrderId,
+			WeightGrams: productReq.WeightGrams,
+			Dimensions:  productReq.Dimensions,
+		})
+	}
+
+	batchResp, err := h.productService.CreateProducts(stream.Context(), batchReq)
+	if err != nil {
+		return mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("AddProducts result: created %d, failed %d", batchResp.Created, batchResp.Failed)
+
+	return stream.SendAndClose(mapProductBatchResultToProto(*batchResp))
+}
+
+func (h *GrpcHandler) DeleteLastProduct(ctx context.Context, req *pvz_v1.DeleteLastProductRequest) (*pvz_v1.DeleteLastProductResponse, error) {
+	log.Info().Msg("DeleteLastProduct started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.productService.DeleteLastProduct(ctx, pvzId, getGrpcUserId(ctx))
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msg("DeleteLastProduct finished")
+
+	return &pvz_v1.DeleteLastProductResponse{}, nil
+}
+
+func (h *GrpcHandler) DeleteProduct(ctx context.Context, req *pvz_v1.DeleteProductRequest) (*pvz_v1.DeleteProductResponse, error) {
+	log.Info().Msg("DeleteProduct started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	productId, err := parseUuid(req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.productService.DeleteProduct(ctx, pvzId, productId, getGrpcUserId(ctx))
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msg("DeleteProduct finished")
+
+	return &pvz_v1.DeleteProductResponse{}, nil
+}
+
+func (h *GrpcHandler) RestoreProduct(ctx context.Context, req *pvz_v1.RestoreProductRequest) (*pvz_v1.RestoreProductResponse, error) {
+	log.Info().Msg("RestoreProduct started")
+
+	pvzId, err := parseUuid(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	productId, err := parseUuid(req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	productResp, err := h.productService.RestoreProduct(ctx, pvzId, productId)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("RestoreProduct result: %v", productResp)
+
+	return &pvz_v1.RestoreProductResponse{Product: mapProductToProto(*productResp)}, nil
+}
+
+func (h *GrpcHandler) GetProductByBarcode(ctx context.Context, req *pvz_v1.GetProductByBarcodeRequest) (*pvz_v1.GetProductByBarcodeResponse, error) {
+	log.Info().Msg("GetProductByBarcode started")
+
+	locationResp, err := h.productService.GetProductByBarcode(ctx, req.Barcode)
+	if err != nil {
+		return nil, mapErrorToStatus(err)
+	}
+
+	log.Info().Msgf("GetProductByBarcode result: %v", locationResp)
+
+	return &pvz_v1.GetProductByBarcodeResponse{
+		Product:   mapProductToProto(locationResp.Product),
+		Reception: mapReceptionToProto(locationResp.Reception),
+		Pvz:       mapPvzToProto(locationResp.Pvz),
+	}, nil
+}
+
+func (h *GrpcHandler) WatchPVZEvents(req *pvz_v1.WatchPVZEventsRequest, stream grpc.ServerStreamingServer[pvz_v1.PVZEvent]) error {
+	log.Info().Msg("WatchPVZEvents started")
+
+	var pvzIdFilter pgtype.UUID
+	if req.PvzId != "" {
+		pvzId, err := parseUuid(req.PvzId)
+		if err != nil {
+			return err
+		}
+
+		pvzIdFilter, err = services.ConvertOpenAPIUuidToPgType(pvzId)
+		if err != nil {
+			return mapErrorToStatus(err)
+		}
+	}
+
+	ctx := stream.Context()
+	events, unsubscribe := h.eventService.Subscribe()
+	defer unsubscribe()
+
+	pvzCities := make(map[pgtype.UUID]string)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("WatchPVZEvents finished")
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			if pvzIdFilter.Valid && event.PvzId != pvzIdFilter {
+				continue
+			}
+
+			if req.City != "" {
+				city, err := h.getPvzCity(ctx, pvzCities, event.PvzId)
+				if err != nil {
+					log.Error().Err(err).Msgf("failed to resolve pvz city for %s event, event skipped", event.Type)
+					continue
+				}
+
+				if city != req.City {
+					continue
+				}
+			}
+
+			if err := stream.Send(mapEventToProto(event)); err != nil {
+				log.Error().Err(err).Msg("failed to send pvz event")
+				return err
+			}
+		}
+	}
+}
+
+func (h *GrpcHandler) getPvzCity(ctx context.Context, pvzCities map[pgtype.UUID]string, pvzId pgtype.UUID) (string, error) {
+	if city, exists := pvzCities[pvzId]; exists {
+		return city, nil
+	}
+
+	pvz, err := h.pvzService.GetPvzById(ctx, pvzId)
+	if err != nil {
+		return "", err
+	}
+
+	pvzCities[pvzId] = string(pvz.City)
+	return pvzCities[pvzId], nil
+}
+
+func mapErrorToStatus(err error) error {
+	var userErr *custom_errors.UserError
+	if !errors.As(err, &userErr) {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if errors.Is(err, custom_errors.ErrNoOpenReception) ||
+		errors.Is(err, custom_errors.ErrNoReception) ||
+		errors.Is(err, custom_errors.ErrInProgressReception) ||
+		errors.Is(err, custom_errors.ErrPvzExists) ||
+		errors.Is(err, custom_errors.ErrBarcodeExists) ||
+		errors.Is(err, custom_errors.ErrProductTypeLimit) ||
+		errors.Is(err, custom_errors.ErrReceptionNotClosed) ||
+		errors.Is(err, custom_errors.ErrNewerReception) ||
+		errors.Is(err, custom_errors.ErrReceptionPaused) ||
+		errors.Is(err, custom_errors.ErrReceptionFinalized) ||
+		errors.Is(err, custom_errors.ErrReceptionTransition) ||
+		errors.Is(err, custom_errors.ErrPvzInactive) ||
+		errors.Is(err, custom_errors.ErrPvzArchived) ||
+		errors.Is(err, custom_errors.ErrPvzStatus) ||
+		errors.Is(err, custom_errors.ErrPvzOpenReception) ||
+		errors.Is(err, custom_errors.ErrPvzFull) ||
+		errors.Is(err, custom_errors.ErrPvzProductTypeFull) {
+		return status.Error(codes.FailedPrecondition, userErr.Error())
+	}
+
+	if errors.Is(err, custom_errors.ErrReceptionChanged) ||
+		errors.Is(err, custom_errors.ErrPvzChanged) {
+		return status.Error(codes.Aborted, userErr.Error())
+	}
+
+	if errors.Is(err, custom_errors.ErrTransitionRole) {
+		return status.Error(codes.PermissionDenied, userErr.Error())
+	}
+
+	if errors.Is(err, custom_errors.ErrProductNotFound) ||
+		errors.Is(err, custom_errors.ErrDeletedProduct) ||
+		errors.Is(err, custom_errors.ErrReceptionNotFound) ||
+		errors.Is(err, custom_errors.ErrNoPvz) {
+		return status.Error(codes.NotFound, userErr.Error())
+	}
+
+	return status.Error(codes.InvalidArgument, userErr.Error())
+}
+
+func getGrpcUserId(ctx context.Context) pgtype.UUID {
+	user, ok := middlewares.UserFromContext(ctx)
+	if !ok {
+		return pgtype.UUID{}
+	}
+
+	return user.Id
+}
+
+func getGrpcUserRole(ctx context.Context) user_model.UserRole {
+	user, ok := middlewares.UserFromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	return user.Role
+}
+
+func parseUuid(s string) (openapi_types.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUuidFormat.Message)
+		return openapi_types.UUID{}, status.Error(codes.InvalidArgument, custom_errors.ErrUuidFormat.Error())
+	}
+
+	return id, nil
+}
+
+func mapPvzWithReceptionsToProto(pvz pvz_model.PvzWithReceptions) *pvz_v1.PVZWithReceptions {
+	pvzProto := &pvz_v1.PVZWithReceptions{
+		Pvz:          mapPvzModelToProto(pvz.Pvz),
+		LastActivity: timestamppb.New(pvz.LastActivity),
+	}
+
+	for _, reception := range pvz.Receptions {
+		receptionProto := &pvz_v1.ReceptionWithProducts{
+			Reception: &pvz_v1.Reception{
+				Id:       reception.Reception.Id.String(),
+				DateTime: timestamppb.New(reception.Reception.ReceptionTime),
+				PvzId:    reception.Reception.PvzId.String(),
+				Status:   mapReceptionStatusToProto(generated.ReceptionStatus(reception.Reception.Status)),
+			},
+		}
+
+		for _, product := range reception.Products {
+			productProto := &pvz_v1.Product{
+				Id:          product.Id.String(),
+				DateTime:    timestamppb.New(product.AddingTime),
+				Type:        string(product.ProductType),
+				ReceptionId: product.ReceptionId.String(),
+				Barcode:     product.Barcode,
+				Sku:         product.Sku,
+				OrderId:     product.OrderId,
+				WeightGrams: intPtrToInt32(product.WeightGrams),
+			}
+			if product.Dimensions != nil {
+				productProto.Dimensions = &pvz_v1.ProductDimensions{
+					LengthMm: int32(product.Dimensions.LengthMm),
+					WidthMm:  int32(product.Dimensions.WidthMm),
+					HeightMm: int32(product.Dimensions.HeightMm),
+				}
+			}
+
+			receptionProto.Products = append(receptionProto.Products, productProto)
+		}
+
+		pvzProto.Receptions = append(pvzProto.Receptions, receptionProto)
+	}
+
+	return pvzProto
+}
+
+func mapPvzToProto(pvz generated.PVZ) *pvz_v1.PVZ {
+	pvzProto := &pvz_v1.PVZ{
+		Id:               uuidToString(pvz.Id),
+		RegistrationDate: timeToProto(pvz.RegistrationDate),
+		City:             string(pvz.City),
+		Address:          pvz.Address,
+		WorkingHours:     pvz.WorkingHours,
+		Capacity:         intPtrToInt32(pvz.Capacity),
+		ArchivedAt:       timeToProto(pvz.ArchivedAt),
+		Latitude:         pvz.Latitude,
+		Longitude:        pvz.Longitude,
+	}
+	if pvz.Status != nil {
+		pvzProto.Status = mapPvzStatusToProto(pvz_model.PvzStatus(*pvz.Status))
+	}
+
+	return pvzProto
+}
+
+func mapPvzModelToProto(pvz pvz_model.Pvz) *pvz_v1.PVZ {
+	return &pvz_v1.PVZ{
+		Id:               pvz.Id.String(),
+		RegistrationDate: timestamppb.New(pvz.RegistrationDate),
+		City:             string(pvz.City),
+		Address:          pvz.Address,
+		WorkingHours:     pvz.WorkingHours,
+		Capacity:         intPtrToInt32(pvz.Capacity),
+		Status:           mapPvzStatusToProto(pvz.Status),
+		ArchivedAt:       timeToProto(pvz.ArchivedAt),
+		Latitude:         pvz.Latitude,
+		Longitude:        pvz.Longitude,
+	}
+}
+
+func mapPvzOccupancyToProto(occupancy generated.PVZOccupancy) *pvz_v1.PVZOccupancy {
+	productTypes := make([]*pvz_v1.ProductTypeOccupancy, 0, len(occupancy.ProductTypes))
+	for _, typeOccupancy := range occupancy.ProductTypes {
+		productTypes = append(productTypes, &pvz_v1.ProductTypeOccupancy{
+			Type:     typeOccupancy.Type,
+			Capacity: intPtrToInt32(typeOccupancy.Capacity),
+			Occupied: int32(typeOccupancy.Occupied),
+		})
+	}
+
+	return &pvz_v1.PVZOccupancy{
+		PvzId:        occupancy.PvzId.String(),
+		Capacity:     intPtrToInt32(occupancy.Capacity),
+		Occupied:     int32(occupancy.Occupied),
+		StorageHours: int32(occupancy.StorageHours),
+		ProductTypes: productTypes,
+	}
+}
+
+func mapPvzStatusToProto(pvzStatus pvz_model.PvzStatus) pvz_v1.PVZStatus {
+	switch pvzStatus {
+	case pvz_model.Inactive:
+		return pvz_v1.PVZStatus_PVZ_STATUS_INACTIVE
+	case pvz_model.Archived:
+		return pvz_v1.PVZStatus_PVZ_STATUS_ARCHIVED
+	default:
+		return pvz_v1.PVZStatus_PVZ_STATUS_ACTIVE
+	}
+}
+
+func mapReceptionToProto(reception generated.Reception) *pvz_v1.Reception {
+	return &pvz_v1.Reception{
+		Id:          uuidToString(reception.Id),
+		DateTime:    timestamppb.New(reception.DateTime),
+		PvzId:       reception.PvzId.String(),
+		Status:      mapReceptionStatusToProto(reception.Status),
+		CloseReason: reception.CloseReason,
+	}
+}
+
+func mapReceptionWithProductsToProto(reception generated.ReceptionWithProducts) *pvz_v1.ReceptionWithProducts {
+	products := make([]*pvz_v1.Product, 0, len(reception.Products))
+	for _, product := range reception.Products {
+		products = append(products, mapProductToProto(product))
+	}
+
+	return &pvz_v1.ReceptionWithProducts{
+		Reception: mapReceptionToProto(reception.Reception),
+		Products:  products,
+	}
+}
+
+func mapReceptionCorrectionToProto(correction generated.ReceptionCorrection) *pvz_v1.ReceptionCorrection {
+	return &pvz_v1.ReceptionCorrection{
+		Id:          correction.Id.String(),
+		ReceptionId: correction.ReceptionId.String(),
+		ClosedAt:    timeToProto(correction.ClosedAt),
+		ReopenedBy:  uuidToString(correction.ReopenedBy),
+		ReopenedAt:  timestamppb.New(correction.ReopenedAt),
+		Reason:      correction.Reason,
+	}
+}
+
+func mapProductToProto(product generated.Product) *pvz_v1.Product {
+	productProto := &pvz_v1.Product{
+		Id:          uuidToString(product.Id),
+		DateTime:    timeToProto(product.DateTime),
+		Type:        string(product.Type),
+		ReceptionId: product.ReceptionId.String(),
+		Barcode:     product.Barcode,
+		Sku:         product.Sku,
+		OrderId:     product.OrderId,
+		WeightGrams: intPtrToInt32(product.WeightGrams),
+	}
+
+	if product.Dimensions != nil {
+		productProto.Dimensions = &pvz_v1.ProductDimensions{
+			LengthMm: int32(product.Dimensions.LengthMm),
+			WidthMm:  int32(product.Dimensions.WidthMm),
+			HeightMm: int32(product.Dimensions.HeightMm),
+		}
+	}
+
+	return productProto
+}
+
+func mapReceptionStatusToProto(receptionStatus generated.ReceptionStatus) pvz_v1.ReceptionStatus {
+	switch receptionStatus {
+	case generated.Close:
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED
+	case generated.AutoClosed:
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_AUTO_CLOSED
+	case generated.Paused:
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_PAUSED
+	case generated.Verified:
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_VERIFIED
+	case generated.Cancelled:
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_CANCELLED
+	default:
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+	}
+}
+
+func mapReceptionStatusFromProto(receptionStatus pvz_v1.ReceptionStatus) generated.ReceptionStatus {
+	switch receptionStatus {
+	case pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED:
+		return generated.Close
+	case pvz_v1.ReceptionStatus_RECEPTION_STATUS_AUTO_CLOSED:
+		return generated.AutoClosed
+	case pvz_v1.ReceptionStatus_RECEPTION_STATUS_PAUSED:
+		return generated.Paused
+	case pvz_v1.ReceptionStatus_RECEPTION_STATUS_VERIFIED:
+		return generated.Verified
+	case pvz_v1.ReceptionStatus_RECEPTION_STATUS_CANCELLED:
+		return generated.Cancelled
+	default:
+		return generated.InProgress
+	}
+}
+
+func setProductDetailsFromProto(productReq *generated.PostProductsJSONRequestBody, req *pvz_v1.AddProductRequest) {
+	productReq.Barcode = req.Barcode
+	productReq.Sku = req.Sku
+	productReq.OrderId = req.OrderId
+	productReq.WeightGrams = int32PtrToInt(req.WeightGrams)
+	if req.Dimensions != nil {
+		productReq.Dimensions = &generated.ProductDimensions{
+			LengthMm: int(req.Dimensions.LengthMm),
+			WidthMm:  int(req.Dimensions.WidthMm),
+			HeightMm: int(req.Dimensions.HeightMm),
+		}
+	}
+}
+
+func mapProductBatchResultToProto(batchResult generated.ProductBatchResult) *pvz_v1.AddProductsResponse {
+	batchProto := &pvz_v1.AddProductsResponse{
+		ReceptionId: batchResult.ReceptionId.String(),
+		Created:     int32(batchResult.Created),
+		Failed:      int32(batchResult.Failed),
+	}
+
+	for _, item := range batchResult.Items {
+		itemProto := &pvz_v1.ProductBatchItemResult{Index: int32(item.Index)}
+		if item.Product != nil {
+			itemProto.Product = mapProductToProto(*item.Product)
+		}
+		if item.Error != nil {
+			itemProto.Error = *item.Error
+		}
+		batchProto.Items = append(batchProto.Items, itemProto)
+	}
+
+	return batchProto
+}
+
+func intPtrToInt32(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+
+	converted := int32(*value)
+	return &converted
+}
+
+func int32PtrToInt(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+
+	converted := int(*value)
+	return &converted
+}
+
+func uuidToString(id *openapi_types.UUID) string {
+	if id == nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func mapEventToProto(event event_model.Event) *pvz_v1.PVZEvent {
+	eventProto := &pvz_v1.PVZEvent{
+		Id:          event.Id.String(),
+		Type:        mapEventTypeToProto(event.Type),
+		PvzId:       event.PvzId.String(),
+		ProductType: event.ProductType,
+		OccurredAt:  timestamppb.New(event.OccurredAt),
+	}
+
+	if event.ReceptionId.Valid {
+		eventProto.ReceptionId = event.ReceptionId.String()
+	}
+
+	if event.ProductId.Valid {
+		eventProto.ProductId = event.ProductId.String()
+	}
+
+	return eventProto
+}
+
+func mapEventTypeToProto(eventType event_model.EventType) pvz_v1.PVZEventType {
+	switch eventType {
+	case event_model.ReceptionOpened:
+		return pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_OPENED
+	case event_model.ReceptionClosed:
+		return pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CLOSED
+	case event_model.ReceptionReopened:
+		return pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_REOPENED
+	case event_model.ReceptionPaused:
+		return pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_PAUSED
+	case event_model.ReceptionResumed:
+		return pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_RESUMED
+	case event_model.ReceptionCancelled:
+		return pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CANCELLED
+	case event_model.ReceptionVerified:
+		return pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_VERIFIED
+	case event_model.ProductAdded:
+		return pvz_v1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED
+	case event_model.ProductDeleted:
+		return pvz_v1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_DELETED
+	default:
+		return pvz_v1.PVZEventType_PVZ_EVENT_TYPE_UNSPECIFIED
+	}
+}
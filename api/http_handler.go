@@ -3,31 +3,49 @@ package api
 import (
 	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/middlewares"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/city_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_type_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/pvz_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/reception_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/user_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/webhook_service"
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/rs/zerolog/log"
 	"net/http"
 )
 
+const (
+	authTokenCookie    = "auth_token"
+	refreshTokenCookie = "refresh_token"
+)
+
 type HttpHandler struct {
-	pvzService       pvz_service.IPvzService
-	receptionService reception_service.IReceptionService
-	productService   product_service.IProductService
-	userService      user_service.IUserService
+	pvzService         pvz_service.IPvzService
+	receptionService   reception_service.IReceptionService
+	productService     product_service.IProductService
+	userService        user_service.IUserService
+	webhookService     webhook_service.IWebhookService
+	cityService        city_service.ICityService
+	productTypeService product_type_service.IProductTypeService
 }
 
-func NewHttpHandler(pvzService pvz_service.IPvzService, receptionService reception_service.IReceptionService, productService product_service.IProductService, userService user_service.IUserService) *HttpHandler {
+func NewHttpHandler(pvzService pvz_service.IPvzService, receptionService reception_service.IReceptionService, productService product_service.IProductService, userService user_service.IUserService, webhookService webhook_service.IWebhookService, cityService city_service.ICityService, productTypeService product_type_service.IProductTypeService) *HttpHandler {
 	return &HttpHandler{
-		pvzService:       pvzService,
-		receptionService: receptionService,
-		productService:   productService,
-		userService:      userService,
+		pvzService:         pvzService,
+		receptionService:   receptionService,
+		productService:     productService,
+		userService:        userService,
+		webhookService:     webhookService,
+		cityService:        cityService,
+		productTypeService: productTypeService,
 	}
 }
 
@@ -48,7 +66,12 @@ func (h *HttpHandler) PostDummyLogin(c *gin.Context) {
 		return
 	}
 
-	token, err := h.userService.DummyLogin(c.Request.Context(), roleDto)
+	tokens, err := h.userService.DummyLogin(c.Request.Context(), roleDto)
+	if errors.Is(err, custom_errors.ErrDummyLoginDisabled) {
+		c.JSON(http.StatusNotFound, generated.Error{Message: "Not found"})
+		return
+	}
+
 	var userErr *custom_errors.UserError
 	if errors.As(err, &userErr) {
 		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request to dummy login: " + userErr.Error()})
@@ -60,18 +83,10 @@ func (h *HttpHandler) PostDummyLogin(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie(
-		"auth_token",
-		token,
-		int(user_model.JwtExpiry.Seconds()),
-		"/",
-		"",
-		false,
-		true,
-	)
+	setAuthCookies(c, tokens)
 
-	c.JSON(http.StatusOK, token)
-	log.Info().Msgf("dummy login result: %s", token)
+	c.JSON(http.StatusOK, mapTokensToDto(tokens))
+	log.Info().Msgf("dummy login result: %s", tokens.AccessToken)
 }
 
 func (h *HttpHandler) PostLogin(c *gin.Context) {
@@ -84,7 +99,7 @@ func (h *HttpHandler) PostLogin(c *gin.Context) {
 		return
 	}
 
-	token, err := h.userService.Login(c.Request.Context(), req.Email, req.Password)
+	tokens, err := h.userService.Login(c.Request.Context(), req.Email, req.Password)
 	var userErr *custom_errors.UserError
 	if errors.As(err, &userErr) {
 		c.JSON(http.StatusUnauthorized, generated.Error{Message: "Invalid request to login:" + userErr.Error()})
@@ -96,19 +111,86 @@ func (h *HttpHandler) PostLogin(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie(
-		"auth_token",
-		token,
-		int(user_model.JwtExpiry.Seconds()),
-		"/",
-		"",
-		false,
-		true,
-	)
+	setAuthCookies(c, tokens)
+
+	c.JSON(http.StatusOK, mapTokensToDto(tokens))
+
+	log.Info().Msgf("login result: %s", tokens.AccessToken)
+}
+
+func (h *HttpHandler) PostRefresh(c *gin.Context) {
+	log.Info().Msg("refresh started")
+
+	var req generated.PostRefreshJSONRequestBody
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			log.Error().Err(err).Msg("failed to bind json body")
+			c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request to refresh: " + err.Error()})
+			return
+		}
+	}
+
+	refreshToken := ""
+	if req.RefreshToken != nil {
+		refreshToken = *req.RefreshToken
+	} else if cookie, err := c.Cookie(refreshTokenCookie); err == nil {
+		refreshToken = cookie
+	}
+
+	tokens, err := h.userService.Refresh(c.Request.Context(), refreshToken)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusUnauthorized, generated.Error{Message: "Invalid request to refresh: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Refresh error: " + err.Error()})
+		return
+	}
+
+	setAuthCookies(c, tokens)
 
-	c.JSON(http.StatusOK, token)
+	c.JSON(http.StatusOK, mapTokensToDto(tokens))
 
-	log.Info().Msgf("login result: %s", token)
+	log.Info().Msg("refresh finished")
+}
+
+func (h *HttpHandler) PostLogout(c *gin.Context, params generated.PostLogoutParams) {
+	log.Info().Msg("logout started")
+
+	var err error
+	if params.All != nil && *params.All {
+		err = h.userService.LogoutAll(c.Request.Context(), getAuthUserId(c))
+	} else {
+		err = h.userService.Logout(c.Request.Context(), c.GetString(middlewares.AuthTokenKey))
+	}
+
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusUnauthorized, generated.Error{Message: "Invalid request to logout: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Logout error: " + err.Error()})
+		return
+	}
+
+	clearAuthCookies(c)
+
+	c.JSON(http.StatusOK, gin.H{})
+
+	log.Info().Msg("logout finished")
+}
+
+func (h *HttpHandler) GetWellKnownJwksJson(c *gin.Context) {
+	log.Info().Msg("get jwks started")
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.userService.GetJwks())
+
+	log.Info().Msg("get jwks finished")
 }
 
 func (h *HttpHandler) PostProducts(c *gin.Context) {
@@ -121,7 +203,7 @@ func (h *HttpHandler) PostProducts(c *gin.Context) {
 		return
 	}
 
-	productResp, err := h.productService.CreateProduct(c.Request.Context(), productReq.PvzId, productReq.Type)
+	productResp, err := h.productService.CreateProduct(c.Request.Context(), productReq)
 	var userErr *custom_errors.UserError
 	if errors.As(err, &userErr) {
 		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to create product: " + userErr.Error()})
@@ -135,13 +217,60 @@ func (h *HttpHandler) PostProducts(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, productResp)
 
-	log.Info().Msgf("products result: %s", productResp)
+	log.Info().Msgf("products result: %v", productResp)
+}
+
+func (h *HttpHandler) PostProductsBatch(c *gin.Context) {
+	log.Info().Msg("products batch started")
+
+	var batchReq generated.PostProductsBatchJSONRequestBody
+	if err := c.ShouldBindJSON(&batchReq); err != nil {
+		log.Error().Err(err).Msg("failed to bind json body")
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to create products: " + err.Error()})
+		return
+	}
+
+	batchResp, err := h.productService.CreateProducts(c.Request.Context(), batchReq)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to create products: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Create products error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, batchResp)
+
+	log.Info().Msgf("products batch result: created %d, failed %d", batchResp.Created, batchResp.Failed)
+}
+
+func (h *HttpHandler) GetProductsByBarcodeCode(c *gin.Context, code string) {
+	log.Info().Msg("get product by barcode started")
+
+	locationResp, err := h.productService.GetProductByBarcode(c.Request.Context(), code)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to get product: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get product error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, locationResp)
+
+	log.Info().Msgf("get product by barcode result: %v", locationResp)
 }
 
 func (h *HttpHandler) GetPvz(c *gin.Context, params generated.GetPvzParams) {
 	log.Info().Msg("get pvz started")
 
-	pvzResp, err := h.pvzService.GetPvzFullInfo(c.Request.Context(), params)
+	pvzPage, err := h.pvzService.GetPvzFullInfo(c.Request.Context(), params)
 	var userErr *custom_errors.UserError
 	if errors.As(err, &userErr) {
 		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to get pvz: " + err.Error()})
@@ -153,9 +282,53 @@ func (h *HttpHandler) GetPvz(c *gin.Context, params generated.GetPvzParams) {
 		return
 	}
 
+	pvzList, err := mapPvzWithReceptionsToDto(pvzPage.Pvzs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get pvz error: " + err.Error()})
+		return
+	}
+
+	pvzResp := generated.PVZPage{
+		Pvzs:       pvzList,
+		Page:       pvzPage.Page,
+		Limit:      pvzPage.Limit,
+		Total:      pvzPage.Total,
+		TotalPages: pvzPage.TotalPages(),
+	}
+
+	if pvzPage.NextCursor != "" {
+		pvzResp.NextCursor = &pvzPage.NextCursor
+	}
+
 	c.JSON(http.StatusOK, pvzResp)
 
-	log.Info().Msgf("pvz result: %s", pvzResp)
+	log.Info().Msgf("pvz result: %d pvz of %d", len(pvzResp.Pvzs), pvzResp.Total)
+}
+
+func (h *HttpHandler) GetPvzNearby(c *gin.Context, params generated.GetPvzNearbyParams) {
+	log.Info().Msg("get nearby pvz started")
+
+	pvzList, err := h.pvzService.GetNearbyPvz(c.Request.Context(), params.Lat, params.Lon, params.Radius, params.Limit)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to get nearby pvz: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get nearby pvz error: " + err.Error()})
+		return
+	}
+
+	pvzResp, err := mapNearbyPvzToDto(pvzList)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get nearby pvz error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, pvzResp)
+
+	log.Info().Msgf("nearby pvz result: %d pvz", len(pvzResp))
 }
 
 func (h *HttpHandler) PostPvz(c *gin.Context) {
@@ -182,7 +355,141 @@ func (h *HttpHandler) PostPvz(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, pvzResp)
 
-	log.Info().Msgf("pvz result: %s", pvzResp)
+	log.Info().Msgf("pvz result: %v", pvzResp)
+}
+
+func (h *HttpHandler) PutPvzPvzId(c *gin.Context, pvzId openapi_types.UUID) {
+	log.Info().Msg("update pvz started")
+
+	var pvzReq generated.PutPvzPvzIdJSONRequestBody
+	if err := c.ShouldBindJSON(&pvzReq); err != nil {
+		log.Error().Err(err).Msg("failed to bind json body")
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to update pvz: " + err.Error()})
+		return
+	}
+
+	pvzResp, err := h.pvzService.UpdatePvz(c.Request.Context(), pvzId, pvzReq)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to update pvz: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Update pvz error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, pvzResp)
+
+	log.Info().Msgf("update pvz result: %v", pvzResp)
+}
+
+func (h *HttpHandler) GetPvzPvzIdOccupancy(c *gin.Context, pvzId openapi_types.UUID) {
+	log.Info().Msg("get pvz occupancy started")
+
+	occupancyResp, err := h.pvzService.GetPvzOccupancy(c.Request.Context(), pvzId)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to get pvz occupancy: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get pvz occupancy error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, occupancyResp)
+
+	log.Info().Msgf("pvz occupancy result: %v", occupancyResp)
+}
+
+func (h *HttpHandler) PutPvzPvzIdCapacity(c *gin.Context, pvzId openapi_types.UUID) {
+	log.Info().Msg("update pvz capacity started")
+
+	var capacityReq generated.PutPvzPvzIdCapacityJSONRequestBody
+	if err := c.ShouldBindJSON(&capacityReq); err != nil {
+		log.Error().Err(err).Msg("failed to bind json body")
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to update pvz capacity: " + err.Error()})
+		return
+	}
+
+	occupancyResp, err := h.pvzService.UpdatePvzCapacity(c.Request.Context(), pvzId, capacityReq)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to update pvz capacity: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Update pvz capacity error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, occupancyResp)
+
+	log.Info().Msgf("update pvz capacity result: %v", occupancyResp)
+}
+
+func (h *HttpHandler) PostPvzPvzIdDeactivate(c *gin.Context, pvzId openapi_types.UUID) {
+	log.Info().Msg("deactivate pvz started")
+
+	pvzResp, err := h.pvzService.DeactivatePvz(c.Request.Context(), pvzId)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to deactivate pvz: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Deactivate pvz error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, pvzResp)
+
+	log.Info().Msgf("deactivate pvz result: %v", pvzResp)
+}
+
+func (h *HttpHandler) PostPvzPvzIdActivate(c *gin.Context, pvzId openapi_types.UUID) {
+	log.Info().Msg("activate pvz started")
+
+	pvzResp, err := h.pvzService.ActivatePvz(c.Request.Context(), pvzId)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to activate pvz: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Activate pvz error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, pvzResp)
+
+	log.Info().Msgf("activate pvz result: %v", pvzResp)
+}
+
+func (h *HttpHandler) PostPvzPvzIdArchive(c *gin.Context, pvzId openapi_types.UUID) {
+	log.Info().Msg("archive pvz started")
+
+	pvzResp, err := h.pvzService.ArchivePvz(c.Request.Context(), pvzId)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to archive pvz: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Archive pvz error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, pvzResp)
+
+	log.Info().Msgf("archive pvz result: %v", pvzResp)
 }
 
 func (h *HttpHandler) PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId openapi_types.UUID) {
@@ -202,13 +509,13 @@ func (h *HttpHandler) PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId opena
 
 	c.JSON(http.StatusOK, receptionResp)
 
-	log.Info().Msgf("close last reception result: %s", receptionResp)
+	log.Info().Msgf("close last reception result: %v", receptionResp)
 }
 
 func (h *HttpHandler) PostPvzPvzIdDeleteLastProduct(c *gin.Context, pvzId openapi_types.UUID) {
 	log.Info().Msg("delete last product started")
 
-	err := h.productService.DeleteLastProduct(c.Request.Context(), pvzId)
+	err := h.productService.DeleteLastProduct(c.Request.Context(), pvzId, getAuthUserId(c))
 	var userErr *custom_errors.UserError
 	if errors.As(err, &userErr) {
 		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to delete last product error: " + userErr.Error()})
@@ -224,6 +531,45 @@ func (h *HttpHandler) PostPvzPvzIdDeleteLastProduct(c *gin.Context, pvzId openap
 	log.Info().Msg("delete last product finished")
 }
 
+func (h *HttpHandler) DeletePvzPvzIdProductsProductId(c *gin.Context, pvzId openapi_types.UUID, productId openapi_types.UUID) {
+	log.Info().Msg("delete product started")
+
+	err := h.productService.DeleteProduct(c.Request.Context(), pvzId, productId, getAuthUserId(c))
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to delete product: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Delete product error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+	log.Info().Msg("delete product finished")
+}
+
+func (h *HttpHandler) PostPvzPvzIdProductsProductIdRestore(c *gin.Context, pvzId openapi_types.UUID, productId openapi_types.UUID) {
+	log.Info().Msg("restore product started")
+
+	productResp, err := h.productService.RestoreProduct(c.Request.Context(), pvzId, productId)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to restore product: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Restore product error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, productResp)
+
+	log.Info().Msgf("restore product result: %v", productResp)
+}
+
 func (h *HttpHandler) PostReceptions(c *gin.Context) {
 	log.Info().Msg("receptions started")
 
@@ -248,7 +594,121 @@ func (h *HttpHandler) PostReceptions(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, receptionResp)
 
-	log.Info().Msgf("receptions result: %s", receptionResp)
+	log.Info().Msgf("receptions result: %v", receptionResp)
+}
+
+func (h *HttpHandler) GetReceptionsReceptionId(c *gin.Context, receptionId openapi_types.UUID) {
+	log.Info().Msg("get reception started")
+
+	receptionResp, err := h.receptionService.GetReception(c.Request.Context(), receptionId)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to get reception: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get reception error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, receptionResp)
+
+	log.Info().Msgf("get reception result: %d products", len(receptionResp.Products))
+}
+
+func (h *HttpHandler) GetPvzPvzIdReceptions(c *gin.Context, pvzId openapi_types.UUID, params generated.GetPvzPvzIdReceptionsParams) {
+	log.Info().Msg("get pvz receptions started")
+
+	receptionPage, err := h.receptionService.GetPvzReceptions(c.Request.Context(), pvzId, params)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to get pvz receptions: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get pvz receptions error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, receptionPage)
+
+	log.Info().Msgf("get pvz receptions result: %d receptions of %d", len(receptionPage.Receptions), receptionPage.Total)
+}
+
+func (h *HttpHandler) PostReceptionsReceptionIdReopen(c *gin.Context, receptionId openapi_types.UUID) {
+	log.Info().Msg("reopen reception started")
+
+	var reopenReq generated.PostReceptionsReceptionIdReopenJSONRequestBody
+	if err := c.ShouldBindJSON(&reopenReq); err != nil {
+		log.Error().Err(err).Msg("failed to bind json body")
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to reopen reception: " + err.Error()})
+		return
+	}
+
+	receptionResp, err := h.receptionService.ReopenReception(c.Request.Context(), receptionId, reopenReq.Reason, getAuthUserId(c))
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to reopen reception: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Reopen reception error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, receptionResp)
+
+	log.Info().Msgf("reopen reception result: %v", receptionResp)
+}
+
+func (h *HttpHandler) PostReceptionsReceptionIdStatus(c *gin.Context, receptionId openapi_types.UUID) {
+	log.Info().Msg("change reception status started")
+
+	var statusReq generated.PostReceptionsReceptionIdStatusJSONRequestBody
+	if err := c.ShouldBindJSON(&statusReq); err != nil {
+		log.Error().Err(err).Msg("failed to bind json body")
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to change reception status: " + err.Error()})
+		return
+	}
+
+	receptionResp, err := h.receptionService.ChangeReceptionStatus(c.Request.Context(), receptionId, statusReq, getAuthUserId(c), getAuthUserRole(c))
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to change reception status: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Change reception status error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, receptionResp)
+
+	log.Info().Msgf("change reception status result: %v", receptionResp)
+}
+
+func (h *HttpHandler) GetReceptionsReceptionIdCorrections(c *gin.Context, receptionId openapi_types.UUID) {
+	log.Info().Msg("get reception corrections started")
+
+	correctionsResp, err := h.receptionService.GetReceptionCorrections(c.Request.Context(), receptionId)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to get reception corrections: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get reception corrections error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, correctionsResp)
+
+	log.Info().Msgf("get reception corrections result: %d corrections", len(correctionsResp))
 }
 
 func (h *HttpHandler) PostRegister(c *gin.Context) {
@@ -268,7 +728,7 @@ func (h *HttpHandler) PostRegister(c *gin.Context) {
 		return
 	}
 
-	userResp, token, err := h.userService.Register(c.Request.Context(), req.Email, req.Password, roleDto)
+	userResp, tokens, err := h.userService.Register(c.Request.Context(), req.Email, req.Password, roleDto)
 	var userErr *custom_errors.UserError
 	if errors.As(err, &userErr) {
 		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to register: " + userErr.Error()})
@@ -280,17 +740,395 @@ func (h *HttpHandler) PostRegister(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie(
-		"auth_token",
-		token,
-		int(user_model.JwtExpiry.Seconds()),
-		"/",
-		"",
-		false,
-		true,
-	)
+	setAuthCookies(c, tokens)
 
 	c.JSON(http.StatusCreated, userResp)
 
 	log.Info().Msgf("register result: %s", userResp)
 }
+
+func (h *HttpHandler) PostWebhooks(c *gin.Context) {
+	log.Info().Msg("webhooks started")
+
+	var webhookReq generated.PostWebhooksJSONRequestBody
+	if err := c.ShouldBindJSON(&webhookReq); err != nil {
+		log.Error().Err(err).Msg("failed to bind json body")
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to create webhook: " + err.Error()})
+		return
+	}
+
+	webhookResp, err := h.webhookService.CreateSubscription(c.Request.Context(), webhookReq)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to create webhook: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Create webhook error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhookResp)
+
+	log.Info().Msgf("webhooks result: %s", webhookResp)
+}
+
+func (h *HttpHandler) GetWebhooks(c *gin.Context) {
+	log.Info().Msg("get webhooks started")
+
+	webhooksResp, err := h.webhookService.GetSubscriptions(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get webhooks error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, webhooksResp)
+
+	log.Info().Msgf("get webhooks result: %d subscriptions", len(webhooksResp))
+}
+
+func (h *HttpHandler) DeleteWebhooksWebhookId(c *gin.Context, webhookId openapi_types.UUID) {
+	log.Info().Msg("delete webhook started")
+
+	err := h.webhookService.DeleteSubscription(c.Request.Context(), webhookId)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to delete webhook: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Delete webhook error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+	log.Info().Msg("delete webhook finished")
+}
+
+func (h *HttpHandler) GetWebhooksWebhookIdDeliveries(c *gin.Context, webhookId openapi_types.UUID, params generated.GetWebhooksWebhookIdDeliveriesParams) {
+	log.Info().Msg("get webhook deliveries started")
+
+	deliveriesResp, err := h.webhookService.GetDeliveries(c.Request.Context(), webhookId, params)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to get webhook deliveries: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get webhook deliveries error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveriesResp)
+
+	log.Info().Msgf("get webhook deliveries result: %d deliveries", len(deliveriesResp))
+}
+
+func (h *HttpHandler) PostWebhookDeliveriesDeliveryIdReplay(c *gin.Context, deliveryId openapi_types.UUID) {
+	log.Info().Msg("replay webhook delivery started")
+
+	deliveryResp, err := h.webhookService.ReplayDelivery(c.Request.Context(), deliveryId)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to replay webhook delivery: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Replay webhook delivery error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveryResp)
+
+	log.Info().Msgf("replay webhook delivery result: %s", deliveryResp.Id)
+}
+
+func (h *HttpHandler) PostCities(c *gin.Context) {
+	log.Info().Msg("cities started")
+
+	var cityReq generated.PostCitiesJSONRequestBody
+	if err := c.ShouldBindJSON(&cityReq); err != nil {
+		log.Error().Err(err).Msg("failed to bind json body")
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to create city: " + err.Error()})
+		return
+	}
+
+	cityResp, err := h.cityService.CreateCity(c.Request.Context(), cityReq)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to create city: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Create city error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, cityResp)
+
+	log.Info().Msgf("cities result: %s", cityResp.Name)
+}
+
+func (h *HttpHandler) GetCities(c *gin.Context) {
+	log.Info().Msg("get cities started")
+
+	citiesResp, err := h.cityService.GetCities(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get cities error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, citiesResp)
+
+	log.Info().Msgf("get cities result: %d cities", len(citiesResp))
+}
+
+func (h *HttpHandler) PutCitiesName(c *gin.Context, name string) {
+	log.Info().Msg("update city started")
+
+	var cityReq generated.PutCitiesNameJSONRequestBody
+	if err := c.ShouldBindJSON(&cityReq); err != nil {
+		log.Error().Err(err).Msg("failed to bind json body")
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to update city: " + err.Error()})
+		return
+	}
+
+	cityResp, err := h.cityService.UpdateCity(c.Request.Context(), name, cityReq)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to update city: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Update city error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, cityResp)
+
+	log.Info().Msgf("update city result: %s", cityResp.Name)
+}
+
+func (h *HttpHandler) DeleteCitiesName(c *gin.Context, name string) {
+	log.Info().Msg("delete city started")
+
+	err := h.cityService.DeleteCity(c.Request.Context(), name)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to delete city: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Delete city error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+	log.Info().Msg("delete city finished")
+}
+
+func (h *HttpHandler) PostProductTypes(c *gin.Context) {
+	log.Info().Msg("product types started")
+
+	var productTypeReq generated.PostProductTypesJSONRequestBody
+	if err := c.ShouldBindJSON(&productTypeReq); err != nil {
+		log.Error().Err(err).Msg("failed to bind json body")
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to create product type: " + err.Error()})
+		return
+	}
+
+	productTypeResp, err := h.productTypeService.CreateProductType(c.Request.Context(), productTypeReq)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to create product type: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Create product type error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, productTypeResp)
+
+	log.Info().Msgf("product types result: %s", productTypeResp.Code)
+}
+
+func (h *HttpHandler) GetProductTypes(c *gin.Context) {
+	log.Info().Msg("get product types started")
+
+	productTypesResp, err := h.productTypeService.GetProductTypes(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Get product types error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, productTypesResp)
+
+	log.Info().Msgf("get product types result: %d product types", len(productTypesResp))
+}
+
+func (h *HttpHandler) PutProductTypesCode(c *gin.Context, code string) {
+	log.Info().Msg("update product type started")
+
+	var productTypeReq generated.PutProductTypesCodeJSONRequestBody
+	if err := c.ShouldBindJSON(&productTypeReq); err != nil {
+		log.Error().Err(err).Msg("failed to bind json body")
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to update product type: " + err.Error()})
+		return
+	}
+
+	productTypeResp, err := h.productTypeService.UpdateProductType(c.Request.Context(), code, productTypeReq)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to update product type: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Update product type error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, productTypeResp)
+
+	log.Info().Msgf("update product type result: %s", productTypeResp.Code)
+}
+
+func (h *HttpHandler) DeleteProductTypesCode(c *gin.Context, code string) {
+	log.Info().Msg("delete product type started")
+
+	err := h.productTypeService.DeleteProductType(c.Request.Context(), code)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		c.JSON(http.StatusBadRequest, generated.Error{Message: "Invalid request format to delete product type: " + userErr.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.Error{Message: "Delete product type error: " + err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{})
+	log.Info().Msg("delete product type finished")
+}
+
+func mapNearbyPvzToDto(pvzList []pvz_model.NearbyPvz) ([]generated.NearbyPVZ, error) {
+	pvzListDto := make([]generated.NearbyPVZ, 0, len(pvzList))
+	for _, nearbyPvz := range pvzList {
+		pvzDto, err := services.MapPvzToDto(&nearbyPvz.Pvz)
+		if err != nil {
+			return nil, err
+		}
+
+		pvzListDto = append(pvzListDto, generated.NearbyPVZ{Pvz: *pvzDto, Distance: nearbyPvz.Distance})
+	}
+
+	return pvzListDto, nil
+}
+
+func mapPvzWithReceptionsToDto(pvzList []pvz_model.PvzWithReceptions) ([]generated.PVZWithReceptions, error) {
+	pvzListDto := make([]generated.PVZWithReceptions, 0, len(pvzList))
+	for _, pvz := range pvzList {
+		pvzInfoDto, err := services.MapPvzToDto(&pvz.Pvz)
+		if err != nil {
+			return nil, err
+		}
+
+		pvzIdDto := *pvzInfoDto.Id
+		lastActivity := pvz.LastActivity
+		pvzDto := generated.PVZWithReceptions{
+			Pvz:          *pvzInfoDto,
+			LastActivity: &lastActivity,
+			Receptions:   make([]generated.ReceptionWithProducts, 0, len(pvz.Receptions)),
+		}
+
+		for _, reception := range pvz.Receptions {
+			receptionIdDto, err := services.ConvertPgUuidToOpenAPI(reception.Reception.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			receptionDto := generated.ReceptionWithProducts{
+				Reception: generated.Reception{
+					Id:       &receptionIdDto,
+					DateTime: reception.Reception.ReceptionTime,
+					PvzId:    pvzIdDto,
+					Status:   generated.ReceptionStatus(reception.Reception.Status),
+				},
+				Products: make([]generated.Product, 0, len(reception.Products)),
+			}
+
+			for _, product := range reception.Products {
+				productIdDto, err := services.ConvertPgUuidToOpenAPI(product.Id)
+				if err != nil {
+					return nil, err
+				}
+
+				addingTime := product.AddingTime
+				receptionDto.Products = append(receptionDto.Products, generated.Product{
+					Id:          &productIdDto,
+					DateTime:    &addingTime,
+					Type:        string(product.ProductType),
+					ReceptionId: receptionIdDto,
+				})
+			}
+
+			pvzDto.Receptions = append(pvzDto.Receptions, receptionDto)
+		}
+
+		pvzListDto = append(pvzListDto, pvzDto)
+	}
+
+	return pvzListDto, nil
+}
+
+func mapTokensToDto(tokens *user_model.Tokens) generated.TokenPair {
+	return generated.TokenPair{AccessToken: tokens.AccessToken, RefreshToken: tokens.RefreshToken}
+}
+
+func setAuthCookies(c *gin.Context, tokens *user_model.Tokens) {
+	c.SetCookie(authTokenCookie, tokens.AccessToken, int(user_model.JwtExpiry.Seconds()), "/", "", false, true)
+	c.SetCookie(refreshTokenCookie, tokens.RefreshToken, int(user_model.RefreshTokenExpiry.Seconds()), "/", "", false, true)
+}
+
+func clearAuthCookies(c *gin.Context) {
+	c.SetCookie(authTokenCookie, "", -1, "/", "", false, true)
+	c.SetCookie(refreshTokenCookie, "", -1, "/", "", false, true)
+}
+
+func getAuthUserId(c *gin.Context) pgtype.UUID {
+	value, exists := c.Get(middlewares.AuthUserKey)
+	if !exists {
+		return pgtype.UUID{}
+	}
+
+	user, ok := value.(*user_model.User)
+	if !ok {
+		return pgtype.UUID{}
+	}
+
+	return user.Id
+}
+
+func getAuthUserRole(c *gin.Context) user_model.UserRole {
+	value, exists := c.Get(middlewares.AuthUserKey)
+	if !exists {
+		return ""
+	}
+
+	user, ok := value.(*user_model.User)
+	if !ok {
+		return ""
+	}
+
+	return user.Role
+}
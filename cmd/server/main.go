@@ -6,17 +6,29 @@ import (
 	"fmt"
 	"github.com/Dmitrii-Dmitrii/pvz/api"
 	"github.com/Dmitrii-Dmitrii/pvz/internal"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/city_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/event_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/outbox_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/product_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/product_type_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/pvz_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/reception_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/sink_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/user_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/webhook_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/middlewares"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/city_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/event_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/outbox_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_type_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/pvz_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/reception_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/user_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/webhook_service"
 	pvz_v1 "github.com/Dmitrii-Dmitrii/pvz/proto/generated/pvz/v1"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -31,6 +43,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 )
@@ -47,6 +60,9 @@ func init() {
 	registry.MustRegister(internal.PvzCreatedTotal)
 	registry.MustRegister(internal.ReceptionCreatedTotal)
 	registry.MustRegister(internal.ProductCreatedTotal)
+	registry.MustRegister(internal.ReceptionAutoClosedTotal)
+	registry.MustRegister(internal.PvzOccupancy)
+	registry.MustRegister(internal.DisabledRouteRequestsTotal)
 }
 
 func main() {
@@ -67,13 +83,49 @@ func main() {
 	receptionDriver := reception_driver.NewReceptionDriver(dbpool)
 	productDriver := product_driver.NewProductDriver(dbpool)
 	userDriver := user_driver.NewUserDriver(dbpool)
+	outboxDriver := outbox_driver.NewOutboxDriver(dbpool)
+	webhookDriver := webhook_driver.NewWebhookDriver(dbpool)
+	webhookSenderDriver := webhook_driver.NewWebhookSenderDriver()
+	cityDriver := city_driver.NewCityDriver(dbpool)
+	productTypeDriver := product_type_driver.NewProductTypeDriver(dbpool)
+
+	var eventDriver event_driver.IEventDriver
+	if os.Getenv("EVENT_BUS") == "postgres" {
+		eventDriver = event_driver.NewEventDriver(dbpool)
+	}
+
+	eventService := event_service.NewEventService(eventDriver)
+	cityService := city_service.NewCityService(cityDriver)
+	productTypeService := product_type_service.NewProductTypeService(productTypeDriver)
+	pvzService := pvz_service.NewPvzService(pvzDriver, cityService, productTypeService)
+	receptionService := reception_service.NewReceptionService(receptionDriver, eventService, pvzService)
+	productService := product_service.NewProductService(productDriver, receptionService, eventService, productTypeService)
+	jwtKeys, err := getJwtKeySet()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load jwt keys")
+	}
+
+	dummyLoginEnabled := isDummyLoginEnabled()
+	if !dummyLoginEnabled {
+		log.Info().Msg("Dummy login is disabled outside development")
+	}
+
+	userService := user_service.NewUserService(userDriver, jwtKeys, dummyLoginEnabled)
+	outboxService := outbox_service.NewOutboxService(outboxDriver, getOutboxSink())
+	webhookService := webhook_service.NewWebhookService(webhookDriver, webhookSenderDriver, pvzService)
 
-	pvzService := pvz_service.NewPvzService(pvzDriver)
-	receptionService := reception_service.NewReceptionService(receptionDriver)
-	productService := product_service.NewProductService(productDriver, receptionService)
-	userService := user_service.NewUserService(userDriver)
+	listenCtx, stopListen := context.WithCancel(ctx)
+	defer stopListen()
 
-	httpHandler := api.NewHttpHandler(pvzService, receptionService, productService, userService)
+	go eventService.Listen(listenCtx)
+
+	go outboxService.Run(listenCtx, getOutboxRelayInterval())
+	go webhookService.Run(listenCtx, getWebhookDeliveryInterval())
+	go receptionService.RunAutoClose(listenCtx, getReceptionAutoCloseInterval(), getReceptionAutoCloseAfter())
+	go pvzService.RunOccupancyMetrics(listenCtx, getPvzOccupancyMetricsInterval())
+	go userService.RunTokenCleanup(listenCtx, getTokenCleanupInterval())
+
+	httpHandler := api.NewHttpHandler(pvzService, receptionService, productService, userService, webhookService, cityService, productTypeService)
 
 	prometheusAddr := getPrometheusAddress()
 
@@ -96,9 +148,13 @@ func main() {
 			return
 		}
 
-		grpcServer := grpc.NewServer()
+		grpcAuthInterceptor := middlewares.NewGrpcAuthInterceptor(userService)
+		grpcServer := grpc.NewServer(
+			grpc.UnaryInterceptor(grpcAuthInterceptor.UnaryInterceptor),
+			grpc.StreamInterceptor(grpcAuthInterceptor.StreamInterceptor),
+		)
 
-		pvzGrpcHandler := api.NewGrpcHandler(pvzService)
+		pvzGrpcHandler := api.NewGrpcHandler(pvzService, receptionService, productService, eventService)
 		pvz_v1.RegisterPVZServiceServer(grpcServer, pvzGrpcHandler)
 
 		reflection.Register(grpcServer)
@@ -115,7 +171,10 @@ func main() {
 
 	authMiddleware := middlewares.NewAuthMiddleware(userService)
 
-	apiGroup := router.Group("/")
+	var apiGroup gin.IRouter = router.Group("/")
+	if !dummyLoginEnabled {
+		apiGroup = middlewares.NewRouteFilter(apiGroup, "/dummyLogin")
+	}
 
 	generated.RegisterHandlersWithOptions(apiGroup, httpHandler, generated.GinServerOptions{
 		Middlewares: []generated.MiddlewareFunc{
@@ -153,6 +212,10 @@ func main() {
 	log.Info().Msg("Server exiting")
 }
 
+func isDummyLoginEnabled() bool {
+	return os.Getenv("APP_ENV") == "development" || os.Getenv("DUMMY_LOGIN_ENABLED") == "true"
+}
+
 func getServerAddress() string {
 	port := os.Getenv("SERVER_PORT")
 	if port == "" {
@@ -176,3 +239,109 @@ func getGrpcAddress() string {
 	}
 	return ":" + port
 }
+
+func getOutboxRelayInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("OUTBOX_RELAY_INTERVAL"))
+	if err != nil || interval <= 0 {
+		return 5 * time.Second
+	}
+	return interval
+}
+
+func getWebhookDeliveryInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("WEBHOOK_DELIVERY_INTERVAL"))
+	if err != nil || interval <= 0 {
+		return 5 * time.Second
+	}
+	return interval
+}
+
+func getReceptionAutoCloseInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("RECEPTION_AUTO_CLOSE_INTERVAL"))
+	if err != nil || interval <= 0 {
+		return time.Minute
+	}
+	return interval
+}
+
+func getReceptionAutoCloseAfter() time.Duration {
+	threshold, err := time.ParseDuration(os.Getenv("RECEPTION_AUTO_CLOSE_AFTER"))
+	if err != nil || threshold <= 0 {
+		return 24 * time.Hour
+	}
+	return threshold
+}
+
+func getPvzOccupancyMetricsInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("PVZ_OCCUPANCY_METRICS_INTERVAL"))
+	if err != nil || interval <= 0 {
+		return 30 * time.Second
+	}
+	return interval
+}
+
+func getTokenCleanupInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("TOKEN_CLEANUP_INTERVAL"))
+	if err != nil || interval <= 0 {
+		return time.Hour
+	}
+	return interval
+}
+
+func getJwtKeySet() (*user_model.JwtKeySet, error) {
+	privateKeys, err := readJwtKeyFiles(os.Getenv("JWT_PRIVATE_KEYS"))
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys, err := readJwtKeyFiles(os.Getenv("JWT_PUBLIC_KEYS"))
+	if err != nil {
+		return nil, err
+	}
+
+	return user_model.NewJwtKeySet(user_model.JwtKeysConfig{
+		Secret:       []byte(os.Getenv("JWT_SECRET")),
+		SigningKeyId: os.Getenv("JWT_SIGNING_KEY_ID"),
+		PrivateKeys:  privateKeys,
+		PublicKeys:   publicKeys,
+	})
+}
+
+func readJwtKeyFiles(value string) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		kid, path, ok := strings.Cut(entry, "=")
+		if !ok || kid == "" || path == "" {
+			return nil, fmt.Errorf("invalid jwt key entry %q, expected kid=path", entry)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		keys[kid] = data
+	}
+
+	return keys, nil
+}
+
+func getOutboxSink() sink_driver.ISinkDriver {
+	switch os.Getenv("OUTBOX_SINK") {
+	case "webhook":
+		return sink_driver.NewWebhookSinkDriver(os.Getenv("OUTBOX_WEBHOOK_URL"))
+	case "file":
+		file, err := os.OpenFile(os.Getenv("OUTBOX_FILE_PATH"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to open outbox file, falling back to stdout")
+			return sink_driver.NewFileSinkDriver(os.Stdout)
+		}
+		return sink_driver.NewFileSinkDriver(file)
+	default:
+		return sink_driver.NewFileSinkDriver(os.Stdout)
+	}
+}
@@ -0,0 +1,108 @@
+package city_driver
+
+import (
+	"context"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/city_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+)
+
+type CityDriver struct {
+	adapter drivers.Adapter
+}
+
+func NewCityDriver(adapter drivers.Adapter) *CityDriver {
+	return &CityDriver{adapter: adapter}
+}
+
+func (d *CityDriver) CreateCity(ctx context.Context, city *city_model.City) error {
+	_, err := d.adapter.Exec(ctx, drivers.QueryCreateCity, city.Name, city.Region, city.Timezone, city.ReceptionAutoCloseHours, city.CreatedAt)
+	if drivers.IsPgError(err, drivers.UniqueViolationCode) {
+		log.Warn().Err(err).Msg(custom_errors.ErrCityExists.Message)
+		return custom_errors.ErrCityExists
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCreateCity.Message)
+		return custom_errors.ErrCreateCity
+	}
+
+	return nil
+}
+
+func (d *CityDriver) GetCities(ctx context.Context) ([]city_model.City, error) {
+	rows, err := d.adapter.Query(ctx, drivers.QueryGetCities)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetCities.Message)
+		return nil, custom_errors.ErrGetCities
+	}
+	defer rows.Close()
+
+	cities := make([]city_model.City, 0)
+	for rows.Next() {
+		var city city_model.City
+		if err = rows.Scan(&city.Name, &city.Region, &city.Timezone, &city.ReceptionAutoCloseHours, &city.CreatedAt); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+		cities = append(cities, city)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetCities.Message)
+		return nil, custom_errors.ErrGetCities
+	}
+
+	return cities, nil
+}
+
+func (d *CityDriver) GetCityByName(ctx context.Context, name string) (*city_model.City, error) {
+	var city city_model.City
+	err := d.adapter.QueryRow(ctx, drivers.QueryGetCityByName, name).
+		Scan(&city.Name, &city.Region, &city.Timezone, &city.ReceptionAutoCloseHours, &city.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrCityNotFound.Message)
+		return nil, custom_errors.ErrCityNotFound
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetCity.Message)
+		return nil, custom_errors.ErrGetCity
+	}
+
+	return &city, nil
+}
+
+func (d *CityDriver) UpdateCity(ctx context.Context, city *city_model.City) error {
+	err := d.adapter.QueryRow(ctx, drivers.QueryUpdateCity, city.Name, city.Region, city.Timezone, city.ReceptionAutoCloseHours).Scan(&city.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrCityNotFound.Message)
+		return custom_errors.ErrCityNotFound
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdateCity.Message)
+		return custom_errors.ErrUpdateCity
+	}
+
+	return nil
+}
+
+func (d *CityDriver) DeleteCity(ctx context.Context, name string) error {
+	tag, err := d.adapter.Exec(ctx, drivers.QueryDeleteCity, name)
+	if drivers.IsPgError(err, drivers.ForeignKeyViolationCode) {
+		log.Warn().Err(err).Msg(custom_errors.ErrCityInUse.Message)
+		return custom_errors.ErrCityInUse
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrDeleteCity.Message)
+		return custom_errors.ErrDeleteCity
+	}
+
+	if tag.RowsAffected() == 0 {
+		log.Warn().Msg(custom_errors.ErrCityNotFound.Message)
+		return custom_errors.ErrCityNotFound
+	}
+
+	return nil
+}
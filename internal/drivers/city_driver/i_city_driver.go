@@ -0,0 +1,14 @@
+package city_driver
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/city_model"
+)
+
+type ICityDriver interface {
+	CreateCity(ctx context.Context, city *city_model.City) error
+	GetCities(ctx context.Context) ([]city_model.City, error)
+	GetCityByName(ctx context.Context, name string) (*city_model.City, error)
+	UpdateCity(ctx context.Context, city *city_model.City) error
+	DeleteCity(ctx context.Context, name string) error
+}
@@ -2,13 +2,31 @@ package drivers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog/log"
+	"time"
 )
 
+const (
+	UniqueViolationCode     = "23505"
+	ForeignKeyViolationCode = "23503"
+)
+
+func IsPgError(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
+
 func GetReceptionInProgressId(ctx context.Context, tx pgx.Tx, pvzId pgtype.UUID) (pgtype.UUID, error) {
 	var receptionId pgtype.UUID
 	err := tx.QueryRow(ctx, QueryGetReceptionInProgressId, pvzId).Scan(&receptionId)
@@ -23,3 +41,115 @@ func GetReceptionInProgressId(ctx context.Context, tx pgx.Tx, pvzId pgtype.UUID)
 
 	return receptionId, nil
 }
+
+func CreateOutboxEventWithDeliveries(ctx context.Context, tx pgx.Tx, event *event_model.Event) error {
+	if !event.Id.Valid {
+		event.Id = pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	}
+
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrMarshalEvent.Message)
+		return custom_errors.ErrMarshalEvent
+	}
+
+	if err = CreateOutboxEvent(ctx, tx, event, payload); err != nil {
+		return err
+	}
+
+	return CreateWebhookDeliveries(ctx, tx, event, payload)
+}
+
+func CreateOutboxEvent(ctx context.Context, tx pgx.Tx, event *event_model.Event, payload []byte) error {
+	_, err := tx.Exec(ctx, QueryCreateOutboxEvent, event.Id, string(event.Type), payload, event.OccurredAt)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCreateOutboxEvent.Message)
+		return custom_errors.ErrCreateOutboxEvent
+	}
+
+	return nil
+}
+
+func CreateWebhookDeliveries(ctx context.Context, tx pgx.Tx, event *event_model.Event, payload []byte) error {
+	_, err := tx.Exec(ctx, QueryCreateWebhookDeliveries, event.Id, string(event.Type), payload, event.OccurredAt, event.PvzId)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCreateWebhookDeliveries.Message)
+		return custom_errors.ErrCreateWebhookDeliveries
+	}
+
+	return nil
+}
+
+func GetPvzOccupancy(ctx context.Context, adapter Adapter, pvzId pgtype.UUID, now time.Time) (*pvz_model.PvzOccupancy, error) {
+	occupancy := &pvz_model.PvzOccupancy{
+		PvzId:          pvzId,
+		TypeCapacities: make(map[product_model.ProductType]int),
+		TypeOccupied:   make(map[product_model.ProductType]int),
+	}
+
+	err := adapter.QueryRow(ctx, QueryGetPvzCapacity, pvzId).Scan(&occupancy.Capacity, &occupancy.StorageHours)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, custom_errors.ErrPvzNotFound
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvzOccupancy.Message)
+		return nil, custom_errors.ErrGetPvzOccupancy
+	}
+
+	if err = scanProductTypeCounts(ctx, adapter, occupancy.TypeCapacities, QueryGetPvzTypeCapacities, pvzId); err != nil {
+		return nil, err
+	}
+
+	if err = scanProductTypeCounts(ctx, adapter, occupancy.TypeOccupied, QueryCountPvzOccupancy, pvzId, now); err != nil {
+		return nil, err
+	}
+
+	for _, occupied := range occupancy.TypeOccupied {
+		occupancy.Occupied += occupied
+	}
+
+	return occupancy, nil
+}
+
+func scanProductTypeCounts(ctx context.Context, adapter Adapter, counts map[product_model.ProductType]int, query string, args ...any) error {
+	rows, err := adapter.Query(ctx, query, args...)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvzOccupancy.Message)
+		return custom_errors.ErrGetPvzOccupancy
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var productType product_model.ProductType
+		var count int
+		if err = rows.Scan(&productType, &count); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return custom_errors.ErrScanRow
+		}
+		counts[productType] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvzOccupancy.Message)
+		return custom_errors.ErrGetPvzOccupancy
+	}
+
+	return nil
+}
+
+func GetDimensions(length, width, height *int) *product_model.Dimensions {
+	if length == nil || width == nil || height == nil {
+		return nil
+	}
+
+	return &product_model.Dimensions{LengthMm: *length, WidthMm: *width, HeightMm: *height}
+}
+
+func AddParam(params *[]interface{}, value interface{}) string {
+	*params = append(*params, value)
+	return fmt.Sprintf("$%d", len(*params))
+}
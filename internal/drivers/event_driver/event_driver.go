@@ -0,0 +1,72 @@
+package event_driver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+type EventDriver struct {
+	pool *pgxpool.Pool
+}
+
+func NewEventDriver(pool *pgxpool.Pool) *EventDriver {
+	return &EventDriver{pool: pool}
+}
+
+func (d *EventDriver) Notify(ctx context.Context, event *event_model.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrMarshalEvent.Message)
+		return custom_errors.ErrMarshalEvent
+	}
+
+	_, err = d.pool.Exec(ctx, drivers.QueryNotifyEvent, drivers.EventsChannel, string(payload))
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrNotifyEvent.Message)
+		return custom_errors.ErrNotifyEvent
+	}
+
+	return nil
+}
+
+func (d *EventDriver) Listen(ctx context.Context, handle func(event event_model.Event)) error {
+	conn, err := d.pool.Acquire(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrListenEvents.Message)
+		return custom_errors.ErrListenEvents
+	}
+	defer conn.Release()
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{drivers.EventsChannel}.Sanitize())
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrListenEvents.Message)
+		return custom_errors.ErrListenEvents
+	}
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil
+		}
+
+		if err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrListenEvents.Message)
+			return custom_errors.ErrListenEvents
+		}
+
+		var event event_model.Event
+		if err = json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrUnmarshalEvent.Message)
+			continue
+		}
+
+		handle(event)
+	}
+}
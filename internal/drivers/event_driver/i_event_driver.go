@@ -0,0 +1,11 @@
+package event_driver
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+)
+
+type IEventDriver interface {
+	Notify(ctx context.Context, event *event_model.Event) error
+	Listen(ctx context.Context, handle func(event event_model.Event)) error
+}
@@ -0,0 +1,14 @@
+package outbox_driver
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/outbox_model"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type IOutboxDriver interface {
+	ClaimPendingEvents(ctx context.Context, limit int, now, leaseUntil time.Time) ([]outbox_model.OutboxEvent, error)
+	MarkPublished(ctx context.Context, id pgtype.UUID, publishedAt time.Time) error
+	MarkFailed(ctx context.Context, event *outbox_model.OutboxEvent) error
+}
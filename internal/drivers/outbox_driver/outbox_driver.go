@@ -0,0 +1,70 @@
+package outbox_driver
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/outbox_model"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"sort"
+	"time"
+)
+
+type OutboxDriver struct {
+	adapter drivers.Adapter
+}
+
+func NewOutboxDriver(adapter drivers.Adapter) *OutboxDriver {
+	return &OutboxDriver{adapter: adapter}
+}
+
+func (d *OutboxDriver) ClaimPendingEvents(ctx context.Context, limit int, now, leaseUntil time.Time) ([]outbox_model.OutboxEvent, error) {
+	rows, err := d.adapter.Query(ctx, drivers.QueryClaimOutboxEvents, limit, leaseUntil, now)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrClaimOutboxEvents.Message)
+		return nil, custom_errors.ErrClaimOutboxEvents
+	}
+	defer rows.Close()
+
+	var events []outbox_model.OutboxEvent
+	for rows.Next() {
+		event := outbox_model.OutboxEvent{Status: outbox_model.Pending, NextAttemptAt: leaseUntil}
+		if err = rows.Scan(&event.Id, &event.EventType, &event.Payload, &event.CreatedAt, &event.Attempts); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrClaimOutboxEvents.Message)
+		return nil, custom_errors.ErrClaimOutboxEvents
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+
+	return events, nil
+}
+
+func (d *OutboxDriver) MarkPublished(ctx context.Context, id pgtype.UUID, publishedAt time.Time) error {
+	_, err := d.adapter.Exec(ctx, drivers.QueryMarkOutboxEventPublished, id, publishedAt)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdateOutboxEvent.Message)
+		return custom_errors.ErrUpdateOutboxEvent
+	}
+
+	return nil
+}
+
+func (d *OutboxDriver) MarkFailed(ctx context.Context, event *outbox_model.OutboxEvent) error {
+	_, err := d.adapter.Exec(ctx, drivers.QueryMarkOutboxEventFailed, event.Id, event.Status, event.NextAttemptAt, event.LastError)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdateOutboxEvent.Message)
+		return custom_errors.ErrUpdateOutboxEvent
+	}
+
+	return nil
+}
@@ -3,10 +3,15 @@ package product_driver
 import (
 	"context"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type IProductDriver interface {
-	CreateProduct(ctx context.Context, product *product_model.Product, pvzId pgtype.UUID) (*pgtype.UUID, error)
-	DeleteLastProduct(ctx context.Context, pvzId pgtype.UUID) error
+	CreateProduct(ctx context.Context, product *product_model.Product, pvzId pgtype.UUID, maxPerReception *int) (*pgtype.UUID, error)
+	CreateProducts(ctx context.Context, products []*product_model.Product, pvzId pgtype.UUID, maxPerReception map[product_model.ProductType]int) (*pgtype.UUID, []error, error)
+	DeleteLastProduct(ctx context.Context, pvzId pgtype.UUID, deletedBy pgtype.UUID) (*product_model.Product, error)
+	DeleteProduct(ctx context.Context, pvzId pgtype.UUID, productId pgtype.UUID, deletedBy pgtype.UUID) (*product_model.Product, error)
+	RestoreProduct(ctx context.Context, pvzId pgtype.UUID, productId pgtype.UUID) (*product_model.Product, error)
+	GetProductByBarcode(ctx context.Context, barcode string) (*pvz_model.ProductLocation, error)
 }
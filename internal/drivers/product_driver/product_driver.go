@@ -2,22 +2,32 @@ package product_driver
 
 import (
 	"context"
+	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog/log"
+	"time"
 )
 
 type ProductDriver struct {
 	adapter drivers.Adapter
 }
 
+var productColumns = []string{
+	"id", "adding_time", "product_type", "reception_id", "barcode", "sku", "order_id",
+	"weight_grams", "length_mm", "width_mm", "height_mm",
+}
+
 func NewProductDriver(adapter drivers.Adapter) *ProductDriver {
 	return &ProductDriver{adapter: adapter}
 }
 
-func (d *ProductDriver) CreateProduct(ctx context.Context, product *product_model.Product, pvzId pgtype.UUID) (*pgtype.UUID, error) {
+func (d *ProductDriver) CreateProduct(ctx context.Context, product *product_model.Product, pvzId pgtype.UUID, maxPerReception *int) (*pgtype.UUID, error) {
 	tx, err := d.adapter.Begin(ctx)
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
@@ -30,12 +40,49 @@ func (d *ProductDriver) CreateProduct(ctx context.Context, product *product_mode
 		return nil, err
 	}
 
-	_, err = tx.Exec(ctx, drivers.QueryCreateProduct, product.Id, product.AddingTime, product.ProductType, receptionId)
+	if maxPerReception != nil {
+		var count int
+		err = tx.QueryRow(ctx, drivers.QueryCountReceptionProductsByType, receptionId, product.ProductType).Scan(&count)
+		if err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrCountProducts.Message)
+			return nil, custom_errors.ErrCountProducts
+		}
+
+		if count >= *maxPerReception {
+			log.Warn().Msg(custom_errors.ErrProductTypeLimit.Message)
+			return nil, custom_errors.ErrProductTypeLimit
+		}
+	}
+
+	if err = checkPvzCapacity(ctx, tx, pvzId, product.ProductType, product.AddingTime); err != nil {
+		return nil, err
+	}
+
+	if product.Barcode != nil {
+		if err = checkBarcode(ctx, tx, *product.Barcode); err != nil {
+			return nil, err
+		}
+	}
+
+	length, width, height := getDimensionsParams(product.Dimensions)
+	_, err = tx.Exec(ctx, drivers.QueryCreateProduct, product.Id, product.AddingTime, product.ProductType, receptionId,
+		product.Barcode, product.Sku, product.OrderId, product.WeightGrams, length, width, height)
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrCreateProduct.Message)
 		return nil, custom_errors.ErrCreateProduct
 	}
 
+	err = drivers.CreateOutboxEventWithDeliveries(ctx, tx, &event_model.Event{
+		Type:        event_model.ProductAdded,
+		PvzId:       pvzId,
+		ReceptionId: receptionId,
+		ProductId:   product.Id,
+		ProductType: string(product.ProductType),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
 		return nil, custom_errors.ErrCommitTransaction
@@ -44,28 +91,428 @@ func (d *ProductDriver) CreateProduct(ctx context.Context, product *product_mode
 	return &receptionId, nil
 }
 
-func (d *ProductDriver) DeleteLastProduct(ctx context.Context, pvzId pgtype.UUID) error {
+func (d *ProductDriver) CreateProducts(ctx context.Context, products []*product_model.Product, pvzId pgtype.UUID, maxPerReception map[product_model.ProductType]int) (*pgtype.UUID, []error, error) {
 	tx, err := d.adapter.Begin(ctx)
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
-		return custom_errors.ErrBeginTransaction
+		return nil, nil, custom_errors.ErrBeginTransaction
 	}
 	defer tx.Rollback(ctx)
 
 	receptionId, err := drivers.GetReceptionInProgressId(ctx, tx, pvzId)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	counts, err := countProductsByType(ctx, tx, receptionId, maxPerReception)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usedBarcodes, err := lockBarcodes(ctx, tx, products)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	occupancy, err := drivers.GetPvzOccupancy(ctx, tx, pvzId, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	itemErrors := make([]error, len(products))
+	rows := make([][]any, 0, len(products))
+	for i, product := range products {
+		if product.Barcode != nil {
+			if _, exists := usedBarcodes[*product.Barcode]; exists {
+				itemErrors[i] = custom_errors.ErrBarcodeExists
+				continue
+			}
+		}
+
+		limit, limited := maxPerReception[product.ProductType]
+		if limited && counts[product.ProductType] >= limit {
+			itemErrors[i] = custom_errors.ErrProductTypeLimit
+			continue
+		}
+
+		if err = reservePvzCapacity(occupancy, product.ProductType); err != nil {
+			itemErrors[i] = err
+			continue
+		}
+
+		if limited {
+			counts[product.ProductType]++
+		}
+
+		if product.Barcode != nil {
+			usedBarcodes[*product.Barcode] = struct{}{}
+		}
+
+		product.ReceptionId = receptionId
+		length, width, height := getDimensionsParams(product.Dimensions)
+		rows = append(rows, []any{product.Id, product.AddingTime, string(product.ProductType), receptionId,
+			product.Barcode, product.Sku, product.OrderId, product.WeightGrams, length, width, height})
+	}
+
+	if len(rows) == 0 {
+		return &receptionId, itemErrors, nil
+	}
+
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"products"}, productColumns, pgx.CopyFromRows(rows))
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCreateProducts.Message)
+		return nil, nil, custom_errors.ErrCreateProducts
+	}
+
+	for i, product := range products {
+		if itemErrors[i] != nil {
+			continue
+		}
+
+		err = drivers.CreateOutboxEventWithDeliveries(ctx, tx, &event_model.Event{
+			Type:        event_model.ProductAdded,
+			PvzId:       pvzId,
+			ReceptionId: receptionId,
+			ProductId:   product.Id,
+			ProductType: string(product.ProductType),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+		return nil, nil, custom_errors.ErrCommitTransaction
+	}
+
+	return &receptionId, itemErrors, nil
+}
+
+func (d *ProductDriver) DeleteLastProduct(ctx context.Context, pvzId pgtype.UUID, deletedBy pgtype.UUID) (*product_model.Product, error) {
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return nil, custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	receptionId, err := drivers.GetReceptionInProgressId(ctx, tx, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	var product product_model.Product
+	err = tx.QueryRow(ctx, drivers.QueryDeleteLastProduct, receptionId, time.Now(), deletedBy).
+		Scan(&product.Id, &product.AddingTime, &product.ProductType, &product.ReceptionId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrNoProducts.Message)
+		return nil, custom_errors.ErrNoProducts
 	}
 
-	_, err = tx.Exec(ctx, drivers.QueryDeleteLastProduct, receptionId)
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrDeleteProduct.Message)
-		return custom_errors.ErrDeleteProduct
+		return nil, custom_errors.ErrDeleteProduct
+	}
+
+	if err = commitProductDeleted(ctx, tx, pvzId, &product); err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (d *ProductDriver) DeleteProduct(ctx context.Context, pvzId pgtype.UUID, productId pgtype.UUID, deletedBy pgtype.UUID) (*product_model.Product, error) {
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return nil, custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	receptionId, err := drivers.GetReceptionInProgressId(ctx, tx, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	var product product_model.Product
+	err = tx.QueryRow(ctx, drivers.QueryDeleteProduct, productId, receptionId, time.Now(), deletedBy).
+		Scan(&product.Id, &product.AddingTime, &product.ProductType, &product.ReceptionId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrProductNotFound.Message)
+		return nil, custom_errors.ErrProductNotFound
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrDeleteProduct.Message)
+		return nil, custom_errors.ErrDeleteProduct
+	}
+
+	if err = commitProductDeleted(ctx, tx, pvzId, &product); err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (d *ProductDriver) RestoreProduct(ctx context.Context, pvzId pgtype.UUID, productId pgtype.UUID) (*product_model.Product, error) {
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return nil, custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	receptionId, err := drivers.GetReceptionInProgressId(ctx, tx, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	var productType product_model.ProductType
+	var barcode *string
+	var maxPerReception *int
+	err = tx.QueryRow(ctx, drivers.QueryGetDeletedProduct, productId, receptionId).Scan(&productType, &barcode, &maxPerReception)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrDeletedProduct.Message)
+		return nil, custom_errors.ErrDeletedProduct
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrRestoreProduct.Message)
+		return nil, custom_errors.ErrRestoreProduct
+	}
+
+	if maxPerReception != nil {
+		counts, err := countProductsByType(ctx, tx, receptionId, map[product_model.ProductType]int{productType: *maxPerReception})
+		if err != nil {
+			return nil, err
+		}
+
+		if counts[productType] >= *maxPerReception {
+			log.Warn().Msg(custom_errors.ErrProductTypeLimit.Message)
+			return nil, custom_errors.ErrProductTypeLimit
+		}
+	}
+
+	if err = checkPvzCapacity(ctx, tx, pvzId, productType, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if barcode != nil {
+		if err = checkBarcode(ctx, tx, *barcode); err != nil {
+			return nil, err
+		}
+	}
+
+	var product product_model.Product
+	var length, width, height *int
+	err = tx.QueryRow(ctx, drivers.QueryRestoreProduct, productId).Scan(
+		&product.Id,
+		&product.AddingTime,
+		&product.ProductType,
+		&product.ReceptionId,
+		&product.Barcode,
+		&product.Sku,
+		&product.OrderId,
+		&product.WeightGrams,
+		&length,
+		&width,
+		&height,
+	)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrRestoreProduct.Message)
+		return nil, custom_errors.ErrRestoreProduct
+	}
+	product.Dimensions = drivers.GetDimensions(length, width, height)
+
+	err = drivers.CreateOutboxEventWithDeliveries(ctx, tx, &event_model.Event{
+		Type:        event_model.ProductAdded,
+		PvzId:       pvzId,
+		ReceptionId: product.ReceptionId,
+		ProductId:   product.Id,
+		ProductType: string(product.ProductType),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+		return nil, custom_errors.ErrCommitTransaction
+	}
+
+	return &product, nil
+}
+
+func (d *ProductDriver) GetProductByBarcode(ctx context.Context, barcode string) (*pvz_model.ProductLocation, error) {
+	var location pvz_model.ProductLocation
+	var length, width, height *int
+	err := d.adapter.QueryRow(ctx, drivers.QueryGetProductByBarcode, barcode).Scan(
+		&location.Product.Id,
+		&location.Product.AddingTime,
+		&location.Product.ProductType,
+		&location.Product.Barcode,
+		&location.Product.Sku,
+		&location.Product.OrderId,
+		&location.Product.WeightGrams,
+		&length,
+		&width,
+		&height,
+		&location.Reception.Id,
+		&location.Reception.ReceptionTime,
+		&location.Reception.Status,
+		&location.Pvz.Id,
+		&location.Pvz.RegistrationDate,
+		&location.Pvz.City,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrProductNotFound.Message)
+		return nil, custom_errors.ErrProductNotFound
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetProduct.Message)
+		return nil, custom_errors.ErrGetProduct
+	}
+
+	location.Product.Dimensions = drivers.GetDimensions(length, width, height)
+	location.Product.ReceptionId = location.Reception.Id
+	location.Reception.PvzId = location.Pvz.Id
+
+	return &location, nil
+}
+
+func checkBarcode(ctx context.Context, tx pgx.Tx, barcode string) error {
+	if _, err := tx.Exec(ctx, drivers.QueryLockBarcode, barcode); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCheckBarcode.Message)
+		return custom_errors.ErrCheckBarcode
+	}
+
+	var exists bool
+	if err := tx.QueryRow(ctx, drivers.QueryBarcodeInOpenReception, barcode).Scan(&exists); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCheckBarcode.Message)
+		return custom_errors.ErrCheckBarcode
+	}
+
+	if exists {
+		log.Warn().Msg(custom_errors.ErrBarcodeExists.Message)
+		return custom_errors.ErrBarcodeExists
+	}
+
+	return nil
+}
+
+func commitProductDeleted(ctx context.Context, tx pgx.Tx, pvzId pgtype.UUID, product *product_model.Product) error {
+	err := drivers.CreateOutboxEventWithDeliveries(ctx, tx, &event_model.Event{
+		Type:        event_model.ProductDeleted,
+		PvzId:       pvzId,
+		ReceptionId: product.ReceptionId,
+		ProductId:   product.Id,
+		ProductType: string(product.ProductType),
+	})
+	if err != nil {
+		return err
 	}
 
 	if err = tx.Commit(ctx); err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
 		return custom_errors.ErrCommitTransaction
 	}
+
+	return nil
+}
+
+func countProductsByType(ctx context.Context, tx pgx.Tx, receptionId pgtype.UUID, maxPerReception map[product_model.ProductType]int) (map[product_model.ProductType]int, error) {
+	counts := make(map[product_model.ProductType]int, len(maxPerReception))
+	for productType := range maxPerReception {
+		var count int
+		err := tx.QueryRow(ctx, drivers.QueryCountReceptionProductsByType, receptionId, productType).Scan(&count)
+		if err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrCountProducts.Message)
+			return nil, custom_errors.ErrCountProducts
+		}
+		counts[productType] = count
+	}
+
+	return counts, nil
+}
+
+func lockBarcodes(ctx context.Context, tx pgx.Tx, products []*product_model.Product) (map[string]struct{}, error) {
+	usedBarcodes := make(map[string]struct{})
+
+	var barcodes []string
+	for _, product := range products {
+		if product.Barcode != nil {
+			barcodes = append(barcodes, *product.Barcode)
+		}
+	}
+
+	if len(barcodes) == 0 {
+		return usedBarcodes, nil
+	}
+
+	if _, err := tx.Exec(ctx, drivers.QueryLockBarcodes, barcodes); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCheckBarcode.Message)
+		return nil, custom_errors.ErrCheckBarcode
+	}
+
+	rows, err := tx.Query(ctx, drivers.QueryBarcodesInOpenReception, barcodes)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCheckBarcode.Message)
+		return nil, custom_errors.ErrCheckBarcode
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var barcode string
+		if err = rows.Scan(&barcode); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrCheckBarcode.Message)
+			return nil, custom_errors.ErrCheckBarcode
+		}
+		usedBarcodes[barcode] = struct{}{}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCheckBarcode.Message)
+		return nil, custom_errors.ErrCheckBarcode
+	}
+
+	return usedBarcodes, nil
+}
+
+func getDimensionsParams(dimensions *product_model.Dimensions) (*int, *int, *int) {
+	if dimensions == nil {
+		return nil, nil, nil
+	}
+
+	return &dimensions.LengthMm, &dimensions.WidthMm, &dimensions.HeightMm
+}
+
+func checkPvzCapacity(ctx context.Context, tx pgx.Tx, pvzId pgtype.UUID, productType product_model.ProductType, now time.Time) error {
+	occupancy, err := drivers.GetPvzOccupancy(ctx, tx, pvzId, now)
+	if err != nil {
+		return err
+	}
+
+	if err = reservePvzCapacity(occupancy, productType); err != nil {
+		log.Warn().Msg(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func reservePvzCapacity(occupancy *pvz_model.PvzOccupancy, productType product_model.ProductType) error {
+	if occupancy.Capacity != nil && occupancy.Occupied >= *occupancy.Capacity {
+		return custom_errors.ErrPvzFull
+	}
+
+	if capacity, exists := occupancy.TypeCapacities[productType]; exists && occupancy.TypeOccupied[productType] >= capacity {
+		return custom_errors.ErrPvzProductTypeFull
+	}
+
+	occupancy.Occupied++
+	occupancy.TypeOccupied[productType]++
+
 	return nil
 }
@@ -0,0 +1,14 @@
+package product_type_driver
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_type_model"
+)
+
+type IProductTypeDriver interface {
+	CreateProductType(ctx context.Context, productType *product_type_model.ProductType) error
+	GetProductTypes(ctx context.Context) ([]product_type_model.ProductType, error)
+	GetProductTypeByCode(ctx context.Context, code string) (*product_type_model.ProductType, error)
+	UpdateProductType(ctx context.Context, productType *product_type_model.ProductType) error
+	DeleteProductType(ctx context.Context, code string) error
+}
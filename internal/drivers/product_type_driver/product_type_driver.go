@@ -0,0 +1,119 @@
+package product_type_driver
+
+import (
+	"context"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_type_model"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+)
+
+type ProductTypeDriver struct {
+	adapter drivers.Adapter
+}
+
+func NewProductTypeDriver(adapter drivers.Adapter) *ProductTypeDriver {
+	return &ProductTypeDriver{adapter: adapter}
+}
+
+func (d *ProductTypeDriver) CreateProductType(ctx context.Context, productType *product_type_model.ProductType) error {
+	_, err := d.adapter.Exec(ctx, drivers.QueryCreateProductType, productType.Code, productType.DisplayNameRu, productType.DisplayNameEn,
+		productType.Fragile, productType.Oversize, productType.MaxPerReception, productType.CreatedAt)
+	if drivers.IsPgError(err, drivers.UniqueViolationCode) {
+		log.Warn().Err(err).Msg(custom_errors.ErrProductTypeExists.Message)
+		return custom_errors.ErrProductTypeExists
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCreateProductType.Message)
+		return custom_errors.ErrCreateProductType
+	}
+
+	return nil
+}
+
+func (d *ProductTypeDriver) GetProductTypes(ctx context.Context) ([]product_type_model.ProductType, error) {
+	rows, err := d.adapter.Query(ctx, drivers.QueryGetProductTypes)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetProductTypes.Message)
+		return nil, custom_errors.ErrGetProductTypes
+	}
+	defer rows.Close()
+
+	productTypes := make([]product_type_model.ProductType, 0)
+	for rows.Next() {
+		productType, err := scanProductType(rows)
+		if err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+		productTypes = append(productTypes, *productType)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetProductTypes.Message)
+		return nil, custom_errors.ErrGetProductTypes
+	}
+
+	return productTypes, nil
+}
+
+func (d *ProductTypeDriver) GetProductTypeByCode(ctx context.Context, code string) (*product_type_model.ProductType, error) {
+	productType, err := scanProductType(d.adapter.QueryRow(ctx, drivers.QueryGetProductTypeByCode, code))
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrProductTypeNotFound.Message)
+		return nil, custom_errors.ErrProductTypeNotFound
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetProductType.Message)
+		return nil, custom_errors.ErrGetProductType
+	}
+
+	return productType, nil
+}
+
+func (d *ProductTypeDriver) UpdateProductType(ctx context.Context, productType *product_type_model.ProductType) error {
+	err := d.adapter.QueryRow(ctx, drivers.QueryUpdateProductType, productType.Code, productType.DisplayNameRu, productType.DisplayNameEn,
+		productType.Fragile, productType.Oversize, productType.MaxPerReception).Scan(&productType.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrProductTypeNotFound.Message)
+		return custom_errors.ErrProductTypeNotFound
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdateProductType.Message)
+		return custom_errors.ErrUpdateProductType
+	}
+
+	return nil
+}
+
+func (d *ProductTypeDriver) DeleteProductType(ctx context.Context, code string) error {
+	tag, err := d.adapter.Exec(ctx, drivers.QueryDeleteProductType, code)
+	if drivers.IsPgError(err, drivers.ForeignKeyViolationCode) {
+		log.Warn().Err(err).Msg(custom_errors.ErrProductTypeInUse.Message)
+		return custom_errors.ErrProductTypeInUse
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrDeleteProductType.Message)
+		return custom_errors.ErrDeleteProductType
+	}
+
+	if tag.RowsAffected() == 0 {
+		log.Warn().Msg(custom_errors.ErrProductTypeNotFound.Message)
+		return custom_errors.ErrProductTypeNotFound
+	}
+
+	return nil
+}
+
+func scanProductType(row pgx.Row) (*product_type_model.ProductType, error) {
+	var productType product_type_model.ProductType
+	err := row.Scan(&productType.Code, &productType.DisplayNameRu, &productType.DisplayNameEn,
+		&productType.Fragile, &productType.Oversize, &productType.MaxPerReception, &productType.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &productType, nil
+}
@@ -2,6 +2,7 @@ package pvz_driver
 
 import (
 	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/jackc/pgx/v5/pgtype"
 	"time"
@@ -9,7 +10,16 @@ import (
 
 type IPvzDriver interface {
 	CreatePvz(ctx context.Context, pvz *pvz_model.Pvz) error
+	UpdatePvz(ctx context.Context, pvz *pvz_model.Pvz) error
+	UpdatePvzStatus(ctx context.Context, id pgtype.UUID, from, to pvz_model.PvzStatus) error
+	ArchivePvz(ctx context.Context, id pgtype.UUID, archivedAt time.Time) error
+	UpdatePvzCapacity(ctx context.Context, id pgtype.UUID, storageHours int, typeCapacities map[product_model.ProductType]int) error
+	GetPvzOccupancy(ctx context.Context, id pgtype.UUID, now time.Time) (*pvz_model.PvzOccupancy, error)
+	GetAllPvzOccupancy(ctx context.Context, now time.Time) ([]pvz_model.PvzOccupancy, error)
 	GetPvzById(ctx context.Context, id pgtype.UUID) (*pvz_model.Pvz, error)
-	GetPvzFullInfo(ctx context.Context, limit, offset uint32, startInterval, endInterval *time.Time) ([]map[string]interface{}, error)
+	GetPvzFullInfo(ctx context.Context, filter pvz_model.PvzFilter) ([]pvz_model.PvzWithReceptions, error)
+	CountPvz(ctx context.Context, filter pvz_model.PvzFilter) (int, error)
 	GetAllPvz(ctx context.Context) ([]pvz_model.Pvz, error)
+	GetPvzPage(ctx context.Context, limit uint32, after *pvz_model.PvzCursor) ([]pvz_model.Pvz, error)
+	GetNearbyPvz(ctx context.Context, latitude, longitude, radius float64, limit uint32) ([]pvz_model.NearbyPvz, error)
 }
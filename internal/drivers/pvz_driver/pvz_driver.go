@@ -5,15 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
-	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
-	"github.com/Dmitrii-Dmitrii/pvz/internal/services"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog/log"
+	"strings"
 	"time"
 )
 
@@ -26,7 +25,8 @@ func NewPvzDriver(adapter drivers.Adapter) *PvzDriver {
 }
 
 func (d *PvzDriver) CreatePvz(ctx context.Context, pvz *pvz_model.Pvz) error {
-	_, err := d.adapter.Exec(ctx, drivers.QueryCreatePvz, pvz.Id, pvz.RegistrationDate, pvz.City)
+	_, err := d.adapter.Exec(ctx, drivers.QueryCreatePvz,
+		pvz.Id, pvz.RegistrationDate, pvz.City, pvz.Address, pvz.WorkingHours, pvz.Capacity, pvz.Status, pvz.Latitude, pvz.Longitude)
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrCreatePvz.Message)
 		return custom_errors.ErrCreatePvz
@@ -35,34 +35,205 @@ func (d *PvzDriver) CreatePvz(ctx context.Context, pvz *pvz_model.Pvz) error {
 	return nil
 }
 
-func (d *PvzDriver) GetPvzFullInfo(ctx context.Context, limit, offset uint32, startInterval, endInterval *time.Time) ([]map[string]interface{}, error) {
-	query, params := getQueryGetPvz(limit, offset, startInterval, endInterval)
+func (d *PvzDriver) UpdatePvz(ctx context.Context, pvz *pvz_model.Pvz) error {
+	tag, err := d.adapter.Exec(ctx, drivers.QueryUpdatePvz,
+		pvz.Id, pvz.Address, pvz.WorkingHours, pvz.Capacity, pvz.Latitude, pvz.Longitude)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdatePvz.Message)
+		return custom_errors.ErrUpdatePvz
+	}
+
+	if tag.RowsAffected() == 0 {
+		log.Warn().Msg(custom_errors.ErrPvzArchived.Message)
+		return custom_errors.ErrPvzArchived
+	}
+
+	return nil
+}
+
+func (d *PvzDriver) UpdatePvzStatus(ctx context.Context, id pgtype.UUID, from, to pvz_model.PvzStatus) error {
+	tag, err := d.adapter.Exec(ctx, drivers.QueryUpdatePvzStatus, id, from, to)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdatePvz.Message)
+		return custom_errors.ErrUpdatePvz
+	}
+
+	if tag.RowsAffected() == 0 {
+		log.Warn().Msg(custom_errors.ErrPvzChanged.Message)
+		return custom_errors.ErrPvzChanged
+	}
+
+	return nil
+}
+
+func (d *PvzDriver) ArchivePvz(ctx context.Context, id pgtype.UUID, archivedAt time.Time) error {
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	var status pvz_model.PvzStatus
+	err = tx.QueryRow(ctx, drivers.QueryGetPvzStatusForUpdate, id).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrNoPvz.Message)
+		return custom_errors.ErrNoPvz
+	}
 
-	rows, err := d.adapter.Query(ctx, query, params...)
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrGetPvz.Message)
-		return nil, custom_errors.ErrGetPvz
+		return custom_errors.ErrGetPvz
+	}
+
+	if status == pvz_model.Archived {
+		log.Warn().Msg(custom_errors.ErrPvzArchived.Message)
+		return custom_errors.ErrPvzArchived
+	}
+
+	var openExists bool
+	err = tx.QueryRow(ctx, drivers.QueryExistsOpenReception, id).Scan(&openExists)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetReceptionInProgress.Message)
+		return custom_errors.ErrGetReceptionInProgress
+	}
+
+	if openExists {
+		log.Warn().Msg(custom_errors.ErrPvzOpenReception.Message)
+		return custom_errors.ErrPvzOpenReception
+	}
+
+	_, err = tx.Exec(ctx, drivers.QueryArchivePvz, id, archivedAt)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdatePvz.Message)
+		return custom_errors.ErrUpdatePvz
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+		return custom_errors.ErrCommitTransaction
+	}
+
+	return nil
+}
+
+func (d *PvzDriver) UpdatePvzCapacity(ctx context.Context, id pgtype.UUID, storageHours int, typeCapacities map[product_model.ProductType]int) error {
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, drivers.QueryUpdatePvzStorageHours, id, storageHours)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdatePvzCapacity.Message)
+		return custom_errors.ErrUpdatePvzCapacity
+	}
+
+	if tag.RowsAffected() == 0 {
+		log.Warn().Msg(custom_errors.ErrPvzArchived.Message)
+		return custom_errors.ErrPvzArchived
+	}
+
+	if _, err = tx.Exec(ctx, drivers.QueryDeletePvzTypeCapacities, id); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdatePvzCapacity.Message)
+		return custom_errors.ErrUpdatePvzCapacity
+	}
+
+	for productType, capacity := range typeCapacities {
+		_, err = tx.Exec(ctx, drivers.QueryCreatePvzTypeCapacity, id, productType, capacity)
+		if drivers.IsPgError(err, drivers.ForeignKeyViolationCode) {
+			log.Warn().Err(err).Msg(custom_errors.ErrProductType.Message)
+			return custom_errors.ErrProductType
+		}
+
+		if err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrUpdatePvzCapacity.Message)
+			return custom_errors.ErrUpdatePvzCapacity
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+		return custom_errors.ErrCommitTransaction
+	}
+
+	return nil
+}
+
+func (d *PvzDriver) GetPvzOccupancy(ctx context.Context, id pgtype.UUID, now time.Time) (*pvz_model.PvzOccupancy, error) {
+	return drivers.GetPvzOccupancy(ctx, d.adapter, id, now)
+}
+
+func (d *PvzDriver) GetAllPvzOccupancy(ctx context.Context, now time.Time) ([]pvz_model.PvzOccupancy, error) {
+	rows, err := d.adapter.Query(ctx, drivers.QueryCountAllPvzOccupancy, now)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvzOccupancy.Message)
+		return nil, custom_errors.ErrGetPvzOccupancy
 	}
 	defer rows.Close()
 
-	pvzMap, err := scanRowsToGetPvz(rows)
+	var occupancyList []pvz_model.PvzOccupancy
+	for rows.Next() {
+		var occupancy pvz_model.PvzOccupancy
+		if err = rows.Scan(&occupancy.PvzId, &occupancy.Capacity, &occupancy.StorageHours, &occupancy.Occupied); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+
+		occupancyList = append(occupancyList, occupancy)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvzOccupancy.Message)
+		return nil, custom_errors.ErrGetPvzOccupancy
+	}
+
+	return occupancyList, nil
+}
+
+func (d *PvzDriver) GetPvzFullInfo(ctx context.Context, filter pvz_model.PvzFilter) ([]pvz_model.PvzWithReceptions, error) {
+	query, params := getQueryGetPvz(filter)
+
+	rows, err := d.adapter.Query(ctx, query, params...)
 	if err != nil {
-		return nil, err
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvz.Message)
+		return nil, custom_errors.ErrGetPvz
 	}
+	defer rows.Close()
 
-	result := make([]map[string]interface{}, 0, len(pvzMap))
-	for _, pvz := range pvzMap {
-		result = append(result, pvz)
+	return scanRowsToGetPvz(rows)
+}
+
+func (d *PvzDriver) CountPvz(ctx context.Context, filter pvz_model.PvzFilter) (int, error) {
+	var params []interface{}
+	filteredQuery := getQueryGetFilteredPvz(filter, &params)
+
+	var total int
+	err := d.adapter.QueryRow(ctx, fmt.Sprintf(drivers.QueryCountPvz, filteredQuery), params...).Scan(&total)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvz.Message)
+		return 0, custom_errors.ErrGetPvz
 	}
 
-	return result, nil
+	return total, nil
 }
 
 func (d *PvzDriver) GetPvzById(ctx context.Context, id pgtype.UUID) (*pvz_model.Pvz, error) {
-	var registrationDate time.Time
-	var city pvz_model.City
-
-	err := d.adapter.QueryRow(ctx, drivers.QueryGetPvzById, id).Scan(&registrationDate, &city)
+	pvz := &pvz_model.Pvz{Id: id}
+
+	err := d.adapter.QueryRow(ctx, drivers.QueryGetPvzById, id).Scan(
+		&pvz.RegistrationDate,
+		&pvz.City,
+		&pvz.Address,
+		&pvz.WorkingHours,
+		&pvz.Capacity,
+		&pvz.Status,
+		&pvz.ArchivedAt,
+		&pvz.Latitude,
+		&pvz.Longitude,
+	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, custom_errors.ErrPvzNotFound
@@ -72,7 +243,6 @@ func (d *PvzDriver) GetPvzById(ctx context.Context, id pgtype.UUID) (*pvz_model.
 		return nil, custom_errors.ErrGetPvz
 	}
 
-	pvz := &pvz_model.Pvz{Id: id, RegistrationDate: registrationDate, City: city}
 	return pvz, nil
 }
 
@@ -84,79 +254,200 @@ func (d *PvzDriver) GetAllPvz(ctx context.Context) ([]pvz_model.Pvz, error) {
 	}
 	defer rows.Close()
 
-	var pvzList []pvz_model.Pvz
-	for rows.Next() {
-		var id pgtype.UUID
-		var registrationDate time.Time
-		var city pvz_model.City
+	return scanRowsToPvzList(rows)
+}
+
+func (d *PvzDriver) GetPvzPage(ctx context.Context, limit uint32, after *pvz_model.PvzCursor) ([]pvz_model.Pvz, error) {
+	afterDate, afterId := getCursorParams(after)
 
-		err = rows.Scan(&id, &registrationDate, &city)
+	rows, err := d.adapter.Query(ctx, drivers.QueryGetPvzPage, limit, afterDate, afterId)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvz.Message)
+		return nil, custom_errors.ErrGetPvz
+	}
+	defer rows.Close()
+
+	return scanRowsToPvzList(rows)
+}
+
+func (d *PvzDriver) GetNearbyPvz(ctx context.Context, latitude, longitude, radius float64, limit uint32) ([]pvz_model.NearbyPvz, error) {
+	rows, err := d.adapter.Query(ctx, drivers.QueryGetNearbyPvz, latitude, longitude, radius, limit)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvz.Message)
+		return nil, custom_errors.ErrGetPvz
+	}
+	defer rows.Close()
+
+	pvzList := make([]pvz_model.NearbyPvz, 0)
+	for rows.Next() {
+		var nearbyPvz pvz_model.NearbyPvz
+		pvz := &nearbyPvz.Pvz
+
+		err = rows.Scan(
+			&pvz.Id,
+			&pvz.RegistrationDate,
+			&pvz.City,
+			&pvz.Address,
+			&pvz.WorkingHours,
+			&pvz.Capacity,
+			&pvz.Status,
+			&pvz.ArchivedAt,
+			&pvz.Latitude,
+			&pvz.Longitude,
+			&nearbyPvz.Distance,
+		)
 		if err != nil {
 			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
 			return nil, custom_errors.ErrScanRow
 		}
 
-		pvz := pvz_model.Pvz{
-			Id:               id,
-			RegistrationDate: registrationDate,
-			City:             city,
+		pvzList = append(pvzList, nearbyPvz)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvz.Message)
+		return nil, custom_errors.ErrGetPvz
+	}
+
+	return pvzList, nil
+}
+
+func getCursorParams(cursor *pvz_model.PvzCursor) (*time.Time, *pgtype.UUID) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	return &cursor.SortValue, &cursor.Id
+}
+
+func scanRowsToPvzList(rows pgx.Rows) ([]pvz_model.Pvz, error) {
+	var pvzList []pvz_model.Pvz
+	for rows.Next() {
+		var pvz pvz_model.Pvz
+
+		err := rows.Scan(
+			&pvz.Id,
+			&pvz.RegistrationDate,
+			&pvz.City,
+			&pvz.Address,
+			&pvz.WorkingHours,
+			&pvz.Capacity,
+			&pvz.Status,
+			&pvz.ArchivedAt,
+			&pvz.Latitude,
+			&pvz.Longitude,
+		)
+		if err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
 		}
 
 		pvzList = append(pvzList, pvz)
 	}
 
+	if err := rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvz.Message)
+		return nil, custom_errors.ErrGetPvz
+	}
+
 	return pvzList, nil
 }
 
-func getQueryGetPvz(limit, offset uint32, startInterval, endInterval *time.Time) (string, []interface{}) {
-	query := drivers.QueryGetPvz
+func getQueryGetPvz(filter pvz_model.PvzFilter) (string, []interface{}) {
 	var params []interface{}
-	paramCnt := 0
+	filteredQuery := getQueryGetFilteredPvz(filter, &params)
 
-	if startInterval != nil || endInterval != nil {
-		query += " WHERE "
+	sortColumn, cursorOperator, sortDirection := "registration_date", ">", ""
+	if filter.SortBy == pvz_model.SortByLastActivity {
+		sortColumn, cursorOperator, sortDirection = "last_activity", "<", " DESC"
+	}
 
-		if startInterval != nil {
-			paramCnt++
-			query += fmt.Sprintf("r.reception_time >= $%d", paramCnt)
-			params = append(params, *startInterval)
-		}
+	pageQuery := ""
+	if filter.After != nil {
+		pageQuery += fmt.Sprintf("WHERE (%s, id) %s (%s, %s::uuid) ", sortColumn, cursorOperator,
+			drivers.AddParam(&params, filter.After.SortValue), drivers.AddParam(&params, filter.After.Id))
+	}
+	pageQuery += fmt.Sprintf("ORDER BY %s%s, id%s LIMIT %s OFFSET %s", sortColumn, sortDirection, sortDirection,
+		drivers.AddParam(&params, filter.Limit), drivers.AddParam(&params, filter.Offset))
 
-		if startInterval != nil && endInterval != nil {
-			query += " AND "
-		}
+	receptionConditions := ""
+	if filter.StartInterval != nil {
+		receptionConditions += " AND r.reception_time >= " + drivers.AddParam(&params, *filter.StartInterval)
+	}
+	if filter.EndInterval != nil {
+		receptionConditions += " AND r.reception_time <= " + drivers.AddParam(&params, *filter.EndInterval)
+	}
 
-		if endInterval != nil {
-			paramCnt++
-			query += fmt.Sprintf("r.reception_time <= $%d", paramCnt)
-			params = append(params, *endInterval)
+	order := fmt.Sprintf("p.%s%s, p.id%s", sortColumn, sortDirection, sortDirection)
+
+	return fmt.Sprintf(drivers.QueryGetPvz, filteredQuery, pageQuery, receptionConditions, order), params
+}
+
+func getQueryGetFilteredPvz(filter pvz_model.PvzFilter, params *[]interface{}) string {
+	var conditions []string
+	if filter.City != nil {
+		conditions = append(conditions, "p.city = "+drivers.AddParam(params, *filter.City))
+	}
+
+	if filter.ReceptionStatus != nil || filter.ProductType != nil || filter.MinProducts != nil {
+		receptionConditions := []string{"r.pvz_id = p.id"}
+		if filter.StartInterval != nil {
+			receptionConditions = append(receptionConditions, "r.reception_time >= "+drivers.AddParam(params, *filter.StartInterval))
 		}
+		if filter.EndInterval != nil {
+			receptionConditions = append(receptionConditions, "r.reception_time <= "+drivers.AddParam(params, *filter.EndInterval))
+		}
+		if filter.ReceptionStatus != nil {
+			receptionConditions = append(receptionConditions, "r.status = "+drivers.AddParam(params, *filter.ReceptionStatus))
+		}
+		if filter.ProductType != nil {
+			receptionConditions = append(receptionConditions, fmt.Sprintf(
+				"EXISTS (SELECT 1 FROM products pr WHERE pr.reception_id = r.id AND pr.deleted_at IS NULL AND pr.product_type = %s)",
+				drivers.AddParam(params, *filter.ProductType)))
+		}
+		if filter.MinProducts != nil {
+			receptionConditions = append(receptionConditions, fmt.Sprintf(
+				"(SELECT COUNT(*) FROM products pr WHERE pr.reception_id = r.id AND pr.deleted_at IS NULL) >= %s",
+				drivers.AddParam(params, *filter.MinProducts)))
+		}
+
+		conditions = append(conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM receptions r WHERE %s)",
+			strings.Join(receptionConditions, " AND ")))
 	}
 
-	paramCnt++
-	query += fmt.Sprintf(" ORDER BY p.id, r.reception_time DESC LIMIT $%d", paramCnt)
-	params = append(params, limit)
-	paramCnt++
-	query += fmt.Sprintf(" OFFSET $%d", paramCnt)
-	params = append(params, offset)
+	query := drivers.QueryGetFilteredPvz
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
 
-	return query, params
+	return query
 }
 
-func scanRowsToGetPvz(rows pgx.Rows) (map[pgtype.UUID]map[string]interface{}, error) {
-	pvzMap := make(map[pgtype.UUID]map[string]interface{})
+func scanRowsToGetPvz(rows pgx.Rows) ([]pvz_model.PvzWithReceptions, error) {
+	pvzList := make([]pvz_model.PvzWithReceptions, 0)
+	pvzIndexes := make(map[pgtype.UUID]int)
+	receptionIndexes := make(map[pgtype.UUID]int)
 
 	for rows.Next() {
-		var pvzId, receptionId, productId pgtype.UUID
-		var registrationDate, receptionTime, addingTime *time.Time
-		var pvzCity pvz_model.City
+		var pvz pvz_model.Pvz
+		var receptionId, productId pgtype.UUID
+		var lastActivity time.Time
+		var receptionTime, addingTime *time.Time
 		var receptionStatus *reception_model.ReceptionStatus
 		var productType *product_model.ProductType
 
 		err := rows.Scan(
-			&pvzId,
-			&registrationDate,
-			&pvzCity,
+			&pvz.Id,
+			&pvz.RegistrationDate,
+			&pvz.City,
+			&pvz.Address,
+			&pvz.WorkingHours,
+			&pvz.Capacity,
+			&pvz.Status,
+			&pvz.ArchivedAt,
+			&pvz.Latitude,
+			&pvz.Longitude,
+			&lastActivity,
 			&receptionId,
 			&receptionTime,
 			&receptionStatus,
@@ -169,78 +460,55 @@ func scanRowsToGetPvz(rows pgx.Rows) (map[pgtype.UUID]map[string]interface{}, er
 			return nil, custom_errors.ErrScanRow
 		}
 
-		pvzIdDto, err := services.ConvertPgUuidToOpenAPI(pvzId)
-		if err != nil {
-			return nil, err
-		}
-
-		pvzObj, exists := pvzMap[pvzId]
+		pvzId := pvz.Id
+		pvzIndex, exists := pvzIndexes[pvzId]
 		if !exists {
-			pvzObj = map[string]interface{}{
-				"pvz": generated.PVZ{
-					Id:               &pvzIdDto,
-					RegistrationDate: registrationDate,
-					City:             generated.PVZCity(pvzCity),
-				},
-				"receptions": []map[string]interface{}{},
-			}
-			pvzMap[pvzId] = pvzObj
+			pvzList = append(pvzList, pvz_model.PvzWithReceptions{
+				Pvz:          pvz,
+				LastActivity: lastActivity,
+				Receptions:   make([]pvz_model.ReceptionWithProducts, 0),
+			})
+			pvzIndex = len(pvzList) - 1
+			pvzIndexes[pvzId] = pvzIndex
 		}
 
 		if !receptionId.Valid {
 			continue
 		}
 
-		receptions := pvzObj["receptions"].([]map[string]interface{})
-		var receptionObj map[string]interface{}
-
-		receptionIdDto, err := services.ConvertPgUuidToOpenAPI(receptionId)
-		if err != nil {
-			return nil, err
-		}
-
-		receptionExists := false
-		for i, reception := range receptions {
-
-			if reception["reception"].(generated.Reception).Id.String() == receptionIdDto.String() {
-				receptionExists = true
-				receptionObj = receptions[i]
-				break
-			}
-		}
-
-		if !receptionExists {
-			receptionObj = map[string]interface{}{
-				"reception": generated.Reception{
-					Id:       &receptionIdDto,
-					PvzId:    pvzIdDto,
-					DateTime: *registrationDate,
-					Status:   generated.ReceptionStatus(*receptionStatus),
+		pvzWithReceptions := &pvzList[pvzIndex]
+		receptionIndex, exists := receptionIndexes[receptionId]
+		if !exists {
+			pvzWithReceptions.Receptions = append(pvzWithReceptions.Receptions, pvz_model.ReceptionWithProducts{
+				Reception: reception_model.Reception{
+					Id:            receptionId,
+					ReceptionTime: *receptionTime,
+					PvzId:         pvzId,
+					Status:        *receptionStatus,
 				},
-				"products": []generated.Product{},
-			}
-			receptions = append(receptions, receptionObj)
-			pvzObj["receptions"] = receptions
+				Products: make([]product_model.Product, 0),
+			})
+			receptionIndex = len(pvzWithReceptions.Receptions) - 1
+			receptionIndexes[receptionId] = receptionIndex
 		}
 
 		if !productId.Valid {
 			continue
 		}
 
-		productIdDto, err := services.ConvertPgUuidToOpenAPI(productId)
-		if err != nil {
-			return nil, err
-		}
-
-		productDto := generated.Product{
-			Id:          &productIdDto,
-			ReceptionId: receptionIdDto,
-			DateTime:    addingTime,
-			Type:        generated.ProductType(*productType),
-		}
+		reception := &pvzWithReceptions.Receptions[receptionIndex]
+		reception.Products = append(reception.Products, product_model.Product{
+			Id:          productId,
+			AddingTime:  *addingTime,
+			ProductType: *productType,
+			ReceptionId: receptionId,
+		})
+	}
 
-		receptionObj["products"] = append(receptionObj["products"].([]generated.Product), productDto)
+	if err := rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvz.Message)
+		return nil, custom_errors.ErrGetPvz
 	}
 
-	return pvzMap, nil
+	return pvzList, nil
 }
@@ -1,8 +1,90 @@
 package drivers
 
+const EventsChannel = "pvz_events"
+
+const ReceptionAutoCloseLockKey = 7305001
+
 const (
 	QueryCreatePvz = `
-	INSERT INTO pvz (id, registration_date, city)
+	INSERT INTO pvz (id, registration_date, city, address, working_hours, capacity, status, latitude, longitude)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+	QueryUpdatePvz = `
+	UPDATE pvz
+	SET address = $2, working_hours = $3, capacity = $4, latitude = $5, longitude = $6
+	WHERE id = $1 AND status <> 'archived'
+`
+	QueryUpdatePvzStatus = `
+	UPDATE pvz
+	SET status = $3
+	WHERE id = $1 AND status = $2
+`
+	QueryGetPvzStatusForUpdate = `
+	SELECT status
+	FROM pvz
+	WHERE id = $1
+	FOR UPDATE
+`
+	QueryGetPvzStatusForShare = `
+	SELECT status
+	FROM pvz
+	WHERE id = $1
+	FOR SHARE
+`
+	QueryExistsOpenReception = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM receptions
+		WHERE pvz_id = $1 AND status IN ('in_progress', 'paused')
+	)
+`
+	QueryArchivePvz = `
+	UPDATE pvz
+	SET status = 'archived', archived_at = $2
+	WHERE id = $1
+`
+	QueryGetPvzCapacity = `
+	SELECT capacity, storage_hours
+	FROM pvz
+	WHERE id = $1
+`
+	QueryGetPvzTypeCapacities = `
+	SELECT product_type, capacity
+	FROM pvz_product_type_capacities
+	WHERE pvz_id = $1
+`
+	QueryCountPvzOccupancy = `
+	SELECT pr.product_type, COUNT(*)
+	FROM products pr
+	JOIN receptions r ON r.id = pr.reception_id
+	JOIN pvz p ON p.id = r.pvz_id
+	WHERE r.pvz_id = $1
+	  AND pr.deleted_at IS NULL
+	  AND (r.status IN ('in_progress', 'paused')
+	    OR r.status IN ('close', 'auto_closed', 'verified') AND r.closed_at >= $2 - p.storage_hours * INTERVAL '1 hour')
+	GROUP BY pr.product_type
+`
+	QueryCountAllPvzOccupancy = `
+	SELECT p.id, p.capacity, p.storage_hours, COUNT(pr.id)
+	FROM pvz p
+	LEFT JOIN receptions r ON r.pvz_id = p.id
+	  AND (r.status IN ('in_progress', 'paused')
+	    OR r.status IN ('close', 'auto_closed', 'verified') AND r.closed_at >= $1 - p.storage_hours * INTERVAL '1 hour')
+	LEFT JOIN products pr ON pr.reception_id = r.id AND pr.deleted_at IS NULL
+	WHERE p.status <> 'archived'
+	GROUP BY p.id
+`
+	QueryUpdatePvzStorageHours = `
+	UPDATE pvz
+	SET storage_hours = $2
+	WHERE id = $1 AND status <> 'archived'
+`
+	QueryDeletePvzTypeCapacities = `
+	DELETE FROM pvz_product_type_capacities
+	WHERE pvz_id = $1
+`
+	QueryCreatePvzTypeCapacity = `
+	INSERT INTO pvz_product_type_capacities (pvz_id, product_type, capacity)
 	VALUES ($1, $2, $3)
 `
 	QueryCreateReception = `
@@ -13,9 +95,32 @@ const (
 	SELECT
 	    reception_time, 
 	    pvz_id, 
-	    status
+	    status,
+	    close_reason
 	FROM receptions
 	WHERE id = $1
+`
+	QueryGetReceptions = `
+	SELECT
+	    id,
+	    reception_time,
+	    status,
+	    close_reason
+	FROM receptions
+	WHERE pvz_id = $1%s
+	ORDER BY reception_time DESC, id DESC
+	LIMIT %s OFFSET %s
+`
+	QueryCountReceptions = `
+	SELECT COUNT(*)
+	FROM receptions
+	WHERE pvz_id = $1%s
+`
+	QueryGetReceptionsProducts = `
+	SELECT id, adding_time, product_type, reception_id, barcode, sku, order_id, weight_grams, length_mm, width_mm, height_mm
+	FROM products
+	WHERE reception_id = ANY($1) AND deleted_at IS NULL
+	ORDER BY adding_time, id
 `
 	QueryGetReceptionInProgressId = `
 	SELECT id
@@ -26,41 +131,150 @@ const (
 	FOR UPDATE
 `
 	QueryCreateProduct = `
-	INSERT INTO products (id, adding_time, product_type, reception_id)
-	VALUES ($1, $2, $3, $4)
+	INSERT INTO products (id, adding_time, product_type, reception_id, barcode, sku, order_id, weight_grams, length_mm, width_mm, height_mm)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 `
 	QueryDeleteLastProduct = `
-	DELETE FROM products
+	UPDATE products
+	SET deleted_at = $2, deleted_by = $3
 	WHERE id IN (
 		SELECT id 
 		FROM products
-		WHERE reception_id = $1
+		WHERE reception_id = $1 AND deleted_at IS NULL
 		ORDER BY adding_time DESC
 		LIMIT 1
 	)
+	RETURNING id, adding_time, product_type, reception_id
+`
+	QueryDeleteProduct = `
+	UPDATE products
+	SET deleted_at = $3, deleted_by = $4
+	WHERE id = $1 AND reception_id = $2 AND deleted_at IS NULL
+	RETURNING id, adding_time, product_type, reception_id
+`
+	QueryGetDeletedProduct = `
+	SELECT pr.product_type, pr.barcode, pt.max_per_reception
+	FROM products pr
+	JOIN product_types pt ON pt.code = pr.product_type
+	WHERE pr.id = $1 AND pr.reception_id = $2 AND pr.deleted_at IS NOT NULL
+`
+	QueryRestoreProduct = `
+	UPDATE products
+	SET deleted_at = NULL, deleted_by = NULL
+	WHERE id = $1
+	RETURNING id, adding_time, product_type, reception_id, barcode, sku, order_id, weight_grams, length_mm, width_mm, height_mm
 `
 	QueryCloseReception = `
 	UPDATE receptions
-	SET status = 'close'
+	SET status = 'close', closed_at = $2
+	WHERE id = $1
+`
+	QueryGetReceptionForUpdate = `
+	SELECT
+	    reception_time,
+	    pvz_id,
+	    status,
+	    closed_at
+	FROM receptions
+	WHERE id = $1
+	FOR UPDATE
+`
+	QueryExistsNewerReception = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM receptions
+		WHERE pvz_id = $1 AND id <> $2 AND reception_time >= $3
+	)
+`
+	QueryReopenReception = `
+	UPDATE receptions
+	SET status = 'in_progress', closed_at = NULL, close_reason = NULL, reopened_at = $2
 	WHERE id = $1
+`
+	QueryUpdateReceptionStatus = `
+	UPDATE receptions
+	SET status = $3
+	WHERE id = $1 AND status = $2
+	RETURNING pvz_id
+`
+	QueryTryAdvisoryLock = `
+	SELECT pg_try_advisory_xact_lock($1)
+`
+	QueryAutoCloseReceptions = `
+	UPDATE receptions r
+	SET status = 'auto_closed', closed_at = $1, close_reason = $2
+	FROM pvz p
+	JOIN cities c ON c.name = p.city
+	WHERE r.pvz_id = p.id
+	  AND r.status = ANY($4::reception_status[])
+	  AND COALESCE(r.reopened_at, r.reception_time) < $1 - COALESCE(c.reception_auto_close_hours * INTERVAL '1 hour', $3::interval)
+	RETURNING r.id, r.reception_time, r.pvz_id
+`
+	QueryCreateReceptionCorrection = `
+	INSERT INTO reception_corrections (id, reception_id, closed_at, reopened_by, reopened_at, reason)
+	VALUES ($1, $2, $3, $4, $5, $6)
+`
+	QueryGetReceptionCorrections = `
+	SELECT id, closed_at, reopened_by, reopened_at, reason
+	FROM reception_corrections
+	WHERE reception_id = $1
+	ORDER BY reopened_at, id
+`
+	QueryGetFilteredPvz = `
+	SELECT 
+		p.id, 
+		p.registration_date, 
+		p.city,
+		p.address,
+		p.working_hours,
+		p.capacity,
+		p.status,
+		p.archived_at,
+		p.latitude,
+		p.longitude,
+		GREATEST(
+			p.registration_date,
+			(SELECT MAX(r.reception_time) FROM receptions r WHERE r.pvz_id = p.id),
+			(SELECT MAX(pr.adding_time) FROM products pr JOIN receptions r ON r.id = pr.reception_id WHERE r.pvz_id = p.id AND pr.deleted_at IS NULL)
+		) AS last_activity
+	FROM pvz p
 `
 	QueryGetPvz = `
+	WITH filtered_pvz AS (%s),
+	pvz_page AS (
+		SELECT id, registration_date, city, address, working_hours, capacity, status, archived_at, latitude, longitude, last_activity
+		FROM filtered_pvz
+		%s
+	)
 	SELECT 
 		p.id, 
 		p.registration_date, 
 		p.city,
+		p.address,
+		p.working_hours,
+		p.capacity,
+		p.status,
+		p.archived_at,
+		p.latitude,
+		p.longitude,
+		p.last_activity,
 		r.id,
 		r.reception_time, 
 		r.status, 
 		pr.id, 
 		pr.adding_time, 
 		pr.product_type
-	FROM pvz p
-	LEFT JOIN receptions r ON p.id = r.pvz_id
-	LEFT JOIN products pr ON r.id = pr.reception_id
+	FROM pvz_page p
+	LEFT JOIN receptions r ON p.id = r.pvz_id%s
+	LEFT JOIN products pr ON r.id = pr.reception_id AND pr.deleted_at IS NULL
+	ORDER BY %s, r.reception_time DESC, r.id, pr.adding_time, pr.id
+`
+	QueryCountPvz = `
+	SELECT COUNT(*)
+	FROM (%s) filtered_pvz
 `
 	QueryGetPvzById = `
-	SELECT registration_date, city
+	SELECT registration_date, city, address, working_hours, capacity, status, archived_at, latitude, longitude
 	FROM pvz
 	WHERE id = $1
 `
@@ -84,12 +298,332 @@ const (
 	SELECT email, password_hash, role
 	FROM users
 	WHERE id = $1
+`
+	QueryCreateRefreshToken = `
+	INSERT INTO refresh_tokens (id, family_id, user_id, token_hash, access_jti, access_expires_at, created_at, expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+	QueryGetRefreshTokenForUpdate = `
+	SELECT id, family_id, user_id, expires_at, revoked_at
+	FROM refresh_tokens
+	WHERE token_hash = $1
+	FOR UPDATE
+`
+	QueryRevokeRefreshToken = `
+	UPDATE refresh_tokens
+	SET revoked_at = $2
+	WHERE id = $1
+`
+	QueryRevokeTokenFamily = `
+	WITH revoked AS (
+	    UPDATE refresh_tokens
+	    SET revoked_at = COALESCE(revoked_at, $2)
+	    WHERE family_id = $1
+	    RETURNING access_jti, access_expires_at
+	)
+	INSERT INTO revoked_tokens (jti, expires_at)
+	SELECT access_jti, access_expires_at
+	FROM revoked
+	WHERE access_expires_at > $2
+	ON CONFLICT (jti) DO NOTHING
+`
+	QueryRevokeSession = `
+	WITH revoked AS (
+	    UPDATE refresh_tokens
+	    SET revoked_at = COALESCE(revoked_at, $2)
+	    WHERE family_id = (SELECT family_id FROM refresh_tokens WHERE access_jti = $1)
+	    RETURNING access_jti, access_expires_at
+	)
+	INSERT INTO revoked_tokens (jti, expires_at)
+	SELECT access_jti, access_expires_at
+	FROM revoked
+	WHERE access_expires_at > $2
+	ON CONFLICT (jti) DO NOTHING
+`
+	QueryRevokeUserTokens = `
+	WITH revoked AS (
+	    UPDATE refresh_tokens
+	    SET revoked_at = COALESCE(revoked_at, $2)
+	    WHERE user_id = $1
+	    RETURNING access_jti, access_expires_at
+	)
+	INSERT INTO revoked_tokens (jti, expires_at)
+	SELECT access_jti, access_expires_at
+	FROM revoked
+	WHERE access_expires_at > $2
+	ON CONFLICT (jti) DO NOTHING
+`
+	QueryRevokeToken = `
+	INSERT INTO revoked_tokens (jti, expires_at)
+	VALUES ($1, $2)
+	ON CONFLICT (jti) DO NOTHING
+`
+	QueryIsTokenRevoked = `
+	SELECT EXISTS (
+	    SELECT 1
+	    FROM revoked_tokens
+	    WHERE jti = $1
+	)
+`
+	QueryDeleteExpiredRefreshTokens = `
+	DELETE FROM refresh_tokens
+	WHERE expires_at <= $1
+`
+	QueryDeleteExpiredRevokedTokens = `
+	DELETE FROM revoked_tokens
+	WHERE expires_at <= $1
 `
 	QueryGetAllPvz = `
 	SELECT 
 	    id, 
 	    registration_date, 
-	    city
+	    city,
+	    address,
+	    working_hours,
+	    capacity,
+	    status,
+	    archived_at,
+	    latitude,
+	    longitude
 	FROM pvz
+`
+	QueryGetPvzPage = `
+	SELECT 
+	    id, 
+	    registration_date, 
+	    city,
+	    address,
+	    working_hours,
+	    capacity,
+	    status,
+	    archived_at,
+	    latitude,
+	    longitude
+	FROM pvz
+	WHERE $2::timestamp IS NULL OR (registration_date, id) > ($2, $3::uuid)
+	ORDER BY registration_date, id
+	LIMIT $1
+`
+	QueryGetNearbyPvz = `
+	SELECT id, registration_date, city, address, working_hours, capacity, status, archived_at, latitude, longitude, distance
+	FROM (
+		SELECT 
+		    id, 
+		    registration_date, 
+		    city,
+		    address,
+		    working_hours,
+		    capacity,
+		    status,
+		    archived_at,
+		    latitude,
+		    longitude,
+		    2 * 6371000 * ASIN(LEAST(1, SQRT(
+		        POWER(SIN(RADIANS(latitude - $1::float8) / 2), 2) +
+		        COS(RADIANS($1::float8)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - $2::float8) / 2), 2)
+		    ))) AS distance
+		FROM pvz
+		WHERE status = 'active' AND latitude IS NOT NULL
+		  AND latitude BETWEEN $1::float8 - DEGREES($3::float8 / 6371000) AND $1::float8 + DEGREES($3::float8 / 6371000)
+	) nearby_pvz
+	WHERE distance <= $3::float8
+	ORDER BY distance, id
+	LIMIT $4
+`
+	QueryNotifyEvent = `
+	SELECT pg_notify($1, $2)
+`
+	QueryCreateOutboxEvent = `
+	INSERT INTO outbox (id, event_type, payload, created_at, next_attempt_at)
+	VALUES ($1, $2, $3, $4, $4)
+`
+	QueryClaimOutboxEvents = `
+	UPDATE outbox
+	SET attempts = attempts + 1, next_attempt_at = $2
+	WHERE id IN (
+		SELECT id
+		FROM outbox
+		WHERE status = 'pending' AND next_attempt_at <= $3
+		ORDER BY created_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, event_type, payload, created_at, attempts
+`
+	QueryMarkOutboxEventPublished = `
+	UPDATE outbox
+	SET status = 'published', published_at = $2, last_error = NULL
+	WHERE id = $1
+`
+	QueryMarkOutboxEventFailed = `
+	UPDATE outbox
+	SET status = $2, next_attempt_at = $3, last_error = $4
+	WHERE id = $1
+`
+	QueryCreateWebhookDeliveries = `
+	INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, payload, created_at, next_attempt_at)
+	SELECT gen_random_uuid(), id, $1, $2, $3, $4, $4
+	FROM webhook_subscriptions
+	WHERE $2 = ANY (event_types) AND (pvz_id IS NULL OR pvz_id = $5)
+`
+	QueryCreateWebhookSubscription = `
+	INSERT INTO webhook_subscriptions (id, url, secret, event_types, pvz_id, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+`
+	QueryGetWebhookSubscriptions = `
+	SELECT id, url, event_types, pvz_id, created_at
+	FROM webhook_subscriptions
+	ORDER BY created_at
+`
+	QueryDeleteWebhookSubscription = `
+	DELETE FROM webhook_subscriptions
+	WHERE id = $1
+`
+	QueryGetWebhookDeliveries = `
+	SELECT id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, delivered_at, last_error
+	FROM webhook_deliveries
+	WHERE subscription_id = $1 AND ($2::webhook_delivery_status IS NULL OR status = $2)
+	ORDER BY created_at DESC
+	LIMIT $3
+`
+	QueryClaimWebhookDeliveries = `
+	UPDATE webhook_deliveries d
+	SET attempts = d.attempts + 1, next_attempt_at = $2
+	FROM webhook_subscriptions s
+	WHERE d.subscription_id = s.id AND d.id IN (
+		SELECT id
+		FROM webhook_deliveries
+		WHERE status = 'pending' AND next_attempt_at <= $3
+		ORDER BY created_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.created_at, d.attempts, s.url, s.secret
+`
+	QueryMarkWebhookDeliveryDelivered = `
+	UPDATE webhook_deliveries
+	SET status = 'delivered', delivered_at = $2, last_error = NULL
+	WHERE id = $1
+`
+	QueryMarkWebhookDeliveryFailed = `
+	UPDATE webhook_deliveries
+	SET status = $2, next_attempt_at = $3, last_error = $4
+	WHERE id = $1
+`
+	QueryReplayWebhookDelivery = `
+	UPDATE webhook_deliveries
+	SET status = 'pending', attempts = 0, next_attempt_at = $2
+	WHERE id = $1 AND status = 'dead'
+	RETURNING id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, delivered_at, last_error
+`
+	QueryExistsWebhookDelivery = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM webhook_deliveries
+		WHERE id = $1
+	)
+`
+	QueryCreateCity = `
+	INSERT INTO cities (name, region, timezone, reception_auto_close_hours, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+`
+	QueryGetCities = `
+	SELECT name, region, timezone, reception_auto_close_hours, created_at
+	FROM cities
+	ORDER BY name
+`
+	QueryGetCityByName = `
+	SELECT name, region, timezone, reception_auto_close_hours, created_at
+	FROM cities
+	WHERE name = $1
+`
+	QueryUpdateCity = `
+	UPDATE cities
+	SET region = $2, timezone = $3, reception_auto_close_hours = $4
+	WHERE name = $1
+	RETURNING created_at
+`
+	QueryDeleteCity = `
+	DELETE FROM cities
+	WHERE name = $1
+`
+	QueryCountReceptionProductsByType = `
+	SELECT COUNT(*)
+	FROM products
+	WHERE reception_id = $1 AND product_type = $2 AND deleted_at IS NULL
+`
+	QueryCreateProductType = `
+	INSERT INTO product_types (code, display_name_ru, display_name_en, is_fragile, is_oversize, max_per_reception, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+	QueryGetProductTypes = `
+	SELECT code, display_name_ru, display_name_en, is_fragile, is_oversize, max_per_reception, created_at
+	FROM product_types
+	ORDER BY code
+`
+	QueryGetProductTypeByCode = `
+	SELECT code, display_name_ru, display_name_en, is_fragile, is_oversize, max_per_reception, created_at
+	FROM product_types
+	WHERE code = $1
+`
+	QueryUpdateProductType = `
+	UPDATE product_types
+	SET display_name_ru = $2, display_name_en = $3, is_fragile = $4, is_oversize = $5, max_per_reception = $6
+	WHERE code = $1
+	RETURNING created_at
+`
+	QueryDeleteProductType = `
+	DELETE FROM product_types
+	WHERE code = $1
+`
+	QueryLockBarcode = `
+	SELECT pg_advisory_xact_lock(hashtext($1))
+`
+	QueryLockBarcodes = `
+	SELECT pg_advisory_xact_lock(lock_key)
+	FROM (
+		SELECT DISTINCT hashtext(barcode) AS lock_key
+		FROM unnest($1::text[]) AS barcode
+		ORDER BY lock_key
+	) AS keys
+`
+	QueryBarcodesInOpenReception = `
+	SELECT DISTINCT pr.barcode
+	FROM products pr
+	JOIN receptions r ON r.id = pr.reception_id
+	WHERE pr.barcode = ANY($1) AND pr.deleted_at IS NULL AND r.status IN ('in_progress', 'paused')
+`
+	QueryBarcodeInOpenReception = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM products pr
+		JOIN receptions r ON r.id = pr.reception_id
+		WHERE pr.barcode = $1 AND pr.deleted_at IS NULL AND r.status IN ('in_progress', 'paused')
+	)
+`
+	QueryGetProductByBarcode = `
+	SELECT
+		pr.id,
+		pr.adding_time,
+		pr.product_type,
+		pr.barcode,
+		pr.sku,
+		pr.order_id,
+		pr.weight_grams,
+		pr.length_mm,
+		pr.width_mm,
+		pr.height_mm,
+		r.id,
+		r.reception_time,
+		r.status,
+		p.id,
+		p.registration_date,
+		p.city
+	FROM products pr
+	JOIN receptions r ON r.id = pr.reception_id
+	JOIN pvz p ON p.id = r.pvz_id
+	WHERE pr.barcode = $1 AND pr.deleted_at IS NULL
+	ORDER BY r.status IN ('in_progress', 'paused') DESC, pr.adding_time DESC
+	LIMIT 1
 `
 )
@@ -2,12 +2,23 @@ package reception_driver
 
 import (
 	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
 	"github.com/jackc/pgx/v5/pgtype"
+	"time"
 )
 
 type IReceptionDriver interface {
 	CreateReception(ctx context.Context, reception *reception_model.Reception) error
 	CloseReception(ctx context.Context, pvzId pgtype.UUID) (*reception_model.Reception, error)
+	GetReceptionWithProducts(ctx context.Context, id pgtype.UUID) (*pvz_model.ReceptionWithProducts, error)
+	GetReceptions(ctx context.Context, filter reception_model.ReceptionFilter) ([]pvz_model.ReceptionWithProducts, error)
+	CountReceptions(ctx context.Context, filter reception_model.ReceptionFilter) (int, error)
+	GetReception(ctx context.Context, id pgtype.UUID) (*reception_model.Reception, error)
+	UpdateReceptionStatus(ctx context.Context, id pgtype.UUID, from, to reception_model.ReceptionStatus, eventType event_model.EventType) error
+	ReopenReception(ctx context.Context, correction *reception_model.ReceptionCorrection, from reception_model.ReceptionStatus) (*reception_model.Reception, error)
+	AutoCloseStaleReceptions(ctx context.Context, now time.Time, defaultThreshold time.Duration, reason string, statuses []reception_model.ReceptionStatus) ([]reception_model.Reception, error)
+	GetReceptionCorrections(ctx context.Context, receptionId pgtype.UUID) ([]reception_model.ReceptionCorrection, error)
 	GetLastReceptionStatus(ctx context.Context, pvzId pgtype.UUID) (*reception_model.ReceptionStatus, error)
 }
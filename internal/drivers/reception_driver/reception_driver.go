@@ -3,8 +3,12 @@ package reception_driver
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -21,12 +25,58 @@ func NewReceptionDriver(adapter drivers.Adapter) *ReceptionDriver {
 }
 
 func (d *ReceptionDriver) CreateReception(ctx context.Context, reception *reception_model.Reception) error {
-	_, err := d.adapter.Exec(ctx, drivers.QueryCreateReception, reception.Id, reception.ReceptionTime, reception.PvzId, reception.Status)
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	var pvzStatus pvz_model.PvzStatus
+	err = tx.QueryRow(ctx, drivers.QueryGetPvzStatusForShare, reception.PvzId).Scan(&pvzStatus)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrNoPvz.Message)
+		return custom_errors.ErrNoPvz
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetPvz.Message)
+		return custom_errors.ErrGetPvz
+	}
+
+	if pvzStatus == pvz_model.Archived {
+		log.Warn().Msg(custom_errors.ErrPvzArchived.Message)
+		return custom_errors.ErrPvzArchived
+	}
+
+	if pvzStatus != pvz_model.Active {
+		log.Warn().Msg(custom_errors.ErrPvzInactive.Message)
+		return custom_errors.ErrPvzInactive
+	}
+
+	_, err = tx.Exec(ctx, drivers.QueryCreateReception, reception.Id, reception.ReceptionTime, reception.PvzId, reception.Status)
+	if drivers.IsPgError(err, drivers.UniqueViolationCode) {
+		log.Warn().Err(err).Msg(custom_errors.ErrInProgressReception.Message)
+		return custom_errors.ErrInProgressReception
+	}
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrCreateReception.Message)
 		return custom_errors.ErrCreateReception
 	}
 
+	err = drivers.CreateOutboxEventWithDeliveries(ctx, tx, &event_model.Event{
+		Type:        event_model.ReceptionOpened,
+		PvzId:       reception.PvzId,
+		ReceptionId: reception.Id,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+		return custom_errors.ErrCommitTransaction
+	}
+
 	return nil
 }
 
@@ -43,26 +93,248 @@ func (d *ReceptionDriver) CloseReception(ctx context.Context, pvzId pgtype.UUID)
 		return nil, err
 	}
 
-	_, err = tx.Exec(ctx, drivers.QueryCloseReception, receptionId)
+	_, err = tx.Exec(ctx, drivers.QueryCloseReception, receptionId, time.Now())
 
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrCloseReception.Message)
 		return nil, custom_errors.ErrCloseReception
 	}
 
+	err = drivers.CreateOutboxEventWithDeliveries(ctx, tx, &event_model.Event{
+		Type:        event_model.ReceptionClosed,
+		PvzId:       pvzId,
+		ReceptionId: receptionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
 		return nil, custom_errors.ErrCommitTransaction
 	}
 
-	reception, err := d.getReception(ctx, receptionId)
+	reception, err := d.GetReception(ctx, receptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return reception, nil
+}
+
+func (d *ReceptionDriver) ReopenReception(ctx context.Context, correction *reception_model.ReceptionCorrection, from reception_model.ReceptionStatus) (*reception_model.Reception, error) {
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return nil, custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	var receptionTime time.Time
+	var pvzId pgtype.UUID
+	var status reception_model.ReceptionStatus
+	var closedAt *time.Time
+	err = tx.QueryRow(ctx, drivers.QueryGetReceptionForUpdate, correction.ReceptionId).Scan(&receptionTime, &pvzId, &status, &closedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrReceptionNotFound.Message)
+		return nil, custom_errors.ErrReceptionNotFound
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetReception.Message)
+		return nil, custom_errors.ErrGetReception
+	}
+
+	if status != from {
+		log.Warn().Msg(custom_errors.ErrReceptionChanged.Message)
+		return nil, custom_errors.ErrReceptionChanged
+	}
+
+	var newerExists bool
+	err = tx.QueryRow(ctx, drivers.QueryExistsNewerReception, pvzId, correction.ReceptionId, receptionTime).Scan(&newerExists)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCheckNewerReception.Message)
+		return nil, custom_errors.ErrCheckNewerReception
+	}
+
+	if newerExists {
+		log.Warn().Msg(custom_errors.ErrNewerReception.Message)
+		return nil, custom_errors.ErrNewerReception
+	}
+
+	_, err = tx.Exec(ctx, drivers.QueryReopenReception, correction.ReceptionId, correction.ReopenedAt)
+	if drivers.IsPgError(err, drivers.UniqueViolationCode) {
+		log.Warn().Err(err).Msg(custom_errors.ErrInProgressReception.Message)
+		return nil, custom_errors.ErrInProgressReception
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrReopenReception.Message)
+		return nil, custom_errors.ErrReopenReception
+	}
+
+	correction.ClosedAt = closedAt
+	_, err = tx.Exec(ctx, drivers.QueryCreateReceptionCorrection,
+		correction.Id, correction.ReceptionId, correction.ClosedAt, correction.ReopenedBy, correction.ReopenedAt, correction.Reason)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrReopenReception.Message)
+		return nil, custom_errors.ErrReopenReception
+	}
+
+	err = drivers.CreateOutboxEventWithDeliveries(ctx, tx, &event_model.Event{
+		Type:        event_model.ReceptionReopened,
+		PvzId:       pvzId,
+		ReceptionId: correction.ReceptionId,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	if err = tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+		return nil, custom_errors.ErrCommitTransaction
+	}
+
+	reception := &reception_model.Reception{
+		Id:            correction.ReceptionId,
+		ReceptionTime: receptionTime,
+		PvzId:         pvzId,
+		Status:        reception_model.InProgress,
+	}
 	return reception, nil
 }
 
+func (d *ReceptionDriver) UpdateReceptionStatus(ctx context.Context, id pgtype.UUID, from, to reception_model.ReceptionStatus, eventType event_model.EventType) error {
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	var pvzId pgtype.UUID
+	err = tx.QueryRow(ctx, drivers.QueryUpdateReceptionStatus, id, from, to).Scan(&pvzId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrReceptionChanged.Message)
+		return custom_errors.ErrReceptionChanged
+	}
+	if drivers.IsPgError(err, drivers.UniqueViolationCode) {
+		log.Warn().Err(err).Msg(custom_errors.ErrInProgressReception.Message)
+		return custom_errors.ErrInProgressReception
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdateReceptionStatus.Message)
+		return custom_errors.ErrUpdateReceptionStatus
+	}
+
+	err = drivers.CreateOutboxEventWithDeliveries(ctx, tx, &event_model.Event{
+		Type:        eventType,
+		PvzId:       pvzId,
+		ReceptionId: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+		return custom_errors.ErrCommitTransaction
+	}
+
+	return nil
+}
+
+func (d *ReceptionDriver) AutoCloseStaleReceptions(ctx context.Context, now time.Time, defaultThreshold time.Duration, reason string, statuses []reception_model.ReceptionStatus) ([]reception_model.Reception, error) {
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return nil, custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	err = tx.QueryRow(ctx, drivers.QueryTryAdvisoryLock, drivers.ReceptionAutoCloseLockKey).Scan(&locked)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrAutoCloseLock.Message)
+		return nil, custom_errors.ErrAutoCloseLock
+	}
+
+	if !locked {
+		return make([]reception_model.Reception, 0), nil
+	}
+
+	statusValues := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		statusValues = append(statusValues, string(status))
+	}
+
+	rows, err := tx.Query(ctx, drivers.QueryAutoCloseReceptions, now, reason, defaultThreshold, statusValues)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrAutoCloseReceptions.Message)
+		return nil, custom_errors.ErrAutoCloseReceptions
+	}
+	defer rows.Close()
+
+	receptions := make([]reception_model.Reception, 0)
+	for rows.Next() {
+		reception := reception_model.Reception{Status: reception_model.AutoClosed, CloseReason: &reason}
+		if err = rows.Scan(&reception.Id, &reception.ReceptionTime, &reception.PvzId); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+		receptions = append(receptions, reception)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrAutoCloseReceptions.Message)
+		return nil, custom_errors.ErrAutoCloseReceptions
+	}
+	rows.Close()
+
+	for i := range receptions {
+		err = drivers.CreateOutboxEventWithDeliveries(ctx, tx, &event_model.Event{
+			Type:        event_model.ReceptionClosed,
+			PvzId:       receptions[i].PvzId,
+			ReceptionId: receptions[i].Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+		return nil, custom_errors.ErrCommitTransaction
+	}
+
+	return receptions, nil
+}
+
+func (d *ReceptionDriver) GetReceptionCorrections(ctx context.Context, receptionId pgtype.UUID) ([]reception_model.ReceptionCorrection, error) {
+	rows, err := d.adapter.Query(ctx, drivers.QueryGetReceptionCorrections, receptionId)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetCorrections.Message)
+		return nil, custom_errors.ErrGetCorrections
+	}
+	defer rows.Close()
+
+	corrections := make([]reception_model.ReceptionCorrection, 0)
+	for rows.Next() {
+		correction := reception_model.ReceptionCorrection{ReceptionId: receptionId}
+		if err = rows.Scan(&correction.Id, &correction.ClosedAt, &correction.ReopenedBy, &correction.ReopenedAt, &correction.Reason); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+		corrections = append(corrections, correction)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetCorrections.Message)
+		return nil, custom_errors.ErrGetCorrections
+	}
+
+	return corrections, nil
+}
+
 func (d *ReceptionDriver) GetLastReceptionStatus(ctx context.Context, pvzId pgtype.UUID) (*reception_model.ReceptionStatus, error) {
 	var status reception_model.ReceptionStatus
 	err := d.adapter.QueryRow(ctx, drivers.QueryGetLastReceptionStatus, pvzId).Scan(&status)
@@ -78,16 +350,160 @@ func (d *ReceptionDriver) GetLastReceptionStatus(ctx context.Context, pvzId pgty
 	return &status, nil
 }
 
-func (d *ReceptionDriver) getReception(ctx context.Context, id pgtype.UUID) (*reception_model.Reception, error) {
+func (d *ReceptionDriver) GetReception(ctx context.Context, id pgtype.UUID) (*reception_model.Reception, error) {
 	var receptionTime time.Time
 	var pvzId pgtype.UUID
 	var status reception_model.ReceptionStatus
-	err := d.adapter.QueryRow(ctx, drivers.QueryGetReception, id).Scan(&receptionTime, &pvzId, &status)
+	var closeReason *string
+	err := d.adapter.QueryRow(ctx, drivers.QueryGetReception, id).Scan(&receptionTime, &pvzId, &status, &closeReason)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrReceptionNotFound.Message)
+		return nil, custom_errors.ErrReceptionNotFound
+	}
+
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrGetReception.Message)
 		return nil, custom_errors.ErrGetReception
 	}
 
-	reception := &reception_model.Reception{Id: id, PvzId: pvzId, ReceptionTime: receptionTime, Status: status}
+	reception := &reception_model.Reception{Id: id, PvzId: pvzId, ReceptionTime: receptionTime, Status: status, CloseReason: closeReason}
 	return reception, nil
 }
+
+func (d *ReceptionDriver) GetReceptionWithProducts(ctx context.Context, id pgtype.UUID) (*pvz_model.ReceptionWithProducts, error) {
+	reception, err := d.GetReception(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := d.getReceptionsProducts(ctx, []pgtype.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+
+	receptionWithProducts := &pvz_model.ReceptionWithProducts{Reception: *reception, Products: products[id]}
+	if receptionWithProducts.Products == nil {
+		receptionWithProducts.Products = make([]product_model.Product, 0)
+	}
+
+	return receptionWithProducts, nil
+}
+
+func (d *ReceptionDriver) GetReceptions(ctx context.Context, filter reception_model.ReceptionFilter) ([]pvz_model.ReceptionWithProducts, error) {
+	params := []interface{}{filter.PvzId}
+	conditions := getReceptionConditions(filter, &params)
+	query := fmt.Sprintf(drivers.QueryGetReceptions, conditions,
+		drivers.AddParam(&params, filter.Limit), drivers.AddParam(&params, filter.Offset))
+
+	rows, err := d.adapter.Query(ctx, query, params...)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetReceptions.Message)
+		return nil, custom_errors.ErrGetReceptions
+	}
+	defer rows.Close()
+
+	receptions := make([]pvz_model.ReceptionWithProducts, 0)
+	receptionIds := make([]pgtype.UUID, 0)
+	for rows.Next() {
+		reception := reception_model.Reception{PvzId: filter.PvzId}
+		if err = rows.Scan(&reception.Id, &reception.ReceptionTime, &reception.Status, &reception.CloseReason); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+		receptions = append(receptions, pvz_model.ReceptionWithProducts{Reception: reception})
+		receptionIds = append(receptionIds, reception.Id)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetReceptions.Message)
+		return nil, custom_errors.ErrGetReceptions
+	}
+
+	if len(receptionIds) == 0 {
+		return receptions, nil
+	}
+
+	products, err := d.getReceptionsProducts(ctx, receptionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range receptions {
+		receptions[i].Products = products[receptions[i].Reception.Id]
+		if receptions[i].Products == nil {
+			receptions[i].Products = make([]product_model.Product, 0)
+		}
+	}
+
+	return receptions, nil
+}
+
+func (d *ReceptionDriver) CountReceptions(ctx context.Context, filter reception_model.ReceptionFilter) (int, error) {
+	params := []interface{}{filter.PvzId}
+	query := fmt.Sprintf(drivers.QueryCountReceptions, getReceptionConditions(filter, &params))
+
+	var total int
+	err := d.adapter.QueryRow(ctx, query, params...).Scan(&total)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetReceptions.Message)
+		return 0, custom_errors.ErrGetReceptions
+	}
+
+	return total, nil
+}
+
+func (d *ReceptionDriver) getReceptionsProducts(ctx context.Context, receptionIds []pgtype.UUID) (map[pgtype.UUID][]product_model.Product, error) {
+	rows, err := d.adapter.Query(ctx, drivers.QueryGetReceptionsProducts, receptionIds)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetProduct.Message)
+		return nil, custom_errors.ErrGetProduct
+	}
+	defer rows.Close()
+
+	products := make(map[pgtype.UUID][]product_model.Product)
+	for rows.Next() {
+		var product product_model.Product
+		var length, width, height *int
+		err = rows.Scan(
+			&product.Id,
+			&product.AddingTime,
+			&product.ProductType,
+			&product.ReceptionId,
+			&product.Barcode,
+			&product.Sku,
+			&product.OrderId,
+			&product.WeightGrams,
+			&length,
+			&width,
+			&height,
+		)
+		if err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+		product.Dimensions = drivers.GetDimensions(length, width, height)
+		products[product.ReceptionId] = append(products[product.ReceptionId], product)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetProduct.Message)
+		return nil, custom_errors.ErrGetProduct
+	}
+
+	return products, nil
+}
+
+func getReceptionConditions(filter reception_model.ReceptionFilter, params *[]interface{}) string {
+	conditions := ""
+	if filter.Status != nil {
+		conditions += " AND status = " + drivers.AddParam(params, *filter.Status)
+	}
+	if filter.StartInterval != nil {
+		conditions += " AND reception_time >= " + drivers.AddParam(params, *filter.StartInterval)
+	}
+	if filter.EndInterval != nil {
+		conditions += " AND reception_time <= " + drivers.AddParam(params, *filter.EndInterval)
+	}
+
+	return conditions
+}
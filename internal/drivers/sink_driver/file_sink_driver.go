@@ -0,0 +1,38 @@
+package sink_driver
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/outbox_model"
+	"github.com/rs/zerolog/log"
+	"io"
+	"sync"
+)
+
+type FileSinkDriver struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+func NewFileSinkDriver(writer io.Writer) *FileSinkDriver {
+	return &FileSinkDriver{writer: writer}
+}
+
+func (d *FileSinkDriver) Send(ctx context.Context, event *outbox_model.OutboxEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrMarshalEvent.Message)
+		return custom_errors.ErrMarshalEvent
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, err = d.writer.Write(append(line, '\n')); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrSendOutboxEvent.Message)
+		return custom_errors.ErrSendOutboxEvent
+	}
+
+	return nil
+}
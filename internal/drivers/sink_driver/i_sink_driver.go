@@ -0,0 +1,10 @@
+package sink_driver
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/outbox_model"
+)
+
+type ISinkDriver interface {
+	Send(ctx context.Context, event *outbox_model.OutboxEvent) error
+}
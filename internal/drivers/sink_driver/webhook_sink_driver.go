@@ -0,0 +1,54 @@
+package sink_driver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/outbox_model"
+	"github.com/rs/zerolog/log"
+	"net/http"
+	"time"
+)
+
+const webhookTimeout = 10 * time.Second
+
+type WebhookSinkDriver struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSinkDriver(url string) *WebhookSinkDriver {
+	return &WebhookSinkDriver{url: url, client: &http.Client{Timeout: webhookTimeout}}
+}
+
+func (d *WebhookSinkDriver) Send(ctx context.Context, event *outbox_model.OutboxEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrMarshalEvent.Message)
+		return custom_errors.ErrMarshalEvent
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader(body))
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrSendOutboxEvent.Message)
+		return custom_errors.ErrSendOutboxEvent
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", event.Id.String())
+	req.Header.Set("X-Event-Type", event.EventType)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrSendOutboxEvent.Message)
+		return custom_errors.ErrSendOutboxEvent
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Error().Int("status", resp.StatusCode).Msg(custom_errors.ErrUnexpectedStatusCode.Message)
+		return custom_errors.ErrUnexpectedStatusCode
+	}
+
+	return nil
+}
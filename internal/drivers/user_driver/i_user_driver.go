@@ -4,10 +4,17 @@ import (
 	"context"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
 	"github.com/jackc/pgx/v5/pgtype"
+	"time"
 )
 
 type IUserDriver interface {
 	CreateUser(ctx context.Context, user *user_model.User) error
 	GetUserByEmail(ctx context.Context, email string) (*user_model.User, error)
 	GetUserById(ctx context.Context, id pgtype.UUID) (*user_model.User, error)
+	CreateRefreshToken(ctx context.Context, token *user_model.RefreshToken) error
+	RotateRefreshToken(ctx context.Context, tokenHash []byte, newToken *user_model.RefreshToken, now time.Time) error
+	RevokeSession(ctx context.Context, jti pgtype.UUID, expiresAt time.Time, now time.Time) error
+	RevokeUserSessions(ctx context.Context, userId pgtype.UUID, now time.Time) error
+	IsTokenRevoked(ctx context.Context, jti pgtype.UUID) (bool, error)
+	DeleteExpiredTokens(ctx context.Context, now time.Time) error
 }
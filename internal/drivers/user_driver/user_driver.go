@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog/log"
+	"time"
 )
 
 type UserDriver struct {
@@ -66,3 +67,136 @@ func (d *UserDriver) GetUserById(ctx context.Context, id pgtype.UUID) (*user_mod
 	user := &user_model.User{Id: id, Email: email, PasswordHash: passwordHash, Role: userRole}
 	return user, nil
 }
+
+func (d *UserDriver) CreateRefreshToken(ctx context.Context, token *user_model.RefreshToken) error {
+	_, err := d.adapter.Exec(ctx, drivers.QueryCreateRefreshToken, token.Id, token.FamilyId, token.UserId, token.TokenHash,
+		token.AccessJti, token.AccessExpiresAt, token.CreatedAt, token.ExpiresAt)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCreateRefreshToken.Message)
+		return custom_errors.ErrCreateRefreshToken
+	}
+
+	return nil
+}
+
+func (d *UserDriver) RotateRefreshToken(ctx context.Context, tokenHash []byte, newToken *user_model.RefreshToken, now time.Time) error {
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	var id, familyId, userId pgtype.UUID
+	var expiresAt time.Time
+	var revokedAt *time.Time
+	err = tx.QueryRow(ctx, drivers.QueryGetRefreshTokenForUpdate, tokenHash).Scan(&id, &familyId, &userId, &expiresAt, &revokedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Warn().Msg(custom_errors.ErrRefreshToken.Message)
+		return custom_errors.ErrRefreshToken
+	}
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrRotateRefreshToken.Message)
+		return custom_errors.ErrRotateRefreshToken
+	}
+
+	if revokedAt != nil {
+		if _, err = tx.Exec(ctx, drivers.QueryRevokeTokenFamily, familyId, now); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrRevokeTokens.Message)
+			return custom_errors.ErrRevokeTokens
+		}
+
+		if err = tx.Commit(ctx); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+			return custom_errors.ErrCommitTransaction
+		}
+
+		log.Warn().Msg(custom_errors.ErrRefreshTokenReused.Message)
+		return custom_errors.ErrRefreshTokenReused
+	}
+
+	if !expiresAt.After(now) {
+		log.Warn().Msg(custom_errors.ErrRefreshTokenExpired.Message)
+		return custom_errors.ErrRefreshTokenExpired
+	}
+
+	if _, err = tx.Exec(ctx, drivers.QueryRevokeRefreshToken, id, now); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrRotateRefreshToken.Message)
+		return custom_errors.ErrRotateRefreshToken
+	}
+
+	newToken.FamilyId = familyId
+	newToken.UserId = userId
+	_, err = tx.Exec(ctx, drivers.QueryCreateRefreshToken, newToken.Id, newToken.FamilyId, newToken.UserId, newToken.TokenHash,
+		newToken.AccessJti, newToken.AccessExpiresAt, newToken.CreatedAt, newToken.ExpiresAt)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCreateRefreshToken.Message)
+		return custom_errors.ErrCreateRefreshToken
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+		return custom_errors.ErrCommitTransaction
+	}
+
+	return nil
+}
+
+func (d *UserDriver) RevokeSession(ctx context.Context, jti pgtype.UUID, expiresAt time.Time, now time.Time) error {
+	tx, err := d.adapter.Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrBeginTransaction.Message)
+		return custom_errors.ErrBeginTransaction
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, drivers.QueryRevokeToken, jti, expiresAt); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrRevokeTokens.Message)
+		return custom_errors.ErrRevokeTokens
+	}
+
+	if _, err = tx.Exec(ctx, drivers.QueryRevokeSession, jti, now); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrRevokeTokens.Message)
+		return custom_errors.ErrRevokeTokens
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCommitTransaction.Message)
+		return custom_errors.ErrCommitTransaction
+	}
+
+	return nil
+}
+
+func (d *UserDriver) RevokeUserSessions(ctx context.Context, userId pgtype.UUID, now time.Time) error {
+	if _, err := d.adapter.Exec(ctx, drivers.QueryRevokeUserTokens, userId, now); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrRevokeTokens.Message)
+		return custom_errors.ErrRevokeTokens
+	}
+
+	return nil
+}
+
+func (d *UserDriver) IsTokenRevoked(ctx context.Context, jti pgtype.UUID) (bool, error) {
+	var revoked bool
+	if err := d.adapter.QueryRow(ctx, drivers.QueryIsTokenRevoked, jti).Scan(&revoked); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCheckTokenRevoked.Message)
+		return false, custom_errors.ErrCheckTokenRevoked
+	}
+
+	return revoked, nil
+}
+
+func (d *UserDriver) DeleteExpiredTokens(ctx context.Context, now time.Time) error {
+	if _, err := d.adapter.Exec(ctx, drivers.QueryDeleteExpiredRefreshTokens, now); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrDeleteExpiredTokens.Message)
+		return custom_errors.ErrDeleteExpiredTokens
+	}
+
+	if _, err := d.adapter.Exec(ctx, drivers.QueryDeleteExpiredRevokedTokens, now); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrDeleteExpiredTokens.Message)
+		return custom_errors.ErrDeleteExpiredTokens
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package webhook_driver
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/webhook_model"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type IWebhookDriver interface {
+	CreateSubscription(ctx context.Context, subscription *webhook_model.Subscription) error
+	GetSubscriptions(ctx context.Context) ([]webhook_model.Subscription, error)
+	DeleteSubscription(ctx context.Context, id pgtype.UUID) error
+	GetDeliveries(ctx context.Context, subscriptionId pgtype.UUID, status *webhook_model.DeliveryStatus, limit int) ([]webhook_model.Delivery, error)
+	ClaimPendingDeliveries(ctx context.Context, limit int, now, leaseUntil time.Time) ([]webhook_model.Delivery, error)
+	MarkDelivered(ctx context.Context, id pgtype.UUID, deliveredAt time.Time) error
+	MarkFailed(ctx context.Context, delivery *webhook_model.Delivery) error
+	ReplayDelivery(ctx context.Context, id pgtype.UUID, now time.Time) (*webhook_model.Delivery, error)
+}
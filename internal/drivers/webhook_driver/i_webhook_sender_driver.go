@@ -0,0 +1,10 @@
+package webhook_driver
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/webhook_model"
+)
+
+type IWebhookSenderDriver interface {
+	Send(ctx context.Context, delivery *webhook_model.Delivery) error
+}
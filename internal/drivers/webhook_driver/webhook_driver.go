@@ -0,0 +1,211 @@
+package webhook_driver
+
+import (
+	"context"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/webhook_model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"sort"
+	"time"
+)
+
+type WebhookDriver struct {
+	adapter drivers.Adapter
+}
+
+func NewWebhookDriver(adapter drivers.Adapter) *WebhookDriver {
+	return &WebhookDriver{adapter: adapter}
+}
+
+func (d *WebhookDriver) CreateSubscription(ctx context.Context, subscription *webhook_model.Subscription) error {
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	_, err := d.adapter.Exec(ctx, drivers.QueryCreateWebhookSubscription,
+		subscription.Id, subscription.Url, subscription.Secret, eventTypes, subscription.PvzId, subscription.CreatedAt)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCreateWebhookSubscription.Message)
+		return custom_errors.ErrCreateWebhookSubscription
+	}
+
+	return nil
+}
+
+func (d *WebhookDriver) GetSubscriptions(ctx context.Context) ([]webhook_model.Subscription, error) {
+	rows, err := d.adapter.Query(ctx, drivers.QueryGetWebhookSubscriptions)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetWebhookSubscriptions.Message)
+		return nil, custom_errors.ErrGetWebhookSubscriptions
+	}
+	defer rows.Close()
+
+	subscriptions := make([]webhook_model.Subscription, 0)
+	for rows.Next() {
+		var subscription webhook_model.Subscription
+		var eventTypes []string
+		if err = rows.Scan(&subscription.Id, &subscription.Url, &eventTypes, &subscription.PvzId, &subscription.CreatedAt); err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+
+		for _, eventType := range eventTypes {
+			subscription.EventTypes = append(subscription.EventTypes, event_model.EventType(eventType))
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetWebhookSubscriptions.Message)
+		return nil, custom_errors.ErrGetWebhookSubscriptions
+	}
+
+	return subscriptions, nil
+}
+
+func (d *WebhookDriver) DeleteSubscription(ctx context.Context, id pgtype.UUID) error {
+	tag, err := d.adapter.Exec(ctx, drivers.QueryDeleteWebhookSubscription, id)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrDeleteWebhookSubscription.Message)
+		return custom_errors.ErrDeleteWebhookSubscription
+	}
+
+	if tag.RowsAffected() == 0 {
+		log.Warn().Msg(custom_errors.ErrWebhookNotFound.Message)
+		return custom_errors.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (d *WebhookDriver) GetDeliveries(ctx context.Context, subscriptionId pgtype.UUID, status *webhook_model.DeliveryStatus, limit int) ([]webhook_model.Delivery, error) {
+	var statusParam *string
+	if status != nil {
+		value := string(*status)
+		statusParam = &value
+	}
+
+	rows, err := d.adapter.Query(ctx, drivers.QueryGetWebhookDeliveries, subscriptionId, statusParam, limit)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetWebhookDeliveries.Message)
+		return nil, custom_errors.ErrGetWebhookDeliveries
+	}
+	defer rows.Close()
+
+	deliveries := make([]webhook_model.Delivery, 0)
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGetWebhookDeliveries.Message)
+		return nil, custom_errors.ErrGetWebhookDeliveries
+	}
+
+	return deliveries, nil
+}
+
+func (d *WebhookDriver) ClaimPendingDeliveries(ctx context.Context, limit int, now, leaseUntil time.Time) ([]webhook_model.Delivery, error) {
+	rows, err := d.adapter.Query(ctx, drivers.QueryClaimWebhookDeliveries, limit, leaseUntil, now)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrClaimWebhookDeliveries.Message)
+		return nil, custom_errors.ErrClaimWebhookDeliveries
+	}
+	defer rows.Close()
+
+	var deliveries []webhook_model.Delivery
+	for rows.Next() {
+		delivery := webhook_model.Delivery{Status: webhook_model.Pending, NextAttemptAt: leaseUntil}
+		err = rows.Scan(&delivery.Id, &delivery.SubscriptionId, &delivery.EventId, &delivery.EventType, &delivery.Payload,
+			&delivery.CreatedAt, &delivery.Attempts, &delivery.Url, &delivery.Secret)
+		if err != nil {
+			log.Error().Err(err).Msg(custom_errors.ErrScanRow.Message)
+			return nil, custom_errors.ErrScanRow
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrClaimWebhookDeliveries.Message)
+		return nil, custom_errors.ErrClaimWebhookDeliveries
+	}
+
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+	})
+
+	return deliveries, nil
+}
+
+func (d *WebhookDriver) MarkDelivered(ctx context.Context, id pgtype.UUID, deliveredAt time.Time) error {
+	_, err := d.adapter.Exec(ctx, drivers.QueryMarkWebhookDeliveryDelivered, id, deliveredAt)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdateWebhookDelivery.Message)
+		return custom_errors.ErrUpdateWebhookDelivery
+	}
+
+	return nil
+}
+
+func (d *WebhookDriver) MarkFailed(ctx context.Context, delivery *webhook_model.Delivery) error {
+	_, err := d.adapter.Exec(ctx, drivers.QueryMarkWebhookDeliveryFailed, delivery.Id, delivery.Status, delivery.NextAttemptAt, delivery.LastError)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUpdateWebhookDelivery.Message)
+		return custom_errors.ErrUpdateWebhookDelivery
+	}
+
+	return nil
+}
+
+func (d *WebhookDriver) ReplayDelivery(ctx context.Context, id pgtype.UUID, now time.Time) (*webhook_model.Delivery, error) {
+	delivery, err := scanDelivery(d.adapter.QueryRow(ctx, drivers.QueryReplayWebhookDelivery, id, now))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, d.replayDeliveryError(ctx, id)
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrReplayWebhookDelivery.Message)
+		return nil, custom_errors.ErrReplayWebhookDelivery
+	}
+
+	return delivery, nil
+}
+
+func (d *WebhookDriver) replayDeliveryError(ctx context.Context, id pgtype.UUID) error {
+	var exists bool
+	err := d.adapter.QueryRow(ctx, drivers.QueryExistsWebhookDelivery, id).Scan(&exists)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrReplayWebhookDelivery.Message)
+		return custom_errors.ErrReplayWebhookDelivery
+	}
+
+	if !exists {
+		log.Warn().Msg(custom_errors.ErrWebhookDeliveryNotFound.Message)
+		return custom_errors.ErrWebhookDeliveryNotFound
+	}
+
+	log.Warn().Msg(custom_errors.ErrWebhookDeliveryDead.Message)
+	return custom_errors.ErrWebhookDeliveryDead
+}
+
+func scanDelivery(row pgx.Row) (*webhook_model.Delivery, error) {
+	var delivery webhook_model.Delivery
+	err := row.Scan(&delivery.Id, &delivery.SubscriptionId, &delivery.EventId, &delivery.EventType, &delivery.Payload,
+		&delivery.Status, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.CreatedAt, &delivery.DeliveredAt, &delivery.LastError)
+	if err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
@@ -0,0 +1,60 @@
+package webhook_driver
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/webhook_model"
+	"github.com/rs/zerolog/log"
+	"net/http"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Pvz-Signature-256"
+	senderTimeout   = 10 * time.Second
+)
+
+type WebhookSenderDriver struct {
+	client *http.Client
+}
+
+func NewWebhookSenderDriver() *WebhookSenderDriver {
+	return &WebhookSenderDriver{client: &http.Client{Timeout: senderTimeout}}
+}
+
+func (d *WebhookSenderDriver) Send(ctx context.Context, delivery *webhook_model.Delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrSendWebhook.Message)
+		return custom_errors.ErrSendWebhook
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", delivery.EventId.String())
+	req.Header.Set("X-Event-Type", string(delivery.EventType))
+	req.Header.Set("X-Delivery-Id", delivery.Id.String())
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrSendWebhook.Message)
+		return custom_errors.ErrSendWebhook
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Error().Int("status", resp.StatusCode).Msg(custom_errors.ErrUnexpectedStatusCode.Message)
+		return custom_errors.ErrUnexpectedStatusCode
+	}
+
+	return nil
+}
+
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
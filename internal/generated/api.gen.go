@@ -17,24 +17,33 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for PVZCity.
+// Defines values for JwkAlg.
 const (
-	Казань         PVZCity = "Казань"
-	Москва         PVZCity = "Москва"
-	СанктПетербург PVZCity = "Санкт-Петербург"
+	EdDSA JwkAlg = "EdDSA"
+	RS256 JwkAlg = "RS256"
 )
 
-// Defines values for ProductType.
+// Defines values for JwkKty.
 const (
-	ProductTypeОбувь       ProductType = "обувь"
-	ProductTypeОдежда      ProductType = "одежда"
-	ProductTypeЭлектроника ProductType = "электроника"
+	OKP JwkKty = "OKP"
+	RSA JwkKty = "RSA"
+)
+
+// Defines values for PVZStatus.
+const (
+	Active   PVZStatus = "active"
+	Archived PVZStatus = "archived"
+	Inactive PVZStatus = "inactive"
 )
 
 // Defines values for ReceptionStatus.
 const (
+	AutoClosed ReceptionStatus = "auto_closed"
+	Cancelled  ReceptionStatus = "cancelled"
 	Close      ReceptionStatus = "close"
 	InProgress ReceptionStatus = "in_progress"
+	Paused     ReceptionStatus = "paused"
+	Verified   ReceptionStatus = "verified"
 )
 
 // Defines values for UserRole.
@@ -43,17 +52,36 @@ const (
 	UserRoleModerator UserRole = "moderator"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookEventType.
+const (
+	ProductAdded       WebhookEventType = "product_added"
+	ProductDeleted     WebhookEventType = "product_deleted"
+	ReceptionCancelled WebhookEventType = "reception_cancelled"
+	ReceptionClosed    WebhookEventType = "reception_closed"
+	ReceptionOpened    WebhookEventType = "reception_opened"
+	ReceptionPaused    WebhookEventType = "reception_paused"
+	ReceptionReopened  WebhookEventType = "reception_reopened"
+	ReceptionResumed   WebhookEventType = "reception_resumed"
+	ReceptionVerified  WebhookEventType = "reception_verified"
+)
+
 // Defines values for PostDummyLoginJSONBodyRole.
 const (
 	PostDummyLoginJSONBodyRoleEmployee  PostDummyLoginJSONBodyRole = "employee"
 	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for GetPvzParamsSortBy.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	LastActivity     GetPvzParamsSortBy = "lastActivity"
+	RegistrationDate GetPvzParamsSortBy = "registrationDate"
 )
 
 // Defines values for PostRegisterJSONBodyRole.
@@ -62,46 +90,273 @@ const (
 	Moderator PostRegisterJSONBodyRole = "moderator"
 )
 
+// Defines values for GetWebhooksWebhookIdDeliveriesParamsStatus.
+const (
+	GetWebhooksWebhookIdDeliveriesParamsStatusDead      GetWebhooksWebhookIdDeliveriesParamsStatus = "dead"
+	GetWebhooksWebhookIdDeliveriesParamsStatusDelivered GetWebhooksWebhookIdDeliveriesParamsStatus = "delivered"
+	GetWebhooksWebhookIdDeliveriesParamsStatusPending   GetWebhooksWebhookIdDeliveriesParamsStatus = "pending"
+)
+
+// City defines model for City.
+type City struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Name      string     `json:"name"`
+
+	// ReceptionAutoCloseHours Через сколько часов незакрытая приемка закрывается автоматически, по умолчанию значение из настроек сервиса
+	ReceptionAutoCloseHours *int   `json:"receptionAutoCloseHours,omitempty"`
+	Region                  string `json:"region"`
+
+	// Timezone Часовой пояс в формате IANA, например Europe/Moscow
+	Timezone string `json:"timezone"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
 }
 
+// Jwk defines model for Jwk.
+type Jwk struct {
+	Alg JwkAlg `json:"alg"`
+
+	// Crv Кривая OKP-ключа
+	Crv *string `json:"crv,omitempty"`
+
+	// E Экспонента RSA-ключа (base64url)
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty JwkKty  `json:"kty"`
+
+	// N Модуль RSA-ключа (base64url)
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+
+	// X Публичный OKP-ключ (base64url)
+	X *string `json:"x,omitempty"`
+}
+
+// JwkAlg defines model for Jwk.Alg.
+type JwkAlg string
+
+// JwkKty defines model for Jwk.Kty.
+type JwkKty string
+
+// Jwks defines model for Jwks.
+type Jwks struct {
+	Keys []Jwk `json:"keys"`
+}
+
+// NearbyPVZ defines model for NearbyPVZ.
+type NearbyPVZ struct {
+	// Distance Расстояние до ПВЗ в метрах
+	Distance float64 `json:"distance"`
+	Pvz      PVZ     `json:"pvz"`
+}
+
 // PVZ defines model for PVZ.
 type PVZ struct {
-	City             PVZCity             `json:"city"`
-	Id               *openapi_types.UUID `json:"id,omitempty"`
-	RegistrationDate *time.Time          `json:"registrationDate,omitempty"`
+	Address    *string    `json:"address,omitempty"`
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
+
+	// Capacity Вместимость ПВЗ (количество товаров)
+	Capacity *int                `json:"capacity,omitempty"`
+	City     string              `json:"city"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+
+	// Latitude Широта ПВЗ, задается вместе с долготой
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Longitude Долгота ПВЗ, задается вместе с широтой
+	Longitude        *float64   `json:"longitude,omitempty"`
+	RegistrationDate *time.Time `json:"registrationDate,omitempty"`
+
+	// Status В деактивированном (inactive) ПВЗ нельзя создавать приемки, пока модератор не активирует его снова.
+	// Архивный (archived) ПВЗ нельзя изменить, активировать или открыть в нем приемку, история приемок сохраняется.
+	Status *PVZStatus `json:"status,omitempty"`
+
+	// WorkingHours Часы работы в свободной форме, например 09:00-21:00
+	WorkingHours *string `json:"workingHours,omitempty"`
+}
+
+// PVZOccupancy defines model for PVZOccupancy.
+type PVZOccupancy struct {
+	// Capacity Общая вместимость ПВЗ, если задана
+	Capacity *int `json:"capacity,omitempty"`
+
+	// Occupied Количество товаров в открытых и недавно закрытых приемках
+	Occupied     int                    `json:"occupied"`
+	ProductTypes []ProductTypeOccupancy `json:"productTypes"`
+	PvzId        openapi_types.UUID     `json:"pvzId"`
+
+	// StorageHours Сколько часов после закрытия приемки ее товары занимают место в ПВЗ
+	StorageHours int `json:"storageHours"`
+}
+
+// PVZPage defines model for PVZPage.
+type PVZPage struct {
+	Limit int `json:"limit"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string             `json:"nextCursor,omitempty"`
+	Page       int                 `json:"page"`
+	Pvzs       []PVZWithReceptions `json:"pvzs"`
+
+	// Total Общее количество ПВЗ
+	Total      int `json:"total"`
+	TotalPages int `json:"totalPages"`
 }
 
-// PVZCity defines model for PVZ.City.
-type PVZCity string
+// PVZStatus В деактивированном (inactive) ПВЗ нельзя создавать приемки, пока модератор не активирует его снова.
+// Архивный (archived) ПВЗ нельзя изменить, активировать или открыть в нем приемку, история приемок сохраняется.
+type PVZStatus string
+
+// PVZWithReceptions defines model for PVZWithReceptions.
+type PVZWithReceptions struct {
+	// LastActivity Время последней приемки или добавления товара
+	LastActivity *time.Time              `json:"lastActivity,omitempty"`
+	Pvz          PVZ                     `json:"pvz"`
+	Receptions   []ReceptionWithProducts `json:"receptions"`
+}
 
 // Product defines model for Product.
 type Product struct {
-	DateTime    *time.Time          `json:"dateTime,omitempty"`
+	Barcode  *string    `json:"barcode,omitempty"`
+	DateTime *time.Time `json:"dateTime,omitempty"`
+
+	// Dimensions Габариты товара в миллиметрах
+	Dimensions  *ProductDimensions  `json:"dimensions,omitempty"`
 	Id          *openapi_types.UUID `json:"id,omitempty"`
+	OrderId     *string             `json:"orderId,omitempty"`
 	ReceptionId openapi_types.UUID  `json:"receptionId"`
-	Type        ProductType         `json:"type"`
+	Sku         *string             `json:"sku,omitempty"`
+	Type        string              `json:"type"`
+	WeightGrams *int                `json:"weightGrams,omitempty"`
+}
+
+// ProductBatchItem defines model for ProductBatchItem.
+type ProductBatchItem struct {
+	Barcode *string `json:"barcode,omitempty"`
+
+	// Dimensions Габариты товара в миллиметрах
+	Dimensions  *ProductDimensions `json:"dimensions,omitempty"`
+	OrderId     *string            `json:"orderId,omitempty"`
+	Sku         *string            `json:"sku,omitempty"`
+	Type        string             `json:"type"`
+	WeightGrams *int               `json:"weightGrams,omitempty"`
 }
 
-// ProductType defines model for Product.Type.
-type ProductType string
+// ProductBatchItemResult Результат добавления одного товара пакета; заполнено либо product, либо error
+type ProductBatchItemResult struct {
+	Error   *string  `json:"error,omitempty"`
+	Index   int      `json:"index"`
+	Product *Product `json:"product,omitempty"`
+}
+
+// ProductBatchResult defines model for ProductBatchResult.
+type ProductBatchResult struct {
+	Created     int                      `json:"created"`
+	Failed      int                      `json:"failed"`
+	Items       []ProductBatchItemResult `json:"items"`
+	ReceptionId openapi_types.UUID       `json:"receptionId"`
+}
+
+// ProductDimensions Габариты товара в миллиметрах
+type ProductDimensions struct {
+	HeightMm int `json:"heightMm"`
+	LengthMm int `json:"lengthMm"`
+	WidthMm  int `json:"widthMm"`
+}
+
+// ProductLocation defines model for ProductLocation.
+type ProductLocation struct {
+	Product   Product   `json:"product"`
+	Pvz       PVZ       `json:"pvz"`
+	Reception Reception `json:"reception"`
+}
+
+// ProductType defines model for ProductType.
+type ProductType struct {
+	Code          string     `json:"code"`
+	CreatedAt     *time.Time `json:"createdAt,omitempty"`
+	DisplayNameEn string     `json:"displayNameEn"`
+	DisplayNameRu string     `json:"displayNameRu"`
+	Fragile       *bool      `json:"fragile,omitempty"`
+
+	// MaxPerReception Максимальное количество товаров этого типа в одной приемке, без ограничения если не задано
+	MaxPerReception *int  `json:"maxPerReception,omitempty"`
+	Oversize        *bool `json:"oversize,omitempty"`
+}
+
+// ProductTypeCapacity defines model for ProductTypeCapacity.
+type ProductTypeCapacity struct {
+	Capacity int    `json:"capacity"`
+	Type     string `json:"type"`
+}
+
+// ProductTypeOccupancy defines model for ProductTypeOccupancy.
+type ProductTypeOccupancy struct {
+	// Capacity Вместимость ПВЗ для товаров этого типа, если задана
+	Capacity *int   `json:"capacity,omitempty"`
+	Occupied int    `json:"occupied"`
+	Type     string `json:"type"`
+}
 
 // Reception defines model for Reception.
 type Reception struct {
-	DateTime time.Time           `json:"dateTime"`
-	Id       *openapi_types.UUID `json:"id,omitempty"`
-	PvzId    openapi_types.UUID  `json:"pvzId"`
-	Status   ReceptionStatus     `json:"status"`
+	// CloseReason Причина автоматического закрытия приемки
+	CloseReason *string             `json:"closeReason,omitempty"`
+	DateTime    time.Time           `json:"dateTime"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+	PvzId       openapi_types.UUID  `json:"pvzId"`
+
+	// Status Допустимые переходы: in_progress → paused, close, cancelled; paused → in_progress, cancelled;
+	// close и auto_closed → in_progress (переоткрытие модератором), verified (модератор).
+	// Статусы verified и cancelled конечные, auto_closed выставляет только сервер.
+	Status ReceptionStatus `json:"status"`
 }
 
-// ReceptionStatus defines model for Reception.Status.
+// ReceptionCorrection defines model for ReceptionCorrection.
+type ReceptionCorrection struct {
+	// ClosedAt Время закрытия приемки до переоткрытия
+	ClosedAt    *time.Time          `json:"closedAt,omitempty"`
+	Id          openapi_types.UUID  `json:"id"`
+	Reason      string              `json:"reason"`
+	ReceptionId openapi_types.UUID  `json:"receptionId"`
+	ReopenedAt  time.Time           `json:"reopenedAt"`
+	ReopenedBy  *openapi_types.UUID `json:"reopenedBy,omitempty"`
+}
+
+// ReceptionPage defines model for ReceptionPage.
+type ReceptionPage struct {
+	Limit      int                     `json:"limit"`
+	Page       int                     `json:"page"`
+	Receptions []ReceptionWithProducts `json:"receptions"`
+
+	// Total Общее количество приемок, подходящих под фильтры
+	Total      int `json:"total"`
+	TotalPages int `json:"totalPages"`
+}
+
+// ReceptionStatus Допустимые переходы: in_progress → paused, close, cancelled; paused → in_progress, cancelled;
+// close и auto_closed → in_progress (переоткрытие модератором), verified (модератор).
+// Статусы verified и cancelled конечные, auto_closed выставляет только сервер.
 type ReceptionStatus string
 
+// ReceptionWithProducts defines model for ReceptionWithProducts.
+type ReceptionWithProducts struct {
+	Products  []Product `json:"products"`
+	Reception Reception `json:"reception"`
+}
+
 // Token defines model for Token.
 type Token = string
 
+// TokenPair defines model for TokenPair.
+type TokenPair struct {
+	AccessToken  Token  `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
 // User defines model for User.
 type User struct {
 	Email openapi_types.Email `json:"email"`
@@ -112,6 +367,43 @@ type User struct {
 // UserRole defines model for User.Role.
 type UserRole string
 
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts       int                    `json:"attempts"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	DeliveredAt    *time.Time             `json:"deliveredAt,omitempty"`
+	EventId        openapi_types.UUID     `json:"eventId"`
+	EventType      WebhookEventType       `json:"eventType"`
+	Id             openapi_types.UUID     `json:"id"`
+	LastError      *string                `json:"lastError,omitempty"`
+	NextAttemptAt  time.Time              `json:"nextAttemptAt"`
+	Payload        map[string]interface{} `json:"payload"`
+	Status         WebhookDeliveryStatus  `json:"status"`
+	SubscriptionId openapi_types.UUID     `json:"subscriptionId"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt  *time.Time          `json:"createdAt,omitempty"`
+	EventTypes []WebhookEventType  `json:"eventTypes"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+	PvzId      *openapi_types.UUID `json:"pvzId,omitempty"`
+	Url        string              `json:"url"`
+}
+
+// PutCitiesNameJSONBody defines parameters for PutCitiesName.
+type PutCitiesNameJSONBody struct {
+	ReceptionAutoCloseHours *int   `json:"receptionAutoCloseHours,omitempty"`
+	Region                  string `json:"region"`
+	Timezone                string `json:"timezone"`
+}
+
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
 	Role PostDummyLoginJSONBodyRole `json:"role"`
@@ -126,14 +418,39 @@ type PostLoginJSONBody struct {
 	Password string              `json:"password"`
 }
 
+// PostLogoutParams defines parameters for PostLogout.
+type PostLogoutParams struct {
+	// All Отозвать все сессии пользователя
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
+}
+
+// PutProductTypesCodeJSONBody defines parameters for PutProductTypesCode.
+type PutProductTypesCodeJSONBody struct {
+	DisplayNameEn   string `json:"displayNameEn"`
+	DisplayNameRu   string `json:"displayNameRu"`
+	Fragile         *bool  `json:"fragile,omitempty"`
+	MaxPerReception *int   `json:"maxPerReception,omitempty"`
+	Oversize        *bool  `json:"oversize,omitempty"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
+	Barcode *string `json:"barcode,omitempty"`
+
+	// Dimensions Габариты товара в миллиметрах
+	Dimensions  *ProductDimensions `json:"dimensions,omitempty"`
+	OrderId     *string            `json:"orderId,omitempty"`
+	PvzId       openapi_types.UUID `json:"pvzId"`
+	Sku         *string            `json:"sku,omitempty"`
+	Type        string             `json:"type"`
+	WeightGrams *int               `json:"weightGrams,omitempty"`
 }
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+// PostProductsBatchJSONBody defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBody struct {
+	Items []ProductBatchItem `json:"items"`
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
 
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
@@ -148,6 +465,76 @@ type GetPvzParams struct {
 
 	// Limit Количество элементов на странице
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// City Город ПВЗ
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// ReceptionStatus Только ПВЗ, у которых есть приемка с указанным статусом (in_progress, paused, close, auto_closed, verified или cancelled)
+	ReceptionStatus *string `form:"receptionStatus,omitempty" json:"receptionStatus,omitempty"`
+
+	// ProductType Только ПВЗ, у которых есть приемка с товаром указанного типа
+	ProductType *string `form:"productType,omitempty" json:"productType,omitempty"`
+
+	// MinProducts Только ПВЗ, у которых есть приемка хотя бы с указанным количеством товаров
+	MinProducts *int `form:"minProducts,omitempty" json:"minProducts,omitempty"`
+
+	// SortBy Сортировка по дате регистрации (по возрастанию) или по последней активности (по убыванию)
+	SortBy *GetPvzParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// Cursor Курсор следующей страницы из поля nextCursor предыдущего ответа; не совместим с page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetPvzParamsSortBy defines parameters for GetPvz.
+type GetPvzParamsSortBy string
+
+// GetPvzNearbyParams defines parameters for GetPvzNearby.
+type GetPvzNearbyParams struct {
+	// Lat Широта точки поиска
+	Lat float64 `form:"lat" json:"lat"`
+
+	// Lon Долгота точки поиска
+	Lon float64 `form:"lon" json:"lon"`
+
+	// Radius Радиус поиска в метрах
+	Radius *float64 `form:"radius,omitempty" json:"radius,omitempty"`
+
+	// Limit Максимальное количество ПВЗ в ответе
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PutPvzPvzIdJSONBody defines parameters for PutPvzPvzId.
+type PutPvzPvzIdJSONBody struct {
+	Address      *string  `json:"address,omitempty"`
+	Capacity     *int     `json:"capacity,omitempty"`
+	Latitude     *float64 `json:"latitude,omitempty"`
+	Longitude    *float64 `json:"longitude,omitempty"`
+	WorkingHours *string  `json:"workingHours,omitempty"`
+}
+
+// PutPvzPvzIdCapacityJSONBody defines parameters for PutPvzPvzIdCapacity.
+type PutPvzPvzIdCapacityJSONBody struct {
+	// ProductTypes Полный список ограничений по типам товаров, заменяет текущий
+	ProductTypes []ProductTypeCapacity `json:"productTypes"`
+	StorageHours int                   `json:"storageHours"`
+}
+
+// GetPvzPvzIdReceptionsParams defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParams struct {
+	// Status Статус приемки (in_progress, paused, close, auto_closed, verified или cancelled)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // PostReceptionsJSONBody defines parameters for PostReceptions.
@@ -155,6 +542,28 @@ type PostReceptionsJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
+// PostReceptionsReceptionIdReopenJSONBody defines parameters for PostReceptionsReceptionIdReopen.
+type PostReceptionsReceptionIdReopenJSONBody struct {
+	Reason string `json:"reason"`
+}
+
+// PostReceptionsReceptionIdStatusJSONBody defines parameters for PostReceptionsReceptionIdStatus.
+type PostReceptionsReceptionIdStatusJSONBody struct {
+	// Reason Причина, обязательна при переоткрытии закрытой приемки
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Допустимые переходы: in_progress → paused, close, cancelled; paused → in_progress, cancelled;
+	// close и auto_closed → in_progress (переоткрытие модератором), verified (модератор).
+	// Статусы verified и cancelled конечные, auto_closed выставляет только сервер.
+	Status ReceptionStatus `json:"status"`
+}
+
+// PostRefreshJSONBody defines parameters for PostRefresh.
+type PostRefreshJSONBody struct {
+	// RefreshToken Refresh-токен, если он не передан в cookie refresh_token
+	RefreshToken *string `json:"refreshToken,omitempty"`
+}
+
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
 	Email    openapi_types.Email      `json:"email"`
@@ -165,53 +574,199 @@ type PostRegisterJSONBody struct {
 // PostRegisterJSONBodyRole defines parameters for PostRegister.
 type PostRegisterJSONBodyRole string
 
+// PostWebhooksJSONBody defines parameters for PostWebhooks.
+type PostWebhooksJSONBody struct {
+	EventTypes []WebhookEventType  `json:"eventTypes"`
+	PvzId      *openapi_types.UUID `json:"pvzId,omitempty"`
+	Secret     string              `json:"secret"`
+	Url        string              `json:"url"`
+}
+
+// GetWebhooksWebhookIdDeliveriesParams defines parameters for GetWebhooksWebhookIdDeliveries.
+type GetWebhooksWebhookIdDeliveriesParams struct {
+	// Status Статус отправки
+	Status *GetWebhooksWebhookIdDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetWebhooksWebhookIdDeliveriesParamsStatus defines parameters for GetWebhooksWebhookIdDeliveries.
+type GetWebhooksWebhookIdDeliveriesParamsStatus string
+
+// PostCitiesJSONRequestBody defines body for PostCities for application/json ContentType.
+type PostCitiesJSONRequestBody = City
+
+// PutCitiesNameJSONRequestBody defines body for PutCitiesName for application/json ContentType.
+type PutCitiesNameJSONRequestBody PutCitiesNameJSONBody
+
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
 type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostProductTypesJSONRequestBody defines body for PostProductTypes for application/json ContentType.
+type PostProductTypesJSONRequestBody = ProductType
+
+// PutProductTypesCodeJSONRequestBody defines body for PutProductTypesCode for application/json ContentType.
+type PutProductTypesCodeJSONRequestBody PutProductTypesCodeJSONBody
+
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
+// PostProductsBatchJSONRequestBody defines body for PostProductsBatch for application/json ContentType.
+type PostProductsBatchJSONRequestBody PostProductsBatchJSONBody
+
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
+// PutPvzPvzIdJSONRequestBody defines body for PutPvzPvzId for application/json ContentType.
+type PutPvzPvzIdJSONRequestBody PutPvzPvzIdJSONBody
+
+// PutPvzPvzIdCapacityJSONRequestBody defines body for PutPvzPvzIdCapacity for application/json ContentType.
+type PutPvzPvzIdCapacityJSONRequestBody PutPvzPvzIdCapacityJSONBody
+
 // PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
 type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 
+// PostReceptionsReceptionIdReopenJSONRequestBody defines body for PostReceptionsReceptionIdReopen for application/json ContentType.
+type PostReceptionsReceptionIdReopenJSONRequestBody PostReceptionsReceptionIdReopenJSONBody
+
+// PostReceptionsReceptionIdStatusJSONRequestBody defines body for PostReceptionsReceptionIdStatus for application/json ContentType.
+type PostReceptionsReceptionIdStatusJSONRequestBody PostReceptionsReceptionIdStatusJSONBody
+
+// PostRefreshJSONRequestBody defines body for PostRefresh for application/json ContentType.
+type PostRefreshJSONRequestBody PostRefreshJSONBody
+
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
 
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody PostWebhooksJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Публичные ключи для проверки подписи токенов доступа
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(c *gin.Context)
+	// Получение справочника городов (только для модераторов)
+	// (GET /cities)
+	GetCities(c *gin.Context)
+	// Добавление города в справочник (только для модераторов)
+	// (POST /cities)
+	PostCities(c *gin.Context)
+	// Удаление города из справочника (только для модераторов)
+	// (DELETE /cities/{name})
+	DeleteCitiesName(c *gin.Context, name string)
+	// Изменение города в справочнике (только для модераторов)
+	// (PUT /cities/{name})
+	PutCitiesName(c *gin.Context, name string)
 	// Получение тестового токена
 	// (POST /dummyLogin)
 	PostDummyLogin(c *gin.Context)
 	// Авторизация пользователя
 	// (POST /login)
 	PostLogin(c *gin.Context)
+	// Выход из текущей сессии или из всех сессий пользователя
+	// (POST /logout)
+	PostLogout(c *gin.Context, params PostLogoutParams)
+	// Получение справочника типов товаров (только для модераторов)
+	// (GET /product_types)
+	GetProductTypes(c *gin.Context)
+	// Добавление типа товара в справочник (только для модераторов)
+	// (POST /product_types)
+	PostProductTypes(c *gin.Context)
+	// Удаление типа товара из справочника (только для модераторов)
+	// (DELETE /product_types/{code})
+	DeleteProductTypesCode(c *gin.Context, code string)
+	// Изменение типа товара в справочнике (только для модераторов)
+	// (PUT /product_types/{code})
+	PutProductTypesCode(c *gin.Context, code string)
 	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 	// (POST /products)
 	PostProducts(c *gin.Context)
+	// Пакетное добавление товаров в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products/batch)
+	PostProductsBatch(c *gin.Context)
+	// Поиск товара по штрихкоду вместе с приемкой и ПВЗ
+	// (GET /products/by-barcode/{code})
+	GetProductsByBarcodeCode(c *gin.Context, code string)
 	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
 	// (GET /pvz)
 	GetPvz(c *gin.Context, params GetPvzParams)
 	// Создание ПВЗ (только для модераторов)
 	// (POST /pvz)
 	PostPvz(c *gin.Context)
+	// Поиск ближайших активных ПВЗ, отсортированных по расстоянию
+	// (GET /pvz/nearby)
+	GetPvzNearby(c *gin.Context, params GetPvzNearbyParams)
+	// Изменение адреса, координат, часов работы и вместимости ПВЗ (только для модераторов)
+	// (PUT /pvz/{pvzId})
+	PutPvzPvzId(c *gin.Context, pvzId openapi_types.UUID)
+	// Повторная активация деактивированного ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/activate)
+	PostPvzPvzIdActivate(c *gin.Context, pvzId openapi_types.UUID)
+	// Перевод ПВЗ в архив с сохранением истории приемок (только для модераторов)
+	// (POST /pvz/{pvzId}/archive)
+	PostPvzPvzIdArchive(c *gin.Context, pvzId openapi_types.UUID)
+	// Изменение вместимости ПВЗ по типам товаров и срока хранения (только для модераторов)
+	// (PUT /pvz/{pvzId}/capacity)
+	PutPvzPvzIdCapacity(c *gin.Context, pvzId openapi_types.UUID)
 	// Закрытие последней открытой приемки товаров в рамках ПВЗ
 	// (POST /pvz/{pvzId}/close_last_reception)
 	PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId openapi_types.UUID)
+	// Деактивация ПВЗ, после которой в нем нельзя создавать приемки (только для модераторов)
+	// (POST /pvz/{pvzId}/deactivate)
+	PostPvzPvzIdDeactivate(c *gin.Context, pvzId openapi_types.UUID)
 	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 	// (POST /pvz/{pvzId}/delete_last_product)
 	PostPvzPvzIdDeleteLastProduct(c *gin.Context, pvzId openapi_types.UUID)
+	// Текущая заполненность ПВЗ в целом и по типам товаров
+	// (GET /pvz/{pvzId}/occupancy)
+	GetPvzPvzIdOccupancy(c *gin.Context, pvzId openapi_types.UUID)
+	// Удаление товара по идентификатору из текущей приемки (только для сотрудников ПВЗ)
+	// (DELETE /pvz/{pvzId}/products/{productId})
+	DeletePvzPvzIdProductsProductId(c *gin.Context, pvzId openapi_types.UUID, productId openapi_types.UUID)
+	// Восстановление удаленного товара в текущей приемке (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/products/{productId}/restore)
+	PostPvzPvzIdProductsProductIdRestore(c *gin.Context, pvzId openapi_types.UUID, productId openapi_types.UUID)
+	// История приемок ПВЗ с фильтрацией по статусу и дате и пагинацией
+	// (GET /pvz/{pvzId}/receptions)
+	GetPvzPvzIdReceptions(c *gin.Context, pvzId openapi_types.UUID, params GetPvzPvzIdReceptionsParams)
 	// Создание новой приемки товаров (только для сотрудников ПВЗ)
 	// (POST /receptions)
 	PostReceptions(c *gin.Context)
+	// Получение приемки с товарами по идентификатору
+	// (GET /receptions/{receptionId})
+	GetReceptionsReceptionId(c *gin.Context, receptionId openapi_types.UUID)
+	// Журнал переоткрытий приемки (только для модераторов)
+	// (GET /receptions/{receptionId}/corrections)
+	GetReceptionsReceptionIdCorrections(c *gin.Context, receptionId openapi_types.UUID)
+	// Переоткрытие закрытой приемки с указанием причины (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(c *gin.Context, receptionId openapi_types.UUID)
+	// Изменение статуса приемки по правилам допустимых переходов
+	// (POST /receptions/{receptionId}/status)
+	PostReceptionsReceptionIdStatus(c *gin.Context, receptionId openapi_types.UUID)
+	// Обновление токена доступа по refresh-токену
+	// (POST /refresh)
+	PostRefresh(c *gin.Context)
 	// Регистрация пользователя
 	// (POST /register)
 	PostRegister(c *gin.Context)
+	// Повторная отправка недоставленного события (только для модераторов)
+	// (POST /webhook_deliveries/{deliveryId}/replay)
+	PostWebhookDeliveriesDeliveryIdReplay(c *gin.Context, deliveryId openapi_types.UUID)
+	// Получение списка подписок на события (только для модераторов)
+	// (GET /webhooks)
+	GetWebhooks(c *gin.Context)
+	// Регистрация подписки на события (только для модераторов)
+	// (POST /webhooks)
+	PostWebhooks(c *gin.Context)
+	// Удаление подписки на события (только для модераторов)
+	// (DELETE /webhooks/{webhookId})
+	DeleteWebhooksWebhookId(c *gin.Context, webhookId openapi_types.UUID)
+	// Получение отправок по подписке, в том числе недоставленных (только для модераторов)
+	// (GET /webhooks/{webhookId}/deliveries)
+	GetWebhooksWebhookIdDeliveries(c *gin.Context, webhookId openapi_types.UUID, params GetWebhooksWebhookIdDeliveriesParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -223,8 +778,8 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// PostDummyLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
+// GetWellKnownJwksJson operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownJwksJson(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -233,11 +788,13 @@ func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostDummyLogin(c)
+	siw.Handler.GetWellKnownJwksJson(c)
 }
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+// GetCities operation middleware
+func (siw *ServerInterfaceWrapper) GetCities(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -246,11 +803,11 @@ func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostLogin(c)
+	siw.Handler.GetCities(c)
 }
 
-// PostProducts operation middleware
-func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
+// PostCities operation middleware
+func (siw *ServerInterfaceWrapper) PostCities(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -261,51 +818,51 @@ func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostProducts(c)
+	siw.Handler.PostCities(c)
 }
 
-// GetPvz operation middleware
-func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
+// DeleteCitiesName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCitiesName(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPvzParams
-
-	// ------------- Optional query parameter "startDate" -------------
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	err = runtime.BindStyledParameter("simple", false, "name", c.Param("name"), &name)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "endDate" -------------
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	siw.Handler.DeleteCitiesName(c, name)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
-	}
+// PutCitiesName operation middleware
+func (siw *ServerInterfaceWrapper) PutCitiesName(c *gin.Context) {
 
-	// ------------- Optional query parameter "limit" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameter("simple", false, "name", c.Param("name"), &name)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -313,13 +870,24 @@ func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetPvz(c, params)
+	siw.Handler.PutCitiesName(c, name)
 }
 
-// PostPvz operation middleware
-func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
+// PostDummyLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostDummyLogin(c)
+}
+
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -328,25 +896,27 @@ func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPvz(c)
+	siw.Handler.PostLogin(c)
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context) {
+// PostLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostLogout(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostLogoutParams
+
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", c.Request.URL.Query(), &params.All)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter all: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -354,22 +924,11 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context
 		}
 	}
 
-	siw.Handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+	siw.Handler.PostLogout(c, params)
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
-
-	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetProductTypes(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -380,11 +939,11 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+	siw.Handler.GetProductTypes(c)
 }
 
-// PostReceptions operation middleware
-func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
+// PostProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) PostProductTypes(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -395,11 +954,24 @@ func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostReceptions(c)
+	siw.Handler.PostProductTypes(c)
 }
 
-// PostRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+// DeleteProductTypesCode operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductTypesCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameter("simple", false, "code", c.Param("code"), &code)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -408,7 +980,881 @@ func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostRegister(c)
+	siw.Handler.DeleteProductTypesCode(c, code)
+}
+
+// PutProductTypesCode operation middleware
+func (siw *ServerInterfaceWrapper) PutProductTypesCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameter("simple", false, "code", c.Param("code"), &code)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutProductTypesCode(c, code)
+}
+
+// PostProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProducts(c)
+}
+
+// PostProductsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsBatch(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsBatch(c)
+}
+
+// GetProductsByBarcodeCode operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsByBarcodeCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameter("simple", false, "code", c.Param("code"), &code)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsByBarcodeCode(c, code)
+}
+
+// GetPvz operation middleware
+func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", c.Request.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter city: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "receptionStatus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "receptionStatus", c.Request.URL.Query(), &params.ReceptionStatus)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionStatus: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "productType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "productType", c.Request.URL.Query(), &params.ProductType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "minProducts" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minProducts", c.Request.URL.Query(), &params.MinProducts)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minProducts: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvz(c, params)
+}
+
+// PostPvz operation middleware
+func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvz(c)
+}
+
+// GetPvzNearby operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzNearby(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzNearbyParams
+
+	// ------------- Required query parameter "lat" -------------
+
+	if paramValue := c.Query("lat"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument lat is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "lat", c.Request.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lat: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "lon" -------------
+
+	if paramValue := c.Query("lon"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument lon is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "lon", c.Request.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lon: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "radius" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius", c.Request.URL.Query(), &params.Radius)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter radius: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzNearby(c, params)
+}
+
+// PutPvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) PutPvzPvzId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutPvzPvzId(c, pvzId)
+}
+
+// PostPvzPvzIdActivate operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdActivate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdActivate(c, pvzId)
+}
+
+// PostPvzPvzIdArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdArchive(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdArchive(c, pvzId)
+}
+
+// PutPvzPvzIdCapacity operation middleware
+func (siw *ServerInterfaceWrapper) PutPvzPvzIdCapacity(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutPvzPvzIdCapacity(c, pvzId)
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+}
+
+// PostPvzPvzIdDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeactivate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdDeactivate(c, pvzId)
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+}
+
+// GetPvzPvzIdOccupancy operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdOccupancy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzPvzIdOccupancy(c, pvzId)
+}
+
+// DeletePvzPvzIdProductsProductId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePvzPvzIdProductsProductId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "productId", c.Param("productId"), &productId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeletePvzPvzIdProductsProductId(c, pvzId, productId)
+}
+
+// PostPvzPvzIdProductsProductIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdProductsProductIdRestore(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "productId", c.Param("productId"), &productId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdProductsProductIdRestore(c, pvzId, productId)
+}
+
+// GetPvzPvzIdReceptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdReceptions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "pvzId", c.Param("pvzId"), &pvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdReceptionsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzPvzIdReceptions(c, pvzId, params)
+}
+
+// PostReceptions operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptions(c)
+}
+
+// GetReceptionsReceptionId operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "receptionId", c.Param("receptionId"), &receptionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReceptionsReceptionId(c, receptionId)
+}
+
+// GetReceptionsReceptionIdCorrections operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionIdCorrections(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "receptionId", c.Param("receptionId"), &receptionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReceptionsReceptionIdCorrections(c, receptionId)
+}
+
+// PostReceptionsReceptionIdReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdReopen(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "receptionId", c.Param("receptionId"), &receptionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptionsReceptionIdReopen(c, receptionId)
+}
+
+// PostReceptionsReceptionIdStatus operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "receptionId", c.Param("receptionId"), &receptionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptionsReceptionIdStatus(c, receptionId)
+}
+
+// PostRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostRefresh(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostRefresh(c)
+}
+
+// PostRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostRegister(c)
+}
+
+// PostWebhookDeliveriesDeliveryIdReplay operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhookDeliveriesDeliveryIdReplay(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "deliveryId", c.Param("deliveryId"), &deliveryId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter deliveryId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostWebhookDeliveriesDeliveryIdReplay(c, deliveryId)
+}
+
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhooks(c)
+}
+
+// PostWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooks(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostWebhooks(c)
+}
+
+// DeleteWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksWebhookId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "webhookId", c.Param("webhookId"), &webhookId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteWebhooksWebhookId(c, webhookId)
+}
+
+// GetWebhooksWebhookIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksWebhookIdDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameter("simple", false, "webhookId", c.Param("webhookId"), &webhookId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksWebhookIdDeliveriesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhooksWebhookIdDeliveries(c, webhookId, params)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -438,13 +1884,45 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	router.GET(options.BaseURL+"/cities", wrapper.GetCities)
+	router.POST(options.BaseURL+"/cities", wrapper.PostCities)
+	router.DELETE(options.BaseURL+"/cities/:name", wrapper.DeleteCitiesName)
+	router.PUT(options.BaseURL+"/cities/:name", wrapper.PutCitiesName)
 	router.POST(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
 	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
+	router.POST(options.BaseURL+"/logout", wrapper.PostLogout)
+	router.GET(options.BaseURL+"/product_types", wrapper.GetProductTypes)
+	router.POST(options.BaseURL+"/product_types", wrapper.PostProductTypes)
+	router.DELETE(options.BaseURL+"/product_types/:code", wrapper.DeleteProductTypesCode)
+	router.PUT(options.BaseURL+"/product_types/:code", wrapper.PutProductTypesCode)
 	router.POST(options.BaseURL+"/products", wrapper.PostProducts)
+	router.POST(options.BaseURL+"/products/batch", wrapper.PostProductsBatch)
+	router.GET(options.BaseURL+"/products/by-barcode/:code", wrapper.GetProductsByBarcodeCode)
 	router.GET(options.BaseURL+"/pvz", wrapper.GetPvz)
 	router.POST(options.BaseURL+"/pvz", wrapper.PostPvz)
+	router.GET(options.BaseURL+"/pvz/nearby", wrapper.GetPvzNearby)
+	router.PUT(options.BaseURL+"/pvz/:pvzId", wrapper.PutPvzPvzId)
+	router.POST(options.BaseURL+"/pvz/:pvzId/activate", wrapper.PostPvzPvzIdActivate)
+	router.POST(options.BaseURL+"/pvz/:pvzId/archive", wrapper.PostPvzPvzIdArchive)
+	router.PUT(options.BaseURL+"/pvz/:pvzId/capacity", wrapper.PutPvzPvzIdCapacity)
 	router.POST(options.BaseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
+	router.POST(options.BaseURL+"/pvz/:pvzId/deactivate", wrapper.PostPvzPvzIdDeactivate)
 	router.POST(options.BaseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
+	router.GET(options.BaseURL+"/pvz/:pvzId/occupancy", wrapper.GetPvzPvzIdOccupancy)
+	router.DELETE(options.BaseURL+"/pvz/:pvzId/products/:productId", wrapper.DeletePvzPvzIdProductsProductId)
+	router.POST(options.BaseURL+"/pvz/:pvzId/products/:productId/restore", wrapper.PostPvzPvzIdProductsProductIdRestore)
+	router.GET(options.BaseURL+"/pvz/:pvzId/receptions", wrapper.GetPvzPvzIdReceptions)
 	router.POST(options.BaseURL+"/receptions", wrapper.PostReceptions)
+	router.GET(options.BaseURL+"/receptions/:receptionId", wrapper.GetReceptionsReceptionId)
+	router.GET(options.BaseURL+"/receptions/:receptionId/corrections", wrapper.GetReceptionsReceptionIdCorrections)
+	router.POST(options.BaseURL+"/receptions/:receptionId/reopen", wrapper.PostReceptionsReceptionIdReopen)
+	router.POST(options.BaseURL+"/receptions/:receptionId/status", wrapper.PostReceptionsReceptionIdStatus)
+	router.POST(options.BaseURL+"/refresh", wrapper.PostRefresh)
 	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
+	router.POST(options.BaseURL+"/webhook_deliveries/:deliveryId/replay", wrapper.PostWebhookDeliveriesDeliveryIdReplay)
+	router.GET(options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	router.POST(options.BaseURL+"/webhooks", wrapper.PostWebhooks)
+	router.DELETE(options.BaseURL+"/webhooks/:webhookId", wrapper.DeleteWebhooksWebhookId)
+	router.GET(options.BaseURL+"/webhooks/:webhookId/deliveries", wrapper.GetWebhooksWebhookIdDeliveries)
 }
@@ -29,7 +29,8 @@ func (m *AuthMiddleware) AuthMiddleware(c *gin.Context) {
 	path := c.FullPath()
 	if strings.Contains(path, "/dummyLogin") ||
 		strings.Contains(path, "/login") ||
-		strings.Contains(path, "/register") {
+		strings.Contains(path, "/register") ||
+		strings.Contains(path, "/refresh") {
 		c.Next()
 		return
 	}
@@ -96,14 +97,32 @@ func extractToken(c *gin.Context) (string, error) {
 func checkRole(c *gin.Context, userRole user_model.UserRole, path string) bool {
 	method := c.Request.Method
 
+	if strings.HasPrefix(path, "/webhook") || strings.HasPrefix(path, "/cities") ||
+		strings.HasPrefix(path, "/product_types") {
+		return string(userRole) == string(generated.UserRoleModerator)
+	}
+
 	if path == "/pvz" && method == http.MethodPost {
 		return string(userRole) == string(generated.UserRoleModerator)
 	}
 
+	if strings.HasPrefix(path, "/pvz/") && (method == http.MethodPut ||
+		method == http.MethodPost && (strings.HasSuffix(path, "/deactivate") || strings.HasSuffix(path, "/activate") || strings.HasSuffix(path, "/archive"))) {
+		return string(userRole) == string(generated.UserRoleModerator)
+	}
+
+	if strings.HasPrefix(path, "/receptions/") && (strings.HasSuffix(path, "/reopen") || strings.HasSuffix(path, "/corrections")) {
+		return string(userRole) == string(generated.UserRoleModerator)
+	}
+
+	if strings.HasPrefix(path, "/receptions/") && strings.HasSuffix(path, "/status") {
+		return true
+	}
+
 	if strings.Contains(path, "/close_last_reception") && method == http.MethodPost ||
 		strings.Contains(path, "/delete_last_product") && method == http.MethodPost ||
 		strings.Contains(path, "/receptions") && method == http.MethodPost ||
-		strings.Contains(path, "/products") && method == http.MethodPost {
+		strings.Contains(path, "/products") && (method == http.MethodPost || method == http.MethodDelete) {
 		return string(userRole) == string(generated.UserRoleEmployee)
 	}
 
@@ -0,0 +1,121 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/user_service"
+	pvz_v1 "github.com/Dmitrii-Dmitrii/pvz/proto/generated/pvz/v1"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+type authUserCtxKey struct{}
+
+var grpcMethodRoles = map[string]user_model.UserRole{
+	pvz_v1.PVZService_CreatePVZ_FullMethodName:               user_model.Moderator,
+	pvz_v1.PVZService_UpdatePVZ_FullMethodName:               user_model.Moderator,
+	pvz_v1.PVZService_DeactivatePVZ_FullMethodName:           user_model.Moderator,
+	pvz_v1.PVZService_ActivatePVZ_FullMethodName:             user_model.Moderator,
+	pvz_v1.PVZService_ArchivePVZ_FullMethodName:              user_model.Moderator,
+	pvz_v1.PVZService_UpdatePVZCapacity_FullMethodName:       user_model.Moderator,
+	pvz_v1.PVZService_CreateReception_FullMethodName:         user_model.Employee,
+	pvz_v1.PVZService_CloseLastReception_FullMethodName:      user_model.Employee,
+	pvz_v1.PVZService_ReopenReception_FullMethodName:         user_model.Moderator,
+	pvz_v1.PVZService_GetReceptionCorrections_FullMethodName: user_model.Moderator,
+	pvz_v1.PVZService_AddProduct_FullMethodName:              user_model.Employee,
+	pvz_v1.PVZService_AddProducts_FullMethodName:             user_model.Employee,
+	pvz_v1.PVZService_DeleteLastProduct_FullMethodName:       user_model.Employee,
+	pvz_v1.PVZService_DeleteProduct_FullMethodName:           user_model.Employee,
+	pvz_v1.PVZService_RestoreProduct_FullMethodName:          user_model.Employee,
+}
+
+type GrpcAuthInterceptor struct {
+	userService user_service.IUserService
+}
+
+func NewGrpcAuthInterceptor(userService user_service.IUserService) *GrpcAuthInterceptor {
+	return &GrpcAuthInterceptor{userService: userService}
+}
+
+func (i *GrpcAuthInterceptor) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := i.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (i *GrpcAuthInterceptor) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := i.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+}
+
+func UserFromContext(ctx context.Context) (*user_model.User, bool) {
+	user, ok := ctx.Value(authUserCtxKey{}).(*user_model.User)
+	return user, ok
+}
+
+func (i *GrpcAuthInterceptor) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	token, err := extractGrpcToken(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Error extracting token")
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized: "+err.Error())
+	}
+
+	user, err := i.userService.ValidateToken(ctx, token)
+	var userErr *custom_errors.UserError
+	if errors.As(err, &userErr) {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized: "+userErr.Error())
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg("Error validating token")
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized: "+err.Error())
+	}
+
+	if role, exists := grpcMethodRoles[fullMethod]; exists && user.Role != role {
+		log.Error().Msgf("Role %s not allowed", user.Role)
+		return nil, status.Error(codes.PermissionDenied, "Forbidden")
+	}
+
+	return context.WithValue(ctx, authUserCtxKey{}, user), nil
+}
+
+func extractGrpcToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errors.New("no authentication token found")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 || values[0] == "" {
+		return "", errors.New("no authentication token found")
+	}
+
+	parts := strings.Split(values[0], " ")
+	if len(parts) == 2 && parts[0] == "Bearer" {
+		return parts[1], nil
+	}
+
+	return "", errors.New("invalid authorization header format")
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
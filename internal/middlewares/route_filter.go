@@ -0,0 +1,64 @@
+package middlewares
+
+import (
+	"github.com/Dmitrii-Dmitrii/pvz/internal"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"net/http"
+)
+
+type RouteFilter struct {
+	gin.IRouter
+	disabledRoutes map[string]struct{}
+}
+
+func NewRouteFilter(router gin.IRouter, disabledRoutes ...string) *RouteFilter {
+	filter := &RouteFilter{IRouter: router, disabledRoutes: make(map[string]struct{}, len(disabledRoutes))}
+	for _, route := range disabledRoutes {
+		filter.disabledRoutes[route] = struct{}{}
+	}
+
+	return filter
+}
+
+func (f *RouteFilter) Handle(method, path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	if _, ok := f.disabledRoutes[path]; ok {
+		log.Info().Str("method", method).Str("path", path).Msg("route is disabled")
+		return f.IRouter.Handle(method, path, DisabledRoute)
+	}
+
+	return f.IRouter.Handle(method, path, handlers...)
+}
+
+func (f *RouteFilter) GET(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return f.Handle(http.MethodGet, path, handlers...)
+}
+
+func (f *RouteFilter) POST(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return f.Handle(http.MethodPost, path, handlers...)
+}
+
+func (f *RouteFilter) PUT(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return f.Handle(http.MethodPut, path, handlers...)
+}
+
+func (f *RouteFilter) PATCH(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return f.Handle(http.MethodPatch, path, handlers...)
+}
+
+func (f *RouteFilter) DELETE(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return f.Handle(http.MethodDelete, path, handlers...)
+}
+
+func DisabledRoute(c *gin.Context) {
+	log.Warn().
+		Str("method", c.Request.Method).
+		Str("path", c.FullPath()).
+		Str("client_ip", c.ClientIP()).
+		Msg("request to disabled route")
+	internal.DisabledRouteRequestsTotal.WithLabelValues(c.Request.Method, c.FullPath()).Inc()
+
+	c.JSON(http.StatusNotFound, generated.Error{Message: "Not found"})
+	c.Abort()
+}
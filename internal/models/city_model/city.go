@@ -0,0 +1,11 @@
+package city_model
+
+import "time"
+
+type City struct {
+	Name                    string
+	Region                  string
+	Timezone                string
+	ReceptionAutoCloseHours *int
+	CreatedAt               time.Time
+}
@@ -27,15 +27,25 @@ var (
 	ErrInvalidUuid          = &InternalError{Message: "invalid UUID"}
 	ErrConvertUuidToOpenapi = &InternalError{Message: "failed to convert uuid to openapi types"}
 
-	ErrCreatePvz   = &InternalError{Message: "failed to create pvz"}
-	ErrGetPvz      = &InternalError{Message: "failed to get pvz"}
-	ErrPvzNotFound = &InternalError{Message: "pvz not found"}
+	ErrCreatePvz         = &InternalError{Message: "failed to create pvz"}
+	ErrGetPvz            = &InternalError{Message: "failed to get pvz"}
+	ErrUpdatePvz         = &InternalError{Message: "failed to update pvz"}
+	ErrPvzNotFound       = &InternalError{Message: "pvz not found"}
+	ErrGetPvzOccupancy   = &InternalError{Message: "failed to get pvz occupancy"}
+	ErrUpdatePvzCapacity = &InternalError{Message: "failed to update pvz capacity"}
 
 	ErrCreateReception        = &InternalError{Message: "failed to create reception"}
 	ErrGetReceptionInProgress = &InternalError{Message: "failed to get reception in progress"}
 	ErrGetReception           = &InternalError{Message: "failed to get reception"}
+	ErrGetReceptions          = &InternalError{Message: "failed to get receptions"}
 	ErrGetLastReceptionStatus = &InternalError{Message: "failed to get last reception status"}
 	ErrCloseReception         = &InternalError{Message: "failed to close reception"}
+	ErrReopenReception        = &InternalError{Message: "failed to reopen reception"}
+	ErrUpdateReceptionStatus  = &InternalError{Message: "failed to update reception status"}
+	ErrCheckNewerReception    = &InternalError{Message: "failed to check newer reception"}
+	ErrGetCorrections         = &InternalError{Message: "failed to get reception corrections"}
+	ErrAutoCloseLock          = &InternalError{Message: "failed to acquire reception auto close lock"}
+	ErrAutoCloseReceptions    = &InternalError{Message: "failed to auto close receptions"}
 
 	ErrCreateProduct = &InternalError{Message: "failed to create product"}
 	ErrDeleteProduct = &InternalError{Message: "failed to delete product"}
@@ -45,7 +55,56 @@ var (
 	ErrGetUserById    = &InternalError{Message: "failed to get user by id"}
 	ErrHashPassword   = &InternalError{Message: "failed to hash password"}
 
-	ErrGenerateJWTToken = &InternalError{Message: "failed to generate jwt token"}
-	ErrSigningMethod    = &InternalError{Message: "unexpected signing method"}
-	ErrInvalidToken     = &InternalError{Message: "invalid token"}
+	ErrMarshalEvent   = &InternalError{Message: "failed to marshal event"}
+	ErrUnmarshalEvent = &InternalError{Message: "failed to unmarshal event"}
+	ErrNotifyEvent    = &InternalError{Message: "failed to notify event"}
+	ErrListenEvents   = &InternalError{Message: "failed to listen events"}
+
+	ErrCreateOutboxEvent    = &InternalError{Message: "failed to create outbox event"}
+	ErrClaimOutboxEvents    = &InternalError{Message: "failed to claim outbox events"}
+	ErrUpdateOutboxEvent    = &InternalError{Message: "failed to update outbox event"}
+	ErrSendOutboxEvent      = &InternalError{Message: "failed to send outbox event"}
+	ErrUnexpectedStatusCode = &InternalError{Message: "unexpected status code"}
+
+	ErrCreateWebhookSubscription = &InternalError{Message: "failed to create webhook subscription"}
+	ErrGetWebhookSubscriptions   = &InternalError{Message: "failed to get webhook subscriptions"}
+	ErrDeleteWebhookSubscription = &InternalError{Message: "failed to delete webhook subscription"}
+	ErrCreateWebhookDeliveries   = &InternalError{Message: "failed to create webhook deliveries"}
+	ErrGetWebhookDeliveries      = &InternalError{Message: "failed to get webhook deliveries"}
+	ErrClaimWebhookDeliveries    = &InternalError{Message: "failed to claim webhook deliveries"}
+	ErrUpdateWebhookDelivery     = &InternalError{Message: "failed to update webhook delivery"}
+	ErrReplayWebhookDelivery     = &InternalError{Message: "failed to replay webhook delivery"}
+	ErrSendWebhook               = &InternalError{Message: "failed to send webhook"}
+
+	ErrCreateCity = &InternalError{Message: "failed to create city"}
+	ErrGetCities  = &InternalError{Message: "failed to get cities"}
+	ErrGetCity    = &InternalError{Message: "failed to get city"}
+	ErrUpdateCity = &InternalError{Message: "failed to update city"}
+	ErrDeleteCity = &InternalError{Message: "failed to delete city"}
+
+	ErrCreateProductType = &InternalError{Message: "failed to create product type"}
+	ErrGetProductTypes   = &InternalError{Message: "failed to get product types"}
+	ErrGetProductType    = &InternalError{Message: "failed to get product type"}
+	ErrUpdateProductType = &InternalError{Message: "failed to update product type"}
+	ErrDeleteProductType = &InternalError{Message: "failed to delete product type"}
+	ErrCountProducts     = &InternalError{Message: "failed to count products"}
+	ErrCheckBarcode      = &InternalError{Message: "failed to check product barcode"}
+	ErrGetProduct        = &InternalError{Message: "failed to get product"}
+	ErrCreateProducts    = &InternalError{Message: "failed to create products"}
+	ErrRestoreProduct    = &InternalError{Message: "failed to restore product"}
+
+	ErrGenerateJWTToken     = &InternalError{Message: "failed to generate jwt token"}
+	ErrSigningMethod        = &InternalError{Message: "unexpected signing method"}
+	ErrInvalidToken         = &InternalError{Message: "invalid token"}
+	ErrJwtKeysNotConfigured = &InternalError{Message: "jwt signing keys are not configured"}
+	ErrParseJwtKey          = &InternalError{Message: "failed to parse jwt key"}
+	ErrUnsupportedJwtKey    = &InternalError{Message: "unsupported jwt key type"}
+	ErrUnknownJwtKey        = &InternalError{Message: "unknown jwt key id"}
+	ErrDuplicateJwtKey      = &InternalError{Message: "duplicate jwt key id"}
+	ErrGenerateRefreshToken = &InternalError{Message: "failed to generate refresh token"}
+	ErrCreateRefreshToken   = &InternalError{Message: "failed to create refresh token"}
+	ErrRotateRefreshToken   = &InternalError{Message: "failed to rotate refresh token"}
+	ErrRevokeTokens         = &InternalError{Message: "failed to revoke tokens"}
+	ErrCheckTokenRevoked    = &InternalError{Message: "failed to check token revocation"}
+	ErrDeleteExpiredTokens  = &InternalError{Message: "failed to delete expired tokens"}
 )
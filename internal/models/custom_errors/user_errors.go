@@ -15,19 +15,86 @@ func (e *UserError) Error() string {
 }
 
 var (
-	ErrNoOpenReception     = &UserError{Message: "no open reception"}
-	ErrNoReception         = &UserError{Message: "no reception"}
-	ErrDateRange           = &UserError{Message: "end date cannot be before start date"}
-	ErrLimitValue          = &UserError{Message: "limit must be between 1 and 30"}
-	ErrPageValue           = &UserError{Message: "page must be greater than zero"}
-	ErrUuidFormat          = &UserError{Message: "invalid UUID format"}
-	ErrProductType         = &UserError{Message: "invalid product type"}
-	ErrPvzCity             = &UserError{Message: "invalid pvz city"}
-	ErrUserRole            = &UserError{Message: "invalid user role"}
-	ErrEmailFormat         = &UserError{Message: "invalid email format"}
-	ErrLoginPassword       = &UserError{Message: "wrong password"}
-	ErrUserNotFound        = &UserError{Message: "user not found"}
-	ErrPvzExists           = &UserError{Message: "pvz already exists"}
-	ErrInProgressReception = &UserError{Message: "in progress reception already exists"}
-	ErrExistingUser        = &UserError{Message: "user already exists"}
+	ErrNoOpenReception         = &UserError{Message: "no open reception"}
+	ErrNoReception             = &UserError{Message: "no reception"}
+	ErrNoProducts              = &UserError{Message: "no products to delete"}
+	ErrDateRange               = &UserError{Message: "end date cannot be before start date"}
+	ErrLimitValue              = &UserError{Message: "limit must be between 1 and 30"}
+	ErrPageValue               = &UserError{Message: "page must be greater than zero"}
+	ErrCursorValue             = &UserError{Message: "invalid cursor"}
+	ErrCursorWithPage          = &UserError{Message: "cursor cannot be combined with page"}
+	ErrMinProductsValue        = &UserError{Message: "minProducts must be greater than zero"}
+	ErrSortValue               = &UserError{Message: "invalid sort"}
+	ErrReceptionStatus         = &UserError{Message: "invalid reception status"}
+	ErrUuidFormat              = &UserError{Message: "invalid UUID format"}
+	ErrProductType             = &UserError{Message: "invalid product type"}
+	ErrPvzCity                 = &UserError{Message: "invalid pvz city"}
+	ErrUserRole                = &UserError{Message: "invalid user role"}
+	ErrEmailFormat             = &UserError{Message: "invalid email format"}
+	ErrLoginPassword           = &UserError{Message: "wrong password"}
+	ErrDummyLoginDisabled      = &UserError{Message: "dummy login is disabled"}
+	ErrUserNotFound            = &UserError{Message: "user not found"}
+	ErrPvzExists               = &UserError{Message: "pvz already exists"}
+	ErrInProgressReception     = &UserError{Message: "in progress reception already exists"}
+	ErrExistingUser            = &UserError{Message: "user already exists"}
+	ErrWebhookUrl              = &UserError{Message: "webhook url must be an absolute http or https url"}
+	ErrWebhookSecret           = &UserError{Message: "webhook secret must not be empty"}
+	ErrWebhookEventType        = &UserError{Message: "invalid webhook event type"}
+	ErrWebhookPvzNotFound      = &UserError{Message: "pvz for webhook not found"}
+	ErrWebhookNotFound         = &UserError{Message: "webhook subscription not found"}
+	ErrWebhookDeliveryDead     = &UserError{Message: "webhook delivery is not in dead state"}
+	ErrWebhookDeliveryNotFound = &UserError{Message: "webhook delivery not found"}
+	ErrWebhookStatus           = &UserError{Message: "invalid webhook delivery status"}
+	ErrCityName                = &UserError{Message: "city name must not be empty"}
+	ErrCityRegion              = &UserError{Message: "city region must not be empty"}
+	ErrCityTimezone            = &UserError{Message: "invalid city timezone"}
+	ErrCityExists              = &UserError{Message: "city already exists"}
+	ErrCityNotFound            = &UserError{Message: "city not found"}
+	ErrCityInUse               = &UserError{Message: "city is used by pvz"}
+	ErrCityAutoCloseHours      = &UserError{Message: "receptionAutoCloseHours must be greater than zero"}
+	ErrProductTypeCode         = &UserError{Message: "product type code must not be empty"}
+	ErrProductTypeName         = &UserError{Message: "product type display names must not be empty"}
+	ErrProductTypeMaxValue     = &UserError{Message: "maxPerReception must be greater than zero"}
+	ErrProductTypeExists       = &UserError{Message: "product type already exists"}
+	ErrProductTypeNotFound     = &UserError{Message: "product type not found"}
+	ErrProductTypeInUse        = &UserError{Message: "product type is used by products"}
+	ErrProductTypeLimit        = &UserError{Message: "product type limit per reception reached"}
+	ErrProductBarcode          = &UserError{Message: "product barcode must not be empty"}
+	ErrProductWeight           = &UserError{Message: "product weight must be greater than zero"}
+	ErrProductDimensions       = &UserError{Message: "product dimensions must be greater than zero"}
+	ErrBarcodeExists           = &UserError{Message: "product with this barcode is already in an open reception"}
+	ErrProductNotFound         = &UserError{Message: "product not found"}
+	ErrBatchSize               = &UserError{Message: "batch must contain from 1 to 1000 products"}
+	ErrBatchPvz                = &UserError{Message: "all products in batch must belong to the same pvz"}
+	ErrDeletedProduct          = &UserError{Message: "deleted product not found in reception in progress"}
+	ErrReceptionNotFound       = &UserError{Message: "reception not found"}
+	ErrReceptionNotClosed      = &UserError{Message: "only closed reception can be reopened"}
+	ErrNewerReception          = &UserError{Message: "pvz already has a newer reception"}
+	ErrReopenReason            = &UserError{Message: "reopen reason must not be empty"}
+	ErrReceptionPaused         = &UserError{Message: "reception is paused"}
+	ErrReceptionFinalized      = &UserError{Message: "reception is verified or cancelled and cannot be changed"}
+	ErrReceptionTransition     = &UserError{Message: "invalid reception status transition"}
+	ErrTransitionRole          = &UserError{Message: "user role is not allowed to make this reception status transition"}
+	ErrReceptionChanged        = &UserError{Message: "reception status was changed concurrently"}
+	ErrNoPvz                   = &UserError{Message: "pvz does not exist"}
+	ErrPvzCapacity             = &UserError{Message: "pvz capacity must be greater than zero"}
+	ErrPvzInactive             = &UserError{Message: "pvz is deactivated"}
+	ErrPvzArchived             = &UserError{Message: "pvz is archived"}
+	ErrPvzStatus               = &UserError{Message: "pvz already has this status"}
+	ErrPvzOpenReception        = &UserError{Message: "pvz has an open reception"}
+	ErrPvzChanged              = &UserError{Message: "pvz status was changed concurrently"}
+	ErrPvzLocation             = &UserError{Message: "pvz latitude and longitude must be set together"}
+	ErrLatitudeValue           = &UserError{Message: "latitude must be between -90 and 90"}
+	ErrLongitudeValue          = &UserError{Message: "longitude must be between -180 and 180"}
+	ErrRadiusValue             = &UserError{Message: "radius must be between 1 and 50000 meters"}
+	ErrPvzFull                 = &UserError{Message: "pvz capacity is reached"}
+	ErrPvzProductTypeFull      = &UserError{Message: "pvz capacity for this product type is reached"}
+	ErrStorageHours            = &UserError{Message: "storage hours must be greater than zero"}
+	ErrDuplicateCapacity       = &UserError{Message: "capacity for product type is set more than once"}
+	ErrRefreshToken            = &UserError{Message: "invalid refresh token"}
+	ErrRefreshTokenExpired     = &UserError{Message: "refresh token is expired"}
+	ErrRefreshTokenReused      = &UserError{Message: "refresh token was already used, session is revoked"}
+	ErrAccessToken             = &UserError{Message: "invalid access token"}
+	ErrTokenExpired            = &UserError{Message: "token is expired"}
+	ErrTokenRevoked            = &UserError{Message: "token is revoked"}
 )
@@ -0,0 +1,30 @@
+package event_model
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type Event struct {
+	Id          pgtype.UUID `json:"id"`
+	Type        EventType   `json:"type"`
+	PvzId       pgtype.UUID `json:"pvz_id"`
+	ReceptionId pgtype.UUID `json:"reception_id"`
+	ProductId   pgtype.UUID `json:"product_id"`
+	ProductType string      `json:"product_type,omitempty"`
+	OccurredAt  time.Time   `json:"occurred_at"`
+}
+
+type EventType string
+
+const (
+	ReceptionOpened    EventType = "reception_opened"
+	ReceptionClosed    EventType = "reception_closed"
+	ReceptionReopened  EventType = "reception_reopened"
+	ReceptionPaused    EventType = "reception_paused"
+	ReceptionResumed   EventType = "reception_resumed"
+	ReceptionCancelled EventType = "reception_cancelled"
+	ReceptionVerified  EventType = "reception_verified"
+	ProductAdded       EventType = "product_added"
+	ProductDeleted     EventType = "product_deleted"
+)
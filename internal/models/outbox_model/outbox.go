@@ -0,0 +1,26 @@
+package outbox_model
+
+import (
+	"encoding/json"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type OutboxEvent struct {
+	Id            pgtype.UUID     `json:"id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+	Status        OutboxStatus    `json:"-"`
+	Attempts      int             `json:"-"`
+	NextAttemptAt time.Time       `json:"-"`
+	LastError     string          `json:"-"`
+}
+
+type OutboxStatus string
+
+const (
+	Pending   OutboxStatus = "pending"
+	Published OutboxStatus = "published"
+	Failed    OutboxStatus = "failed"
+)
@@ -9,6 +9,18 @@ type Product struct {
 	Id          pgtype.UUID
 	AddingTime  time.Time
 	ProductType ProductType
+	ReceptionId pgtype.UUID
+	Barcode     *string
+	Sku         *string
+	OrderId     *string
+	WeightGrams *int
+	Dimensions  *Dimensions
+}
+
+type Dimensions struct {
+	LengthMm int
+	WidthMm  int
+	HeightMm int
 }
 
 type ProductType string
@@ -0,0 +1,13 @@
+package product_type_model
+
+import "time"
+
+type ProductType struct {
+	Code            string
+	DisplayNameRu   string
+	DisplayNameEn   string
+	Fragile         bool
+	Oversize        bool
+	MaxPerReception *int
+	CreatedAt       time.Time
+}
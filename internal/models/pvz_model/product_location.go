@@ -0,0 +1,12 @@
+package pvz_model
+
+import (
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
+)
+
+type ProductLocation struct {
+	Product   product_model.Product
+	Reception reception_model.Reception
+	Pvz       Pvz
+}
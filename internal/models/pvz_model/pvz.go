@@ -9,6 +9,18 @@ type Pvz struct {
 	Id               pgtype.UUID
 	RegistrationDate time.Time
 	City             City
+	Address          *string
+	WorkingHours     *string
+	Capacity         *int
+	Status           PvzStatus
+	ArchivedAt       *time.Time
+	Latitude         *float64
+	Longitude        *float64
+}
+
+type NearbyPvz struct {
+	Pvz      Pvz
+	Distance float64
 }
 
 type City string
@@ -18,3 +30,11 @@ const (
 	SPb    City = "Санкт-Петербург"
 	Kazan  City = "Казань"
 )
+
+type PvzStatus string
+
+const (
+	Active   PvzStatus = "active"
+	Inactive PvzStatus = "inactive"
+	Archived PvzStatus = "archived"
+)
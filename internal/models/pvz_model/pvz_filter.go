@@ -0,0 +1,33 @@
+package pvz_model
+
+import (
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type PvzFilter struct {
+	Limit           uint32
+	Offset          uint32
+	StartInterval   *time.Time
+	EndInterval     *time.Time
+	City            *City
+	ReceptionStatus *reception_model.ReceptionStatus
+	ProductType     *product_model.ProductType
+	MinProducts     *int
+	SortBy          PvzSort
+	After           *PvzCursor
+}
+
+type PvzCursor struct {
+	SortValue time.Time
+	Id        pgtype.UUID
+}
+
+type PvzSort string
+
+const (
+	SortByRegistrationDate PvzSort = "registrationDate"
+	SortByLastActivity     PvzSort = "lastActivity"
+)
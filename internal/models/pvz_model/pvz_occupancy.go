@@ -0,0 +1,15 @@
+package pvz_model
+
+import (
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type PvzOccupancy struct {
+	PvzId          pgtype.UUID
+	Capacity       *int
+	Occupied       int
+	StorageHours   int
+	TypeCapacities map[product_model.ProductType]int
+	TypeOccupied   map[product_model.ProductType]int
+}
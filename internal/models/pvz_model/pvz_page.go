@@ -0,0 +1,13 @@
+package pvz_model
+
+type PvzPage struct {
+	Pvzs       []PvzWithReceptions
+	Page       int
+	Limit      int
+	Total      int
+	NextCursor string
+}
+
+func (p *PvzPage) TotalPages() int {
+	return (p.Total + p.Limit - 1) / p.Limit
+}
@@ -0,0 +1,18 @@
+package pvz_model
+
+import (
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
+	"time"
+)
+
+type PvzWithReceptions struct {
+	Pvz          Pvz
+	LastActivity time.Time
+	Receptions   []ReceptionWithProducts
+}
+
+type ReceptionWithProducts struct {
+	Reception reception_model.Reception
+	Products  []product_model.Product
+}
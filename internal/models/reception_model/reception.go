@@ -11,6 +11,7 @@ type Reception struct {
 	PvzId         pgtype.UUID
 	ProductIds    []pgtype.UUID
 	Status        ReceptionStatus
+	CloseReason   *string
 }
 
 type ReceptionStatus string
@@ -18,4 +19,26 @@ type ReceptionStatus string
 const (
 	InProgress ReceptionStatus = "in_progress"
 	Close      ReceptionStatus = "close"
+	AutoClosed ReceptionStatus = "auto_closed"
+	Paused     ReceptionStatus = "paused"
+	Verified   ReceptionStatus = "verified"
+	Cancelled  ReceptionStatus = "cancelled"
 )
+
+type ReceptionCorrection struct {
+	Id          pgtype.UUID
+	ReceptionId pgtype.UUID
+	ClosedAt    *time.Time
+	ReopenedBy  pgtype.UUID
+	ReopenedAt  time.Time
+	Reason      string
+}
+
+type ReceptionFilter struct {
+	PvzId         pgtype.UUID
+	Status        *ReceptionStatus
+	StartInterval *time.Time
+	EndInterval   *time.Time
+	Limit         uint32
+	Offset        uint32
+}
@@ -1,9 +1,18 @@
 package user_model
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
 	"github.com/golang-jwt/jwt/v5"
-	"os"
+	"github.com/rs/zerolog/log"
+	"maps"
+	"math/big"
+	"slices"
 	"time"
 )
 
@@ -14,23 +23,106 @@ type JwtClaims struct {
 	jwt.RegisteredClaims
 }
 
-const JwtExpiry = time.Hour * 24
+const (
+	JwtExpiry          = time.Minute * 15
+	RefreshTokenExpiry = time.Hour * 24 * 30
+)
 
-func GetJwtSecret() []byte {
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		panic("JWT_SECRET environment variable not set!")
-	}
-	return []byte(secret)
+const minRsaKeyBits = 2048
+
+type JwtKeysConfig struct {
+	Secret       []byte
+	SigningKeyId string
+	PrivateKeys  map[string][]byte
+	PublicKeys   map[string][]byte
 }
 
-func ValidateToken(tokenString string) (*JwtClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JwtClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, custom_errors.ErrSigningMethod
+type JwtKey struct {
+	Id         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+type JwtKeySet struct {
+	secret     []byte
+	signingKey *JwtKey
+	keys       map[string]*JwtKey
+}
+
+type Jwk struct {
+	Kty string  `json:"kty"`
+	Kid string  `json:"kid"`
+	Use string  `json:"use"`
+	Alg string  `json:"alg"`
+	N   *string `json:"n,omitempty"`
+	E   *string `json:"e,omitempty"`
+	Crv *string `json:"crv,omitempty"`
+	X   *string `json:"x,omitempty"`
+}
+
+func NewJwtKeySet(config JwtKeysConfig) (*JwtKeySet, error) {
+	keySet := &JwtKeySet{secret: config.Secret, keys: make(map[string]*JwtKey)}
+
+	for _, kid := range slices.Sorted(maps.Keys(config.PrivateKeys)) {
+		key, err := parsePrivateKey(kid, config.PrivateKeys[kid])
+		if err != nil {
+			return nil, err
+		}
+		keySet.keys[kid] = key
+	}
+
+	for _, kid := range slices.Sorted(maps.Keys(config.PublicKeys)) {
+		if _, ok := keySet.keys[kid]; ok {
+			log.Error().Str("kid", kid).Msg(custom_errors.ErrDuplicateJwtKey.Message)
+			return nil, custom_errors.ErrDuplicateJwtKey
+		}
+
+		key, err := parsePublicKey(kid, config.PublicKeys[kid])
+		if err != nil {
+			return nil, err
 		}
-		return GetJwtSecret(), nil
-	})
+		keySet.keys[kid] = key
+	}
+
+	signingKeyId := config.SigningKeyId
+	if signingKeyId == "" && len(config.PrivateKeys) == 1 {
+		for kid := range config.PrivateKeys {
+			signingKeyId = kid
+		}
+	}
+
+	if signingKeyId != "" {
+		key, ok := keySet.keys[signingKeyId]
+		if !ok || key.PrivateKey == nil {
+			log.Error().Str("kid", signingKeyId).Msg(custom_errors.ErrUnknownJwtKey.Message)
+			return nil, custom_errors.ErrUnknownJwtKey
+		}
+		keySet.signingKey = key
+	}
+
+	if keySet.signingKey == nil && len(keySet.secret) == 0 {
+		log.Error().Msg(custom_errors.ErrJwtKeysNotConfigured.Message)
+		return nil, custom_errors.ErrJwtKeysNotConfigured
+	}
+
+	return keySet, nil
+}
+
+func (k *JwtKeySet) Sign(claims jwt.Claims) (string, error) {
+	if k.signingKey == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(k.secret)
+	}
+
+	token := jwt.NewWithClaims(k.signingKey.Method, claims)
+	token.Header["kid"] = k.signingKey.Id
+
+	return token.SignedString(k.signingKey.PrivateKey)
+}
+
+func (k *JwtKeySet) ValidateToken(tokenString string) (*JwtClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &JwtClaims{}, k.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
 
 	if err != nil {
 		return nil, err
@@ -42,3 +134,112 @@ func ValidateToken(tokenString string) (*JwtClaims, error) {
 
 	return nil, custom_errors.ErrInvalidToken
 }
+
+func (k *JwtKeySet) Jwks() []Jwk {
+	jwks := make([]Jwk, 0, len(k.keys))
+	for _, kid := range slices.Sorted(maps.Keys(k.keys)) {
+		key := k.keys[kid]
+		jwk := Jwk{Kid: key.Id, Use: "sig", Alg: key.Method.Alg()}
+
+		switch publicKey := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			n := base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+			jwk.Kty, jwk.N, jwk.E = "RSA", &n, &e
+		case ed25519.PublicKey:
+			crv := "Ed25519"
+			x := base64.RawURLEncoding.EncodeToString(publicKey)
+			jwk.Kty, jwk.Crv, jwk.X = "OKP", &crv, &x
+		}
+
+		jwks = append(jwks, jwk)
+	}
+
+	return jwks
+}
+
+func (k *JwtKeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if len(k.secret) == 0 {
+			return nil, custom_errors.ErrSigningMethod
+		}
+		return k.secret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, custom_errors.ErrUnknownJwtKey
+	}
+	if key.Method.Alg() != token.Method.Alg() {
+		return nil, custom_errors.ErrSigningMethod
+	}
+
+	return key.PublicKey, nil
+}
+
+func parsePrivateKey(kid string, data []byte) (*JwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		log.Error().Str("kid", kid).Msg(custom_errors.ErrParseJwtKey.Message)
+		return nil, custom_errors.ErrParseJwtKey
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("kid", kid).Msg(custom_errors.ErrParseJwtKey.Message)
+		return nil, custom_errors.ErrParseJwtKey
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		log.Error().Str("kid", kid).Msg(custom_errors.ErrUnsupportedJwtKey.Message)
+		return nil, custom_errors.ErrUnsupportedJwtKey
+	}
+
+	key, err := newJwtKey(kid, signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	key.PrivateKey = signer
+
+	return key, nil
+}
+
+func parsePublicKey(kid string, data []byte) (*JwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		log.Error().Str("kid", kid).Msg(custom_errors.ErrParseJwtKey.Message)
+		return nil, custom_errors.ErrParseJwtKey
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("kid", kid).Msg(custom_errors.ErrParseJwtKey.Message)
+		return nil, custom_errors.ErrParseJwtKey
+	}
+
+	return newJwtKey(kid, publicKey)
+}
+
+func newJwtKey(kid string, publicKey crypto.PublicKey) (*JwtKey, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < minRsaKeyBits {
+			log.Error().Str("kid", kid).Int("bits", key.N.BitLen()).Msg(custom_errors.ErrUnsupportedJwtKey.Message)
+			return nil, custom_errors.ErrUnsupportedJwtKey
+		}
+		return &JwtKey{Id: kid, Method: jwt.SigningMethodRS256, PublicKey: key}, nil
+	case ed25519.PublicKey:
+		return &JwtKey{Id: kid, Method: jwt.SigningMethodEdDSA, PublicKey: key}, nil
+	default:
+		log.Error().Str("kid", kid).Msg(custom_errors.ErrUnsupportedJwtKey.Message)
+		return nil, custom_errors.ErrUnsupportedJwtKey
+	}
+}
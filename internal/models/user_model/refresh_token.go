@@ -0,0 +1,22 @@
+package user_model
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type RefreshToken struct {
+	Id              pgtype.UUID
+	FamilyId        pgtype.UUID
+	UserId          pgtype.UUID
+	TokenHash       []byte
+	AccessJti       pgtype.UUID
+	AccessExpiresAt time.Time
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+}
+
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+}
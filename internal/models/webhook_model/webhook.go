@@ -0,0 +1,41 @@
+package webhook_model
+
+import (
+	"encoding/json"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+type Subscription struct {
+	Id         pgtype.UUID
+	Url        string
+	Secret     string
+	EventTypes []event_model.EventType
+	PvzId      pgtype.UUID
+	CreatedAt  time.Time
+}
+
+type Delivery struct {
+	Id             pgtype.UUID
+	SubscriptionId pgtype.UUID
+	EventId        pgtype.UUID
+	EventType      event_model.EventType
+	Payload        json.RawMessage
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+	LastError      *string
+	Url            string
+	Secret         string
+}
+
+type DeliveryStatus string
+
+const (
+	Pending   DeliveryStatus = "pending"
+	Delivered DeliveryStatus = "delivered"
+	Dead      DeliveryStatus = "dead"
+)
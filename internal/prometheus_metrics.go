@@ -31,4 +31,19 @@ var (
 		Name: "products_added_total",
 		Help: "Total number of products added",
 	})
+
+	ReceptionAutoClosedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "reception_auto_closed_total",
+		Help: "Total number of receptions closed automatically",
+	})
+
+	DisabledRouteRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "disabled_route_requests_total",
+		Help: "Total number of requests to routes disabled in the current environment",
+	}, []string{"method", "endpoint"})
+
+	PvzOccupancy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pvz_occupancy",
+		Help: "Number of items in open and recently closed receptions of PVZ",
+	}, []string{"pvz_id"})
 )
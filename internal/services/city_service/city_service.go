@@ -0,0 +1,123 @@
+package city_service
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/city_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/city_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/rs/zerolog/log"
+	"strings"
+	"time"
+	_ "time/tzdata"
+)
+
+type CityService struct {
+	driver city_driver.ICityDriver
+}
+
+func NewCityService(driver city_driver.ICityDriver) *CityService {
+	return &CityService{driver: driver}
+}
+
+func (s *CityService) CreateCity(ctx context.Context, cityDto generated.City) (*generated.City, error) {
+	name := strings.TrimSpace(cityDto.Name)
+	if name == "" {
+		log.Warn().Msg(custom_errors.ErrCityName.Message)
+		return nil, custom_errors.ErrCityName
+	}
+
+	if err := validateCityParams(cityDto.Region, cityDto.Timezone, cityDto.ReceptionAutoCloseHours); err != nil {
+		return nil, err
+	}
+
+	city := &city_model.City{
+		Name:                    name,
+		Region:                  strings.TrimSpace(cityDto.Region),
+		Timezone:                cityDto.Timezone,
+		ReceptionAutoCloseHours: cityDto.ReceptionAutoCloseHours,
+		CreatedAt:               time.Now(),
+	}
+
+	if err := s.driver.CreateCity(ctx, city); err != nil {
+		return nil, err
+	}
+
+	return mapCityToDto(city), nil
+}
+
+func (s *CityService) GetCities(ctx context.Context) ([]generated.City, error) {
+	cities, err := s.driver.GetCities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	citiesDto := make([]generated.City, 0, len(cities))
+	for i := range cities {
+		citiesDto = append(citiesDto, *mapCityToDto(&cities[i]))
+	}
+
+	return citiesDto, nil
+}
+
+func (s *CityService) GetCity(ctx context.Context, name string) (*city_model.City, error) {
+	return s.driver.GetCityByName(ctx, name)
+}
+
+func (s *CityService) UpdateCity(ctx context.Context, name string, cityReq generated.PutCitiesNameJSONRequestBody) (*generated.City, error) {
+	if err := validateCityParams(cityReq.Region, cityReq.Timezone, cityReq.ReceptionAutoCloseHours); err != nil {
+		return nil, err
+	}
+
+	city := &city_model.City{
+		Name:                    name,
+		Region:                  strings.TrimSpace(cityReq.Region),
+		Timezone:                cityReq.Timezone,
+		ReceptionAutoCloseHours: cityReq.ReceptionAutoCloseHours,
+	}
+
+	if err := s.driver.UpdateCity(ctx, city); err != nil {
+		return nil, err
+	}
+
+	return mapCityToDto(city), nil
+}
+
+func (s *CityService) DeleteCity(ctx context.Context, name string) error {
+	return s.driver.DeleteCity(ctx, name)
+}
+
+func validateCityParams(region, timezone string, receptionAutoCloseHours *int) error {
+	if strings.TrimSpace(region) == "" {
+		log.Warn().Msg(custom_errors.ErrCityRegion.Message)
+		return custom_errors.ErrCityRegion
+	}
+
+	if timezone == "" || timezone == "Local" {
+		log.Warn().Msg(custom_errors.ErrCityTimezone.Message)
+		return custom_errors.ErrCityTimezone
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		log.Warn().Err(err).Msg(custom_errors.ErrCityTimezone.Message)
+		return custom_errors.ErrCityTimezone
+	}
+
+	if receptionAutoCloseHours != nil && *receptionAutoCloseHours <= 0 {
+		log.Warn().Msg(custom_errors.ErrCityAutoCloseHours.Message)
+		return custom_errors.ErrCityAutoCloseHours
+	}
+
+	return nil
+}
+
+func mapCityToDto(city *city_model.City) *generated.City {
+	createdAt := city.CreatedAt
+	return &generated.City{
+		Name:                    city.Name,
+		Region:                  city.Region,
+		Timezone:                city.Timezone,
+		ReceptionAutoCloseHours: city.ReceptionAutoCloseHours,
+		CreatedAt:               &createdAt,
+	}
+}
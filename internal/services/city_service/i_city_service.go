@@ -0,0 +1,15 @@
+package city_service
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/city_model"
+)
+
+type ICityService interface {
+	CreateCity(ctx context.Context, cityDto generated.City) (*generated.City, error)
+	GetCities(ctx context.Context) ([]generated.City, error)
+	GetCity(ctx context.Context, name string) (*city_model.City, error)
+	UpdateCity(ctx context.Context, name string, cityReq generated.PutCitiesNameJSONRequestBody) (*generated.City, error)
+	DeleteCity(ctx context.Context, name string) error
+}
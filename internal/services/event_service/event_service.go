@@ -0,0 +1,108 @@
+package event_service
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/event_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services"
+	"github.com/rs/zerolog/log"
+	"sync"
+	"time"
+)
+
+const (
+	subscriberBufferSize = 64
+	listenBaseBackoff    = 100 * time.Millisecond
+	listenMaxBackoff     = 30 * time.Second
+)
+
+type EventService struct {
+	driver      event_driver.IEventDriver
+	mu          sync.RWMutex
+	subscribers map[uint64]chan event_model.Event
+	nextId      uint64
+}
+
+func NewEventService(driver event_driver.IEventDriver) *EventService {
+	return &EventService{driver: driver, subscribers: make(map[uint64]chan event_model.Event)}
+}
+
+func (s *EventService) Publish(ctx context.Context, event *event_model.Event) {
+	if !event.Id.Valid {
+		event.Id = services.GenerateUuid()
+	}
+
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	if s.driver == nil {
+		s.dispatch(*event)
+		return
+	}
+
+	if err := s.driver.Notify(ctx, event); err != nil {
+		log.Error().Err(err).Msgf("failed to publish %s event", event.Type)
+	}
+}
+
+func (s *EventService) Subscribe() (<-chan event_model.Event, func()) {
+	ch := make(chan event_model.Event, subscriberBufferSize)
+
+	s.mu.Lock()
+	id := s.nextId
+	s.nextId++
+	s.subscribers[id] = ch
+	s.mu.Unlock()
+
+	unsubscribe := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, exists := s.subscribers[id]; exists {
+			delete(s.subscribers, id)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+func (s *EventService) Listen(ctx context.Context) {
+	if s.driver == nil {
+		return
+	}
+
+	attempts := 0
+	for {
+		err := s.driver.Listen(ctx, func(event event_model.Event) {
+			attempts = 0
+			s.dispatch(event)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+
+		attempts++
+		backoff := services.ExponentialBackoff(attempts, listenBaseBackoff, listenMaxBackoff)
+		log.Error().Err(err).Msgf("event listener stopped, reconnecting in %s", backoff)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func (s *EventService) dispatch(event event_model.Event) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, ch := range s.subscribers {
+		select {
+		case ch <- event:
+		default:
+			log.Warn().Msgf("event subscriber is too slow, %s event dropped", event.Type)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package event_service
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+)
+
+type IEventService interface {
+	Publish(ctx context.Context, event *event_model.Event)
+	Subscribe() (<-chan event_model.Event, func())
+}
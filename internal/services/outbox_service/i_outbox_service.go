@@ -0,0 +1,11 @@
+package outbox_service
+
+import (
+	"context"
+	"time"
+)
+
+type IOutboxService interface {
+	RelayPendingEvents(ctx context.Context) error
+	Run(ctx context.Context, interval time.Duration)
+}
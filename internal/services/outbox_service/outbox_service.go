@@ -0,0 +1,81 @@
+package outbox_service
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/outbox_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/sink_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/outbox_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+const (
+	relayBatchSize = 100
+	relayLease     = time.Minute
+	maxAttempts    = 10
+	baseBackoff    = time.Second
+	maxBackoff     = time.Hour
+)
+
+type OutboxService struct {
+	driver outbox_driver.IOutboxDriver
+	sink   sink_driver.ISinkDriver
+}
+
+func NewOutboxService(driver outbox_driver.IOutboxDriver, sink sink_driver.ISinkDriver) *OutboxService {
+	return &OutboxService{driver: driver, sink: sink}
+}
+
+func (s *OutboxService) RelayPendingEvents(ctx context.Context) error {
+	now := time.Now()
+	events, err := s.driver.ClaimPendingEvents(ctx, relayBatchSize, now, now.Add(relayLease))
+	if err != nil {
+		return err
+	}
+
+	for i := range events {
+		event := &events[i]
+
+		if err = s.sink.Send(ctx, event); err != nil {
+			s.markFailed(ctx, event, err)
+			continue
+		}
+
+		if err = s.driver.MarkPublished(ctx, event.Id, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *OutboxService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RelayPendingEvents(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to relay outbox events")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *OutboxService) markFailed(ctx context.Context, event *outbox_model.OutboxEvent, sendErr error) {
+	event.LastError = sendErr.Error()
+	event.NextAttemptAt = time.Now().Add(services.ExponentialBackoff(event.Attempts, baseBackoff, maxBackoff))
+	if event.Attempts >= maxAttempts {
+		event.Status = outbox_model.Failed
+		log.Warn().Msgf("outbox event %s moved to failed after %d attempts", event.Id.String(), event.Attempts)
+	}
+
+	if err := s.driver.MarkFailed(ctx, event); err != nil {
+		log.Error().Err(err).Msgf("failed to record outbox attempt for event %s", event.Id.String())
+	}
+}
@@ -3,10 +3,15 @@ package product_service
 import (
 	"context"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/jackc/pgx/v5/pgtype"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 type IProductService interface {
-	CreateProduct(ctx context.Context, pvzIdDto openapi_types.UUID, productTypeJson generated.PostProductsJSONBodyType) (*generated.Product, error)
-	DeleteLastProduct(ctx context.Context, pvzIdDto openapi_types.UUID) error
+	CreateProduct(ctx context.Context, productReq generated.PostProductsJSONRequestBody) (*generated.Product, error)
+	CreateProducts(ctx context.Context, batchReq generated.PostProductsBatchJSONRequestBody) (*generated.ProductBatchResult, error)
+	DeleteLastProduct(ctx context.Context, pvzIdDto openapi_types.UUID, deletedBy pgtype.UUID) error
+	DeleteProduct(ctx context.Context, pvzIdDto openapi_types.UUID, productIdDto openapi_types.UUID, deletedBy pgtype.UUID) error
+	RestoreProduct(ctx context.Context, pvzIdDto openapi_types.UUID, productIdDto openapi_types.UUID) (*generated.Product, error)
+	GetProductByBarcode(ctx context.Context, barcode string) (*generated.ProductLocation, error)
 }
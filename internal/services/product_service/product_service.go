@@ -2,35 +2,55 @@ package product_service
 
 import (
 	"context"
+	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/product_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
-	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_type_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/event_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_type_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/reception_service"
+	"github.com/jackc/pgx/v5/pgtype"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/rs/zerolog/log"
+	"strings"
 	"time"
 )
 
+const MaxBatchSize = 1000
+
 type ProductService struct {
-	driver           product_driver.IProductDriver
-	receptionService reception_service.IReceptionService
+	driver             product_driver.IProductDriver
+	receptionService   reception_service.IReceptionService
+	eventService       event_service.IEventService
+	productTypeService product_type_service.IProductTypeService
 }
 
-func NewProductService(driver product_driver.IProductDriver, receptionService reception_service.IReceptionService) *ProductService {
-	return &ProductService{driver: driver, receptionService: receptionService}
+func NewProductService(driver product_driver.IProductDriver, receptionService reception_service.IReceptionService, eventService event_service.IEventService, productTypeService product_type_service.IProductTypeService) *ProductService {
+	return &ProductService{driver: driver, receptionService: receptionService, eventService: eventService, productTypeService: productTypeService}
 }
 
-func (s *ProductService) CreateProduct(ctx context.Context, pvzIdDto openapi_types.UUID, productTypeJson generated.PostProductsJSONBodyType) (*generated.Product, error) {
-	productType, err := mapJsonToProductType(productTypeJson)
+func (s *ProductService) CreateProduct(ctx context.Context, productReq generated.PostProductsJSONRequestBody) (*generated.Product, error) {
+	productTypeInfo, err := s.productTypeService.GetProductType(ctx, productReq.Type)
+	if errors.Is(err, custom_errors.ErrProductTypeNotFound) {
+		log.Error().Msg(custom_errors.ErrProductType.Message)
+		return nil, custom_errors.ErrProductType
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	pvzId, err := services.ConvertOpenAPIUuidToPgType(pvzIdDto)
+	product, err := mapProductReqToProduct(productReq)
+	if err != nil {
+		return nil, err
+	}
+	product.ProductType = product_model.ProductType(productTypeInfo.Code)
+
+	pvzId, err := services.ConvertOpenAPIUuidToPgType(productReq.PvzId)
 	if err != nil {
 		return nil, err
 	}
@@ -40,20 +60,103 @@ func (s *ProductService) CreateProduct(ctx context.Context, pvzIdDto openapi_typ
 		return nil, err
 	}
 
-	if *status == reception_model.Close {
-		log.Warn().Msg(custom_errors.ErrNoOpenReception.Message)
-		return nil, custom_errors.ErrNoOpenReception
+	if err = services.ValidateReceptionAcceptsProducts(*status); err != nil {
+		return nil, err
+	}
+
+	product.Id = services.GenerateUuid()
+	product.AddingTime = time.Now()
+
+	receptionId, err := s.driver.CreateProduct(ctx, product, pvzId, productTypeInfo.MaxPerReception)
+	if err != nil {
+		return nil, err
+	}
+	product.ReceptionId = *receptionId
+
+	productDto, err := services.MapProductToDto(product)
+	if err != nil {
+		return nil, err
+	}
+
+	s.eventService.Publish(ctx, &event_model.Event{
+		Type:        event_model.ProductAdded,
+		PvzId:       pvzId,
+		ReceptionId: *receptionId,
+		ProductId:   product.Id,
+		ProductType: string(product.ProductType),
+	})
+
+	internal.ProductCreatedTotal.Inc()
+
+	return productDto, nil
+}
+
+func (s *ProductService) CreateProducts(ctx context.Context, batchReq generated.PostProductsBatchJSONRequestBody) (*generated.ProductBatchResult, error) {
+	if len(batchReq.Items) == 0 || len(batchReq.Items) > MaxBatchSize {
+		log.Warn().Msg(custom_errors.ErrBatchSize.Message)
+		return nil, custom_errors.ErrBatchSize
 	}
 
-	id := services.GenerateUuid()
+	pvzId, err := services.ConvertOpenAPIUuidToPgType(batchReq.PvzId)
+	if err != nil {
+		return nil, err
+	}
 
-	product := &product_model.Product{Id: id, AddingTime: time.Now(), ProductType: productType}
-	receptionId, err := s.driver.CreateProduct(ctx, product, pvzId)
+	status, err := s.receptionService.GetLastReceptionStatus(ctx, pvzId)
 	if err != nil {
 		return nil, err
 	}
 
-	idDto, err := services.ConvertPgUuidToOpenAPI(product.Id)
+	if err = services.ValidateReceptionAcceptsProducts(*status); err != nil {
+		return nil, err
+	}
+
+	itemErrors := make([]error, len(batchReq.Items))
+	productTypes := make(map[string]*product_type_model.ProductType)
+	maxPerReception := make(map[product_model.ProductType]int)
+	var products []*product_model.Product
+	var productIndexes []int
+	for i, item := range batchReq.Items {
+		productTypeInfo, exists := productTypes[item.Type]
+		if !exists {
+			productTypeInfo, err = s.productTypeService.GetProductType(ctx, item.Type)
+			if err != nil && !errors.Is(err, custom_errors.ErrProductTypeNotFound) {
+				return nil, err
+			}
+			productTypes[item.Type] = productTypeInfo
+		}
+
+		if productTypeInfo == nil {
+			itemErrors[i] = custom_errors.ErrProductType
+			continue
+		}
+
+		product, err := mapProductReqToProduct(generated.PostProductsJSONRequestBody{
+			PvzId:       batchReq.PvzId,
+			Type:        item.Type,
+			Barcode:     item.Barcode,
+			Sku:         item.Sku,
+			OrderId:     item.OrderId,
+			WeightGrams: item.WeightGrams,
+			Dimensions:  item.Dimensions,
+		})
+		if err != nil {
+			itemErrors[i] = err
+			continue
+		}
+
+		product.Id = services.GenerateUuid()
+		product.AddingTime = time.Now()
+		product.ProductType = product_model.ProductType(productTypeInfo.Code)
+		if productTypeInfo.MaxPerReception != nil {
+			maxPerReception[product.ProductType] = *productTypeInfo.MaxPerReception
+		}
+
+		products = append(products, product)
+		productIndexes = append(productIndexes, i)
+	}
+
+	receptionId, productErrors, err := s.driver.CreateProducts(ctx, products, pvzId, maxPerReception)
 	if err != nil {
 		return nil, err
 	}
@@ -63,47 +166,220 @@ func (s *ProductService) CreateProduct(ctx context.Context, pvzIdDto openapi_typ
 		return nil, err
 	}
 
-	productDto := &generated.Product{
-		Id:          &idDto,
-		DateTime:    &product.AddingTime,
-		ReceptionId: receptionIdDto,
-		Type:        generated.ProductType(productType),
+	result := &generated.ProductBatchResult{ReceptionId: receptionIdDto, Items: make([]generated.ProductBatchItemResult, len(batchReq.Items))}
+	for i, product := range products {
+		index := productIndexes[i]
+		if productErrors[i] != nil {
+			itemErrors[index] = productErrors[i]
+			continue
+		}
+
+		product.ReceptionId = *receptionId
+		productDto, err := services.MapProductToDto(product)
+		if err != nil {
+			return nil, err
+		}
+		result.Items[index] = generated.ProductBatchItemResult{Index: index, Product: productDto}
+		result.Created++
+
+		s.eventService.Publish(ctx, &event_model.Event{
+			Type:        event_model.ProductAdded,
+			PvzId:       pvzId,
+			ReceptionId: *receptionId,
+			ProductId:   product.Id,
+			ProductType: string(product.ProductType),
+		})
+	}
+
+	for i, itemErr := range itemErrors {
+		if itemErr == nil {
+			continue
+		}
+
+		message := itemErr.Error()
+		result.Items[i] = generated.ProductBatchItemResult{Index: i, Error: &message}
+		result.Failed++
 	}
 
-	internal.ProductCreatedTotal.Inc()
+	internal.ProductCreatedTotal.Add(float64(result.Created))
+
+	return result, nil
+}
+
+func (s *ProductService) GetProductByBarcode(ctx context.Context, barcode string) (*generated.ProductLocation, error) {
+	location, err := s.driver.GetProductByBarcode(ctx, barcode)
+	if err != nil {
+		return nil, err
+	}
+
+	productDto, err := services.MapProductToDto(&location.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	receptionIdDto, err := services.ConvertPgUuidToOpenAPI(location.Reception.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzIdDto, err := services.ConvertPgUuidToOpenAPI(location.Pvz.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	registrationDate := location.Pvz.RegistrationDate
+	return &generated.ProductLocation{
+		Product: *productDto,
+		Reception: generated.Reception{
+			Id:       &receptionIdDto,
+			DateTime: location.Reception.ReceptionTime,
+			PvzId:    pvzIdDto,
+			Status:   generated.ReceptionStatus(location.Reception.Status),
+		},
+		Pvz: generated.PVZ{
+			Id:               &pvzIdDto,
+			RegistrationDate: &registrationDate,
+			City:             string(location.Pvz.City),
+		},
+	}, nil
+}
+
+func (s *ProductService) DeleteLastProduct(ctx context.Context, pvzIdDto openapi_types.UUID, deletedBy pgtype.UUID) error {
+	pvzId, err := s.getPvzIdWithOpenReception(ctx, pvzIdDto)
+	if err != nil {
+		return err
+	}
+
+	product, err := s.driver.DeleteLastProduct(ctx, pvzId, deletedBy)
+	if err != nil {
+		return err
+	}
+
+	s.publishProductEvent(ctx, event_model.ProductDeleted, pvzId, product)
+
+	return nil
+}
+
+func (s *ProductService) DeleteProduct(ctx context.Context, pvzIdDto openapi_types.UUID, productIdDto openapi_types.UUID, deletedBy pgtype.UUID) error {
+	pvzId, err := s.getPvzIdWithOpenReception(ctx, pvzIdDto)
+	if err != nil {
+		return err
+	}
+
+	productId, err := services.ConvertOpenAPIUuidToPgType(productIdDto)
+	if err != nil {
+		return err
+	}
+
+	product, err := s.driver.DeleteProduct(ctx, pvzId, productId, deletedBy)
+	if err != nil {
+		return err
+	}
+
+	s.publishProductEvent(ctx, event_model.ProductDeleted, pvzId, product)
+
+	return nil
+}
+
+func (s *ProductService) RestoreProduct(ctx context.Context, pvzIdDto openapi_types.UUID, productIdDto openapi_types.UUID) (*generated.Product, error) {
+	pvzId, err := s.getPvzIdWithOpenReception(ctx, pvzIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	productId, err := services.ConvertOpenAPIUuidToPgType(productIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.driver.RestoreProduct(ctx, pvzId, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	productDto, err := services.MapProductToDto(product)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishProductEvent(ctx, event_model.ProductAdded, pvzId, product)
 
 	return productDto, nil
 }
 
-func (s *ProductService) DeleteLastProduct(ctx context.Context, pvzIdDto openapi_types.UUID) error {
+func (s *ProductService) getPvzIdWithOpenReception(ctx context.Context, pvzIdDto openapi_types.UUID) (pgtype.UUID, error) {
 	pvzId, err := services.ConvertOpenAPIUuidToPgType(pvzIdDto)
 	if err != nil {
-		return err
+		return pgtype.UUID{}, err
 	}
 
 	status, err := s.receptionService.GetLastReceptionStatus(ctx, pvzId)
 	if err != nil {
-		return err
+		return pgtype.UUID{}, err
 	}
 
-	if *status == reception_model.Close {
-		log.Warn().Msg(custom_errors.ErrNoOpenReception.Message)
-		return custom_errors.ErrNoOpenReception
+	if err = services.ValidateReceptionAcceptsProducts(*status); err != nil {
+		return pgtype.UUID{}, err
 	}
 
-	return s.driver.DeleteLastProduct(ctx, pvzId)
+	return pvzId, nil
 }
 
-func mapJsonToProductType(productType generated.PostProductsJSONBodyType) (product_model.ProductType, error) {
-	switch productType {
-	case "электроника":
-		return product_model.Electronics, nil
-	case "обувь":
-		return product_model.Shoes, nil
-	case "одежда":
-		return product_model.Clothes, nil
-	default:
-		log.Error().Msg(custom_errors.ErrProductType.Message)
-		return "", custom_errors.ErrProductType
+func (s *ProductService) publishProductEvent(ctx context.Context, eventType event_model.EventType, pvzId pgtype.UUID, product *product_model.Product) {
+	s.eventService.Publish(ctx, &event_model.Event{
+		Type:        eventType,
+		PvzId:       pvzId,
+		ReceptionId: product.ReceptionId,
+		ProductId:   product.Id,
+		ProductType: string(product.ProductType),
+	})
+}
+
+func mapProductReqToProduct(productReq generated.PostProductsJSONRequestBody) (*product_model.Product, error) {
+	product := &product_model.Product{
+		Sku:         trimOptional(productReq.Sku),
+		OrderId:     trimOptional(productReq.OrderId),
+		WeightGrams: productReq.WeightGrams,
+	}
+
+	if productReq.Barcode != nil {
+		product.Barcode = trimOptional(productReq.Barcode)
+		if product.Barcode == nil {
+			log.Warn().Msg(custom_errors.ErrProductBarcode.Message)
+			return nil, custom_errors.ErrProductBarcode
+		}
+	}
+
+	if productReq.WeightGrams != nil && *productReq.WeightGrams < 1 {
+		log.Warn().Msg(custom_errors.ErrProductWeight.Message)
+		return nil, custom_errors.ErrProductWeight
+	}
+
+	if dimensions := productReq.Dimensions; dimensions != nil {
+		if dimensions.LengthMm < 1 || dimensions.WidthMm < 1 || dimensions.HeightMm < 1 {
+			log.Warn().Msg(custom_errors.ErrProductDimensions.Message)
+			return nil, custom_errors.ErrProductDimensions
+		}
+
+		product.Dimensions = &product_model.Dimensions{
+			LengthMm: dimensions.LengthMm,
+			WidthMm:  dimensions.WidthMm,
+			HeightMm: dimensions.HeightMm,
+		}
 	}
+
+	return product, nil
+}
+
+func trimOptional(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
 }
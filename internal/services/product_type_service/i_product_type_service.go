@@ -0,0 +1,15 @@
+package product_type_service
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_type_model"
+)
+
+type IProductTypeService interface {
+	CreateProductType(ctx context.Context, productTypeDto generated.ProductType) (*generated.ProductType, error)
+	GetProductTypes(ctx context.Context) ([]generated.ProductType, error)
+	GetProductType(ctx context.Context, code string) (*product_type_model.ProductType, error)
+	UpdateProductType(ctx context.Context, code string, productTypeReq generated.PutProductTypesCodeJSONRequestBody) (*generated.ProductType, error)
+	DeleteProductType(ctx context.Context, code string) error
+}
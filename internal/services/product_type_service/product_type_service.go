@@ -0,0 +1,115 @@
+package product_type_service
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/product_type_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_type_model"
+	"github.com/rs/zerolog/log"
+	"strings"
+	"time"
+)
+
+type ProductTypeService struct {
+	driver product_type_driver.IProductTypeDriver
+}
+
+func NewProductTypeService(driver product_type_driver.IProductTypeDriver) *ProductTypeService {
+	return &ProductTypeService{driver: driver}
+}
+
+func (s *ProductTypeService) CreateProductType(ctx context.Context, productTypeDto generated.ProductType) (*generated.ProductType, error) {
+	code := strings.TrimSpace(productTypeDto.Code)
+	if code == "" {
+		log.Warn().Msg(custom_errors.ErrProductTypeCode.Message)
+		return nil, custom_errors.ErrProductTypeCode
+	}
+
+	productType, err := mapProductTypeParams(code, productTypeDto.DisplayNameRu, productTypeDto.DisplayNameEn,
+		productTypeDto.Fragile, productTypeDto.Oversize, productTypeDto.MaxPerReception)
+	if err != nil {
+		return nil, err
+	}
+	productType.CreatedAt = time.Now()
+
+	if err = s.driver.CreateProductType(ctx, productType); err != nil {
+		return nil, err
+	}
+
+	return mapProductTypeToDto(productType), nil
+}
+
+func (s *ProductTypeService) GetProductTypes(ctx context.Context) ([]generated.ProductType, error) {
+	productTypes, err := s.driver.GetProductTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productTypesDto := make([]generated.ProductType, 0, len(productTypes))
+	for i := range productTypes {
+		productTypesDto = append(productTypesDto, *mapProductTypeToDto(&productTypes[i]))
+	}
+
+	return productTypesDto, nil
+}
+
+func (s *ProductTypeService) GetProductType(ctx context.Context, code string) (*product_type_model.ProductType, error) {
+	return s.driver.GetProductTypeByCode(ctx, code)
+}
+
+func (s *ProductTypeService) UpdateProductType(ctx context.Context, code string, productTypeReq generated.PutProductTypesCodeJSONRequestBody) (*generated.ProductType, error) {
+	productType, err := mapProductTypeParams(code, productTypeReq.DisplayNameRu, productTypeReq.DisplayNameEn,
+		productTypeReq.Fragile, productTypeReq.Oversize, productTypeReq.MaxPerReception)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.driver.UpdateProductType(ctx, productType); err != nil {
+		return nil, err
+	}
+
+	return mapProductTypeToDto(productType), nil
+}
+
+func (s *ProductTypeService) DeleteProductType(ctx context.Context, code string) error {
+	return s.driver.DeleteProductType(ctx, code)
+}
+
+func mapProductTypeParams(code, displayNameRu, displayNameEn string, fragile, oversize *bool, maxPerReception *int) (*product_type_model.ProductType, error) {
+	displayNameRu = strings.TrimSpace(displayNameRu)
+	displayNameEn = strings.TrimSpace(displayNameEn)
+	if displayNameRu == "" || displayNameEn == "" {
+		log.Warn().Msg(custom_errors.ErrProductTypeName.Message)
+		return nil, custom_errors.ErrProductTypeName
+	}
+
+	if maxPerReception != nil && *maxPerReception < 1 {
+		log.Warn().Msg(custom_errors.ErrProductTypeMaxValue.Message)
+		return nil, custom_errors.ErrProductTypeMaxValue
+	}
+
+	return &product_type_model.ProductType{
+		Code:            code,
+		DisplayNameRu:   displayNameRu,
+		DisplayNameEn:   displayNameEn,
+		Fragile:         fragile != nil && *fragile,
+		Oversize:        oversize != nil && *oversize,
+		MaxPerReception: maxPerReception,
+	}, nil
+}
+
+func mapProductTypeToDto(productType *product_type_model.ProductType) *generated.ProductType {
+	fragile := productType.Fragile
+	oversize := productType.Oversize
+	createdAt := productType.CreatedAt
+	return &generated.ProductType{
+		Code:            productType.Code,
+		DisplayNameRu:   productType.DisplayNameRu,
+		DisplayNameEn:   productType.DisplayNameEn,
+		Fragile:         &fragile,
+		Oversize:        &oversize,
+		MaxPerReception: productType.MaxPerReception,
+		CreatedAt:       &createdAt,
+	}
+}
@@ -4,10 +4,21 @@ import (
 	"context"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
+	"github.com/jackc/pgx/v5/pgtype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 type IPvzService interface {
 	CreatePvz(ctx context.Context, pvzDto generated.PVZ) (*generated.PVZ, error)
-	GetPvzFullInfo(ctx context.Context, pvzParams generated.GetPvzParams) ([]map[string]interface{}, error)
+	UpdatePvz(ctx context.Context, pvzIdDto openapi_types.UUID, pvzReq generated.PutPvzPvzIdJSONRequestBody) (*generated.PVZ, error)
+	DeactivatePvz(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZ, error)
+	ActivatePvz(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZ, error)
+	ArchivePvz(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZ, error)
+	GetPvzOccupancy(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZOccupancy, error)
+	UpdatePvzCapacity(ctx context.Context, pvzIdDto openapi_types.UUID, capacityReq generated.PutPvzPvzIdCapacityJSONRequestBody) (*generated.PVZOccupancy, error)
+	GetPvzFullInfo(ctx context.Context, pvzParams generated.GetPvzParams) (*pvz_model.PvzPage, error)
 	GetAllPvz(ctx context.Context) ([]pvz_model.Pvz, error)
+	GetPvzList(ctx context.Context, limit *int, cursor string) ([]pvz_model.Pvz, string, error)
+	GetNearbyPvz(ctx context.Context, latitude, longitude float64, radius *float64, limit *int) ([]pvz_model.NearbyPvz, error)
+	GetPvzById(ctx context.Context, id pgtype.UUID) (*pvz_model.Pvz, error)
 }
@@ -2,24 +2,40 @@ package pvz_service
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/pvz_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/city_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_type_service"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/rs/zerolog/log"
+	"sort"
+	"strings"
 	"time"
 )
 
+const (
+	defaultNearbyRadius = 5000.0
+	maxNearbyRadius     = 50000.0
+)
+
 type PvzService struct {
-	driver pvz_driver.IPvzDriver
+	driver             pvz_driver.IPvzDriver
+	cityService        city_service.ICityService
+	productTypeService product_type_service.IProductTypeService
 }
 
-func NewPvzService(driver pvz_driver.IPvzDriver) *PvzService {
-	return &PvzService{driver: driver}
+func NewPvzService(driver pvz_driver.IPvzDriver, cityService city_service.ICityService, productTypeService product_type_service.IProductTypeService) *PvzService {
+	return &PvzService{driver: driver, cityService: cityService, productTypeService: productTypeService}
 }
 
 func (s *PvzService) CreatePvz(ctx context.Context, pvzDto generated.PVZ) (*generated.PVZ, error) {
@@ -49,32 +65,186 @@ func (s *PvzService) CreatePvz(ctx context.Context, pvzDto generated.PVZ) (*gene
 		registrationDate = *pvzDto.RegistrationDate
 	}
 
-	city, err := mapCityDtoToCity(pvzDto.City)
+	city, err := s.getCity(ctx, pvzDto.City)
 	if err != nil {
 		return nil, err
 	}
 
-	pvz = &pvz_model.Pvz{Id: id, RegistrationDate: registrationDate, City: city}
+	if err = validatePvzCapacity(pvzDto.Capacity); err != nil {
+		return nil, err
+	}
+
+	if err = validatePvzLocation(pvzDto.Latitude, pvzDto.Longitude); err != nil {
+		return nil, err
+	}
+
+	pvz = &pvz_model.Pvz{
+		Id:               id,
+		RegistrationDate: registrationDate,
+		City:             city,
+		Address:          trimOptional(pvzDto.Address),
+		WorkingHours:     trimOptional(pvzDto.WorkingHours),
+		Capacity:         pvzDto.Capacity,
+		Status:           pvz_model.Active,
+		Latitude:         pvzDto.Latitude,
+		Longitude:        pvzDto.Longitude,
+	}
 
 	err = s.driver.CreatePvz(ctx, pvz)
 	if err != nil {
 		return nil, err
 	}
 
-	idDto, err := services.ConvertPgUuidToOpenAPI(id)
+	pvzResp, err := services.MapPvzToDto(pvz)
 	if err != nil {
 		return nil, err
 	}
 
-	pvzDto.Id = &idDto
-	pvzDto.RegistrationDate = &registrationDate
-
 	internal.PvzCreatedTotal.Inc()
 
-	return &pvzDto, nil
+	return pvzResp, nil
 }
 
-func (s *PvzService) GetPvzFullInfo(ctx context.Context, pvzParams generated.GetPvzParams) ([]map[string]interface{}, error) {
+func (s *PvzService) UpdatePvz(ctx context.Context, pvzIdDto openapi_types.UUID, pvzReq generated.PutPvzPvzIdJSONRequestBody) (*generated.PVZ, error) {
+	pvz, err := s.getPvz(ctx, pvzIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	if pvz.Status == pvz_model.Archived {
+		log.Warn().Msg(custom_errors.ErrPvzArchived.Message)
+		return nil, custom_errors.ErrPvzArchived
+	}
+
+	if err = validatePvzCapacity(pvzReq.Capacity); err != nil {
+		return nil, err
+	}
+
+	if err = validatePvzLocation(pvzReq.Latitude, pvzReq.Longitude); err != nil {
+		return nil, err
+	}
+
+	pvz.Address = trimOptional(pvzReq.Address)
+	pvz.WorkingHours = trimOptional(pvzReq.WorkingHours)
+	pvz.Capacity = pvzReq.Capacity
+	pvz.Latitude = pvzReq.Latitude
+	pvz.Longitude = pvzReq.Longitude
+
+	if err = s.driver.UpdatePvz(ctx, pvz); err != nil {
+		return nil, err
+	}
+
+	return services.MapPvzToDto(pvz)
+}
+
+func (s *PvzService) DeactivatePvz(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZ, error) {
+	return s.changePvzStatus(ctx, pvzIdDto, pvz_model.Active, pvz_model.Inactive)
+}
+
+func (s *PvzService) ActivatePvz(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZ, error) {
+	return s.changePvzStatus(ctx, pvzIdDto, pvz_model.Inactive, pvz_model.Active)
+}
+
+func (s *PvzService) ArchivePvz(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZ, error) {
+	pvz, err := s.getPvz(ctx, pvzIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	archivedAt := time.Now()
+	if err = s.driver.ArchivePvz(ctx, pvz.Id, archivedAt); err != nil {
+		return nil, err
+	}
+
+	pvz.Status = pvz_model.Archived
+	pvz.ArchivedAt = &archivedAt
+
+	return services.MapPvzToDto(pvz)
+}
+
+func (s *PvzService) GetPvzOccupancy(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZOccupancy, error) {
+	pvzId, err := services.ConvertOpenAPIUuidToPgType(pvzIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getPvzOccupancy(ctx, pvzId)
+}
+
+func (s *PvzService) UpdatePvzCapacity(ctx context.Context, pvzIdDto openapi_types.UUID, capacityReq generated.PutPvzPvzIdCapacityJSONRequestBody) (*generated.PVZOccupancy, error) {
+	pvz, err := s.getPvz(ctx, pvzIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	if pvz.Status == pvz_model.Archived {
+		log.Warn().Msg(custom_errors.ErrPvzArchived.Message)
+		return nil, custom_errors.ErrPvzArchived
+	}
+
+	if capacityReq.StorageHours < 1 {
+		log.Warn().Msg(custom_errors.ErrStorageHours.Message)
+		return nil, custom_errors.ErrStorageHours
+	}
+
+	typeCapacities := make(map[product_model.ProductType]int, len(capacityReq.ProductTypes))
+	for _, typeCapacity := range capacityReq.ProductTypes {
+		productType, err := s.getProductType(ctx, typeCapacity.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = validatePvzCapacity(&typeCapacity.Capacity); err != nil {
+			return nil, err
+		}
+
+		if _, exists := typeCapacities[productType]; exists {
+			log.Warn().Msg(custom_errors.ErrDuplicateCapacity.Message)
+			return nil, custom_errors.ErrDuplicateCapacity
+		}
+
+		typeCapacities[productType] = typeCapacity.Capacity
+	}
+
+	if err = s.driver.UpdatePvzCapacity(ctx, pvz.Id, capacityReq.StorageHours, typeCapacities); err != nil {
+		return nil, err
+	}
+
+	return s.getPvzOccupancy(ctx, pvz.Id)
+}
+
+func (s *PvzService) UpdateOccupancyMetrics(ctx context.Context) error {
+	occupancyList, err := s.driver.GetAllPvzOccupancy(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	internal.PvzOccupancy.Reset()
+	for _, occupancy := range occupancyList {
+		internal.PvzOccupancy.WithLabelValues(occupancy.PvzId.String()).Set(float64(occupancy.Occupied))
+	}
+
+	return nil
+}
+
+func (s *PvzService) RunOccupancyMetrics(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.UpdateOccupancyMetrics(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to update pvz occupancy metrics")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PvzService) GetPvzFullInfo(ctx context.Context, pvzParams generated.GetPvzParams) (*pvz_model.PvzPage, error) {
 	if pvzParams.StartDate != nil && pvzParams.EndDate != nil {
 		if pvzParams.EndDate.Before(*pvzParams.StartDate) {
 			log.Error().Msg(custom_errors.ErrDateRange.Message)
@@ -82,14 +252,9 @@ func (s *PvzService) GetPvzFullInfo(ctx context.Context, pvzParams generated.Get
 		}
 	}
 
-	limit := 10
-	if pvzParams.Limit != nil {
-		if *pvzParams.Limit < 1 || *pvzParams.Limit > 30 {
-			log.Error().Msg(custom_errors.ErrLimitValue.Message)
-			return nil, custom_errors.ErrLimitValue
-		}
-
-		limit = *pvzParams.Limit
+	limit, err := services.GetLimit(pvzParams.Limit)
+	if err != nil {
+		return nil, err
 	}
 
 	page := 1
@@ -102,25 +267,368 @@ func (s *PvzService) GetPvzFullInfo(ctx context.Context, pvzParams generated.Get
 		page = *pvzParams.Page
 	}
 
-	offset := (page - 1) * limit
+	filter := pvz_model.PvzFilter{
+		Limit:         uint32(limit + 1),
+		Offset:        uint32((page - 1) * limit),
+		StartInterval: pvzParams.StartDate,
+		EndInterval:   pvzParams.EndDate,
+		SortBy:        pvz_model.SortByRegistrationDate,
+	}
+
+	err = s.setPvzFilterParams(ctx, &filter, pvzParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if pvzParams.Cursor != nil {
+		if pvzParams.Page != nil {
+			log.Error().Msg(custom_errors.ErrCursorWithPage.Message)
+			return nil, custom_errors.ErrCursorWithPage
+		}
+
+		filter.After, err = decodeCursor(*pvzParams.Cursor, filter.SortBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	countFilter := filter
+	countFilter.After = nil
+	total, err := s.driver.CountPvz(ctx, countFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzList, err := s.driver.GetPvzFullInfo(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzPage := &pvz_model.PvzPage{Pvzs: pvzList, Page: page, Limit: limit, Total: total}
+	if len(pvzList) > limit {
+		pvzPage.Pvzs = pvzList[:limit]
+		last := pvzPage.Pvzs[limit-1]
+		sortValue := last.Pvz.RegistrationDate
+		if filter.SortBy == pvz_model.SortByLastActivity {
+			sortValue = last.LastActivity
+		}
+		pvzPage.NextCursor = encodeCursor(filter.SortBy, sortValue, last.Pvz.Id)
+	}
 
-	return s.driver.GetPvzFullInfo(ctx, uint32(limit), uint32(offset), pvzParams.StartDate, pvzParams.EndDate)
+	return pvzPage, nil
 }
 
 func (s *PvzService) GetAllPvz(ctx context.Context) ([]pvz_model.Pvz, error) {
 	return s.driver.GetAllPvz(ctx)
 }
 
-func mapCityDtoToCity(cityDto generated.PVZCity) (pvz_model.City, error) {
-	switch cityDto {
-	case generated.Москва:
-		return pvz_model.Moscow, nil
-	case generated.СанктПетербург:
-		return pvz_model.SPb, nil
-	case generated.Казань:
-		return pvz_model.Kazan, nil
-	default:
+func (s *PvzService) GetPvzList(ctx context.Context, limitParam *int, cursor string) ([]pvz_model.Pvz, string, error) {
+	if limitParam == nil && cursor == "" {
+		pvzList, err := s.driver.GetAllPvz(ctx)
+		return pvzList, "", err
+	}
+
+	limit, err := services.GetLimit(limitParam)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var after *pvz_model.PvzCursor
+	if cursor != "" {
+		after, err = decodeCursor(cursor, pvz_model.SortByRegistrationDate)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	pvzList, err := s.driver.GetPvzPage(ctx, uint32(limit+1), after)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(pvzList) <= limit {
+		return pvzList, "", nil
+	}
+
+	pvzList = pvzList[:limit]
+	last := pvzList[limit-1]
+	return pvzList, encodeCursor(pvz_model.SortByRegistrationDate, last.RegistrationDate, last.Id), nil
+}
+
+func (s *PvzService) GetNearbyPvz(ctx context.Context, latitude, longitude float64, radiusParam *float64, limitParam *int) ([]pvz_model.NearbyPvz, error) {
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return nil, err
+	}
+
+	radius := defaultNearbyRadius
+	if radiusParam != nil {
+		if !(*radiusParam >= 1 && *radiusParam <= maxNearbyRadius) {
+			log.Warn().Msg(custom_errors.ErrRadiusValue.Message)
+			return nil, custom_errors.ErrRadiusValue
+		}
+
+		radius = *radiusParam
+	}
+
+	limit, err := services.GetLimit(limitParam)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.driver.GetNearbyPvz(ctx, latitude, longitude, radius, uint32(limit))
+}
+
+func (s *PvzService) GetPvzById(ctx context.Context, id pgtype.UUID) (*pvz_model.Pvz, error) {
+	return s.driver.GetPvzById(ctx, id)
+}
+
+func (s *PvzService) changePvzStatus(ctx context.Context, pvzIdDto openapi_types.UUID, from, to pvz_model.PvzStatus) (*generated.PVZ, error) {
+	pvz, err := s.getPvz(ctx, pvzIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	if pvz.Status == pvz_model.Archived {
+		log.Warn().Msg(custom_errors.ErrPvzArchived.Message)
+		return nil, custom_errors.ErrPvzArchived
+	}
+
+	if pvz.Status != from {
+		log.Warn().Msg(custom_errors.ErrPvzStatus.Message)
+		return nil, custom_errors.ErrPvzStatus
+	}
+
+	if err = s.driver.UpdatePvzStatus(ctx, pvz.Id, from, to); err != nil {
+		return nil, err
+	}
+
+	pvz.Status = to
+
+	return services.MapPvzToDto(pvz)
+}
+
+func (s *PvzService) getPvz(ctx context.Context, pvzIdDto openapi_types.UUID) (*pvz_model.Pvz, error) {
+	pvzId, err := services.ConvertOpenAPIUuidToPgType(pvzIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	pvz, err := s.driver.GetPvzById(ctx, pvzId)
+	if errors.Is(err, custom_errors.ErrPvzNotFound) {
+		log.Warn().Msg(custom_errors.ErrNoPvz.Message)
+		return nil, custom_errors.ErrNoPvz
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return pvz, nil
+}
+
+func (s *PvzService) getPvzOccupancy(ctx context.Context, pvzId pgtype.UUID) (*generated.PVZOccupancy, error) {
+	occupancy, err := s.driver.GetPvzOccupancy(ctx, pvzId, time.Now())
+	if errors.Is(err, custom_errors.ErrPvzNotFound) {
+		log.Warn().Msg(custom_errors.ErrNoPvz.Message)
+		return nil, custom_errors.ErrNoPvz
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pvzIdDto, err := services.ConvertPgUuidToOpenAPI(occupancy.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	productTypes := make([]string, 0, len(occupancy.TypeOccupied))
+	for productType := range occupancy.TypeOccupied {
+		productTypes = append(productTypes, string(productType))
+	}
+	for productType := range occupancy.TypeCapacities {
+		if _, exists := occupancy.TypeOccupied[productType]; !exists {
+			productTypes = append(productTypes, string(productType))
+		}
+	}
+	sort.Strings(productTypes)
+
+	occupancyDto := &generated.PVZOccupancy{
+		PvzId:        pvzIdDto,
+		Capacity:     occupancy.Capacity,
+		Occupied:     occupancy.Occupied,
+		StorageHours: occupancy.StorageHours,
+		ProductTypes: make([]generated.ProductTypeOccupancy, 0, len(productTypes)),
+	}
+	for _, productType := range productTypes {
+		typeOccupancy := generated.ProductTypeOccupancy{
+			Type:     productType,
+			Occupied: occupancy.TypeOccupied[product_model.ProductType(productType)],
+		}
+		if capacity, exists := occupancy.TypeCapacities[product_model.ProductType(productType)]; exists {
+			typeOccupancy.Capacity = &capacity
+		}
+		occupancyDto.ProductTypes = append(occupancyDto.ProductTypes, typeOccupancy)
+	}
+
+	return occupancyDto, nil
+}
+
+func (s *PvzService) setPvzFilterParams(ctx context.Context, filter *pvz_model.PvzFilter, pvzParams generated.GetPvzParams) error {
+	if pvzParams.City != nil {
+		city, err := s.getCity(ctx, *pvzParams.City)
+		if err != nil {
+			return err
+		}
+		filter.City = &city
+	}
+
+	if pvzParams.ReceptionStatus != nil {
+		status, err := services.MapReceptionStatusDtoToStatus(*pvzParams.ReceptionStatus)
+		if err != nil {
+			return err
+		}
+		filter.ReceptionStatus = &status
+	}
+
+	if pvzParams.ProductType != nil {
+		productType, err := s.getProductType(ctx, *pvzParams.ProductType)
+		if err != nil {
+			return err
+		}
+		filter.ProductType = &productType
+	}
+
+	if pvzParams.MinProducts != nil {
+		if *pvzParams.MinProducts < 1 {
+			log.Error().Msg(custom_errors.ErrMinProductsValue.Message)
+			return custom_errors.ErrMinProductsValue
+		}
+		filter.MinProducts = pvzParams.MinProducts
+	}
+
+	if pvzParams.SortBy != nil {
+		sortBy, err := mapSortDtoToSort(*pvzParams.SortBy)
+		if err != nil {
+			return err
+		}
+		filter.SortBy = sortBy
+	}
+
+	return nil
+}
+
+type cursorDto struct {
+	SortBy    pvz_model.PvzSort `json:"s"`
+	SortValue time.Time         `json:"v"`
+	Id        uuid.UUID         `json:"i"`
+}
+
+func encodeCursor(sortBy pvz_model.PvzSort, sortValue time.Time, id pgtype.UUID) string {
+	data, _ := json.Marshal(cursorDto{SortBy: sortBy, SortValue: sortValue, Id: id.Bytes})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string, sortBy pvz_model.PvzSort) (*pvz_model.PvzCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrCursorValue.Message)
+		return nil, custom_errors.ErrCursorValue
+	}
+
+	var dto cursorDto
+	if err = json.Unmarshal(data, &dto); err != nil || dto.Id == uuid.Nil || dto.SortBy != sortBy {
+		log.Error().Err(err).Msg(custom_errors.ErrCursorValue.Message)
+		return nil, custom_errors.ErrCursorValue
+	}
+
+	return &pvz_model.PvzCursor{
+		SortValue: dto.SortValue,
+		Id:        pgtype.UUID{Bytes: dto.Id, Valid: true},
+	}, nil
+}
+
+func (s *PvzService) getCity(ctx context.Context, cityDto string) (pvz_model.City, error) {
+	city, err := s.cityService.GetCity(ctx, cityDto)
+	if errors.Is(err, custom_errors.ErrCityNotFound) {
 		log.Error().Msg(custom_errors.ErrPvzCity.Message)
 		return "", custom_errors.ErrPvzCity
 	}
+	if err != nil {
+		return "", err
+	}
+
+	return pvz_model.City(city.Name), nil
+}
+
+func (s *PvzService) getProductType(ctx context.Context, productTypeDto string) (product_model.ProductType, error) {
+	productType, err := s.productTypeService.GetProductType(ctx, productTypeDto)
+	if errors.Is(err, custom_errors.ErrProductTypeNotFound) {
+		log.Error().Msg(custom_errors.ErrProductType.Message)
+		return "", custom_errors.ErrProductType
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return product_model.ProductType(productType.Code), nil
+}
+
+func validatePvzCapacity(capacity *int) error {
+	if capacity != nil && *capacity <= 0 {
+		log.Warn().Msg(custom_errors.ErrPvzCapacity.Message)
+		return custom_errors.ErrPvzCapacity
+	}
+
+	return nil
+}
+
+func validatePvzLocation(latitude, longitude *float64) error {
+	if latitude == nil && longitude == nil {
+		return nil
+	}
+
+	if latitude == nil || longitude == nil {
+		log.Warn().Msg(custom_errors.ErrPvzLocation.Message)
+		return custom_errors.ErrPvzLocation
+	}
+
+	return validateCoordinates(*latitude, *longitude)
+}
+
+func validateCoordinates(latitude, longitude float64) error {
+	if !(latitude >= -90 && latitude <= 90) {
+		log.Warn().Msg(custom_errors.ErrLatitudeValue.Message)
+		return custom_errors.ErrLatitudeValue
+	}
+
+	if !(longitude >= -180 && longitude <= 180) {
+		log.Warn().Msg(custom_errors.ErrLongitudeValue.Message)
+		return custom_errors.ErrLongitudeValue
+	}
+
+	return nil
+}
+
+func trimOptional(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
+}
+
+func mapSortDtoToSort(sortDto generated.GetPvzParamsSortBy) (pvz_model.PvzSort, error) {
+	switch sortDto {
+	case generated.RegistrationDate:
+		return pvz_model.SortByRegistrationDate, nil
+	case generated.LastActivity:
+		return pvz_model.SortByLastActivity, nil
+	default:
+		log.Error().Msg(custom_errors.ErrSortValue.Message)
+		return "", custom_errors.ErrSortValue
+	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
 	"github.com/jackc/pgx/v5/pgtype"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -11,5 +12,10 @@ import (
 type IReceptionService interface {
 	CreateReception(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.Reception, error)
 	CloseReception(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.Reception, error)
+	GetReception(ctx context.Context, receptionIdDto openapi_types.UUID) (*generated.ReceptionWithProducts, error)
+	GetPvzReceptions(ctx context.Context, pvzIdDto openapi_types.UUID, params generated.GetPvzPvzIdReceptionsParams) (*generated.ReceptionPage, error)
+	ReopenReception(ctx context.Context, receptionIdDto openapi_types.UUID, reason string, reopenedBy pgtype.UUID) (*generated.Reception, error)
+	ChangeReceptionStatus(ctx context.Context, receptionIdDto openapi_types.UUID, statusReq generated.PostReceptionsReceptionIdStatusJSONRequestBody, userId pgtype.UUID, role user_model.UserRole) (*generated.Reception, error)
+	GetReceptionCorrections(ctx context.Context, receptionIdDto openapi_types.UUID) ([]generated.ReceptionCorrection, error)
 	GetLastReceptionStatus(ctx context.Context, pvzId pgtype.UUID) (*reception_model.ReceptionStatus, error)
 }
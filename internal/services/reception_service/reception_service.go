@@ -7,20 +7,37 @@ import (
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/reception_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/event_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/pvz_service"
 	"github.com/jackc/pgx/v5/pgtype"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/rs/zerolog/log"
+	"strings"
 	"time"
 )
 
+const autoCloseReason = "reception was not closed within the allowed time"
+
+var receptionStatusEventTypes = map[reception_model.ReceptionStatus]event_model.EventType{
+	reception_model.Paused:     event_model.ReceptionPaused,
+	reception_model.InProgress: event_model.ReceptionResumed,
+	reception_model.Cancelled:  event_model.ReceptionCancelled,
+	reception_model.Verified:   event_model.ReceptionVerified,
+}
+
 type ReceptionService struct {
-	driver reception_driver.IReceptionDriver
+	driver       reception_driver.IReceptionDriver
+	eventService event_service.IEventService
+	pvzService   pvz_service.IPvzService
 }
 
-func NewReceptionService(driver reception_driver.IReceptionDriver) *ReceptionService {
-	return &ReceptionService{driver: driver}
+func NewReceptionService(driver reception_driver.IReceptionDriver, eventService event_service.IEventService, pvzService pvz_service.IPvzService) *ReceptionService {
+	return &ReceptionService{driver: driver, eventService: eventService, pvzService: pvzService}
 }
 
 func (s *ReceptionService) CreateReception(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.Reception, error) {
@@ -29,12 +46,16 @@ func (s *ReceptionService) CreateReception(ctx context.Context, pvzIdDto openapi
 		return nil, err
 	}
 
+	if err = s.validatePvzAcceptsReceptions(ctx, pvzId); err != nil {
+		return nil, err
+	}
+
 	status, err := s.GetLastReceptionStatus(ctx, pvzId)
 	if err != nil && !errors.Is(err, custom_errors.ErrNoReception) {
 		return nil, err
 	}
 
-	if status != nil && *status == reception_model.InProgress {
+	if status != nil && services.IsReceptionOpen(*status) {
 		log.Warn().Msg(custom_errors.ErrInProgressReception.Message)
 		return nil, custom_errors.ErrInProgressReception
 	}
@@ -59,6 +80,12 @@ func (s *ReceptionService) CreateReception(ctx context.Context, pvzIdDto openapi
 		Status:   generated.ReceptionStatus(reception.Status),
 	}
 
+	s.eventService.Publish(ctx, &event_model.Event{
+		Type:        event_model.ReceptionOpened,
+		PvzId:       pvzId,
+		ReceptionId: reception.Id,
+	})
+
 	internal.ReceptionCreatedTotal.Inc()
 	return receptionDto, nil
 }
@@ -74,11 +101,15 @@ func (s *ReceptionService) CloseReception(ctx context.Context, pvzIdDto openapi_
 		return nil, err
 	}
 
-	if *status == reception_model.Close {
+	if !services.IsReceptionOpen(*status) {
 		log.Warn().Msg(custom_errors.ErrNoOpenReception.Message)
 		return nil, custom_errors.ErrNoOpenReception
 	}
 
+	if err = services.ValidateReceptionTransition(*status, reception_model.Close); err != nil {
+		return nil, err
+	}
+
 	reception, err := s.driver.CloseReception(ctx, pvzId)
 	if err != nil {
 		return nil, err
@@ -96,9 +127,299 @@ func (s *ReceptionService) CloseReception(ctx context.Context, pvzIdDto openapi_
 		Status:   generated.ReceptionStatus(reception.Status),
 	}
 
+	s.eventService.Publish(ctx, &event_model.Event{
+		Type:        event_model.ReceptionClosed,
+		PvzId:       pvzId,
+		ReceptionId: reception.Id,
+	})
+
+	return receptionDto, nil
+}
+
+func (s *ReceptionService) GetReception(ctx context.Context, receptionIdDto openapi_types.UUID) (*generated.ReceptionWithProducts, error) {
+	receptionId, err := services.ConvertOpenAPIUuidToPgType(receptionIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := s.driver.GetReceptionWithProducts(ctx, receptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapReceptionWithProductsToDto(reception)
+}
+
+func (s *ReceptionService) GetPvzReceptions(ctx context.Context, pvzIdDto openapi_types.UUID, params generated.GetPvzPvzIdReceptionsParams) (*generated.ReceptionPage, error) {
+	pvzId, err := services.ConvertOpenAPIUuidToPgType(pvzIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.StartDate != nil && params.EndDate != nil && params.EndDate.Before(*params.StartDate) {
+		log.Error().Msg(custom_errors.ErrDateRange.Message)
+		return nil, custom_errors.ErrDateRange
+	}
+
+	limit, err := services.GetLimit(params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	if params.Page != nil {
+		if *params.Page < 1 {
+			log.Error().Msg(custom_errors.ErrPageValue.Message)
+			return nil, custom_errors.ErrPageValue
+		}
+
+		page = *params.Page
+	}
+
+	filter := reception_model.ReceptionFilter{
+		PvzId:         pvzId,
+		StartInterval: params.StartDate,
+		EndInterval:   params.EndDate,
+		Limit:         uint32(limit),
+		Offset:        uint32((page - 1) * limit),
+	}
+
+	if params.Status != nil {
+		status, err := services.MapReceptionStatusDtoToStatus(*params.Status)
+		if err != nil {
+			return nil, err
+		}
+		filter.Status = &status
+	}
+
+	total, err := s.driver.CountReceptions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	receptions, err := s.driver.GetReceptions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	receptionsDto := make([]generated.ReceptionWithProducts, 0, len(receptions))
+	for i := range receptions {
+		receptionDto, err := mapReceptionWithProductsToDto(&receptions[i])
+		if err != nil {
+			return nil, err
+		}
+		receptionsDto = append(receptionsDto, *receptionDto)
+	}
+
+	receptionPage := &generated.ReceptionPage{
+		Receptions: receptionsDto,
+		Page:       page,
+		Limit:      limit,
+		Total:      total,
+		TotalPages: (total + limit - 1) / limit,
+	}
+
+	return receptionPage, nil
+}
+
+func (s *ReceptionService) ReopenReception(ctx context.Context, receptionIdDto openapi_types.UUID, reason string, reopenedBy pgtype.UUID) (*generated.Reception, error) {
+	receptionId, err := services.ConvertOpenAPIUuidToPgType(receptionIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		log.Warn().Msg(custom_errors.ErrReopenReason.Message)
+		return nil, custom_errors.ErrReopenReason
+	}
+
+	current, err := s.driver.GetReception(ctx, receptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !services.IsReceptionClosed(current.Status) {
+		log.Warn().Msg(custom_errors.ErrReceptionNotClosed.Message)
+		return nil, custom_errors.ErrReceptionNotClosed
+	}
+
+	if err = s.validatePvzAcceptsReceptions(ctx, current.PvzId); err != nil {
+		return nil, err
+	}
+
+	correction := &reception_model.ReceptionCorrection{
+		Id:          services.GenerateUuid(),
+		ReceptionId: receptionId,
+		ReopenedBy:  reopenedBy,
+		ReopenedAt:  time.Now(),
+		Reason:      reason,
+	}
+	reception, err := s.driver.ReopenReception(ctx, correction, current.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzIdDto, err := services.ConvertPgUuidToOpenAPI(reception.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	receptionDto := &generated.Reception{
+		Id:       &receptionIdDto,
+		DateTime: reception.ReceptionTime,
+		PvzId:    pvzIdDto,
+		Status:   generated.ReceptionStatus(reception.Status),
+	}
+
+	s.eventService.Publish(ctx, &event_model.Event{
+		Type:        event_model.ReceptionReopened,
+		PvzId:       reception.PvzId,
+		ReceptionId: reception.Id,
+	})
+
 	return receptionDto, nil
 }
 
+func (s *ReceptionService) ChangeReceptionStatus(ctx context.Context, receptionIdDto openapi_types.UUID, statusReq generated.PostReceptionsReceptionIdStatusJSONRequestBody, userId pgtype.UUID, role user_model.UserRole) (*generated.Reception, error) {
+	receptionId, err := services.ConvertOpenAPIUuidToPgType(receptionIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := services.MapReceptionStatusDtoToStatus(string(statusReq.Status))
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := s.driver.GetReception(ctx, receptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = services.ValidateReceptionTransitionRole(reception.Status, to, role); err != nil {
+		return nil, err
+	}
+
+	pvzIdDto, err := services.ConvertPgUuidToOpenAPI(reception.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	if to == reception_model.Close {
+		return s.CloseReception(ctx, pvzIdDto)
+	}
+
+	if to == reception_model.InProgress && services.IsReceptionClosed(reception.Status) {
+		reason := ""
+		if statusReq.Reason != nil {
+			reason = *statusReq.Reason
+		}
+		return s.ReopenReception(ctx, receptionIdDto, reason, userId)
+	}
+
+	eventType, ok := receptionStatusEventTypes[to]
+	if !ok {
+		log.Warn().Msg(custom_errors.ErrReceptionTransition.Message)
+		return nil, custom_errors.ErrReceptionTransition
+	}
+
+	if err = s.driver.UpdateReceptionStatus(ctx, receptionId, reception.Status, to, eventType); err != nil {
+		return nil, err
+	}
+
+	receptionDto := &generated.Reception{
+		Id:       &receptionIdDto,
+		DateTime: reception.ReceptionTime,
+		PvzId:    pvzIdDto,
+		Status:   generated.ReceptionStatus(to),
+	}
+
+	s.eventService.Publish(ctx, &event_model.Event{
+		Type:        eventType,
+		PvzId:       reception.PvzId,
+		ReceptionId: reception.Id,
+	})
+
+	return receptionDto, nil
+}
+
+func (s *ReceptionService) GetReceptionCorrections(ctx context.Context, receptionIdDto openapi_types.UUID) ([]generated.ReceptionCorrection, error) {
+	receptionId, err := services.ConvertOpenAPIUuidToPgType(receptionIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	corrections, err := s.driver.GetReceptionCorrections(ctx, receptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	correctionsDto := make([]generated.ReceptionCorrection, 0, len(corrections))
+	for _, correction := range corrections {
+		idDto, err := services.ConvertPgUuidToOpenAPI(correction.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		correctionDto := generated.ReceptionCorrection{
+			Id:          idDto,
+			ReceptionId: receptionIdDto,
+			ClosedAt:    correction.ClosedAt,
+			ReopenedAt:  correction.ReopenedAt,
+			Reason:      correction.Reason,
+		}
+
+		if correction.ReopenedBy.Valid {
+			reopenedByDto, err := services.ConvertPgUuidToOpenAPI(correction.ReopenedBy)
+			if err != nil {
+				return nil, err
+			}
+			correctionDto.ReopenedBy = &reopenedByDto
+		}
+
+		correctionsDto = append(correctionsDto, correctionDto)
+	}
+
+	return correctionsDto, nil
+}
+
+func (s *ReceptionService) AutoCloseStaleReceptions(ctx context.Context, defaultThreshold time.Duration) (int, error) {
+	receptions, err := s.driver.AutoCloseStaleReceptions(ctx, time.Now(), defaultThreshold, autoCloseReason, services.AutoClosableReceptionStatuses())
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range receptions {
+		log.Info().Msgf("reception %s in pvz %s auto closed", receptions[i].Id.String(), receptions[i].PvzId.String())
+		s.eventService.Publish(ctx, &event_model.Event{
+			Type:        event_model.ReceptionClosed,
+			PvzId:       receptions[i].PvzId,
+			ReceptionId: receptions[i].Id,
+		})
+	}
+
+	internal.ReceptionAutoClosedTotal.Add(float64(len(receptions)))
+	return len(receptions), nil
+}
+
+func (s *ReceptionService) RunAutoClose(ctx context.Context, interval, defaultThreshold time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.AutoCloseStaleReceptions(ctx, defaultThreshold); err != nil {
+			log.Error().Err(err).Msg("failed to auto close stale receptions")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (s *ReceptionService) GetLastReceptionStatus(ctx context.Context, pvzId pgtype.UUID) (*reception_model.ReceptionStatus, error) {
 	status, err := s.driver.GetLastReceptionStatus(ctx, pvzId)
 	if err != nil {
@@ -107,3 +428,49 @@ func (s *ReceptionService) GetLastReceptionStatus(ctx context.Context, pvzId pgt
 
 	return status, nil
 }
+
+func mapReceptionWithProductsToDto(reception *pvz_model.ReceptionWithProducts) (*generated.ReceptionWithProducts, error) {
+	idDto, err := services.ConvertPgUuidToOpenAPI(reception.Reception.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzIdDto, err := services.ConvertPgUuidToOpenAPI(reception.Reception.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	receptionDto := &generated.ReceptionWithProducts{
+		Reception: generated.Reception{
+			Id:          &idDto,
+			DateTime:    reception.Reception.ReceptionTime,
+			PvzId:       pvzIdDto,
+			Status:      generated.ReceptionStatus(reception.Reception.Status),
+			CloseReason: reception.Reception.CloseReason,
+		},
+		Products: make([]generated.Product, 0, len(reception.Products)),
+	}
+
+	for i := range reception.Products {
+		productDto, err := services.MapProductToDto(&reception.Products[i])
+		if err != nil {
+			return nil, err
+		}
+		receptionDto.Products = append(receptionDto.Products, *productDto)
+	}
+
+	return receptionDto, nil
+}
+
+func (s *ReceptionService) validatePvzAcceptsReceptions(ctx context.Context, pvzId pgtype.UUID) error {
+	pvz, err := s.pvzService.GetPvzById(ctx, pvzId)
+	if errors.Is(err, custom_errors.ErrPvzNotFound) {
+		log.Warn().Msg(custom_errors.ErrNoPvz.Message)
+		return custom_errors.ErrNoPvz
+	}
+	if err != nil {
+		return err
+	}
+
+	return services.ValidatePvzAcceptsReceptions(pvz.Status)
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
+	"github.com/rs/zerolog/log"
+	"slices"
+)
+
+type receptionTransition struct {
+	from reception_model.ReceptionStatus
+	to   reception_model.ReceptionStatus
+}
+
+var receptionTransitionRoles = map[receptionTransition][]user_model.UserRole{
+	{reception_model.InProgress, reception_model.Paused}:     {user_model.Employee},
+	{reception_model.InProgress, reception_model.Close}:      {user_model.Employee},
+	{reception_model.InProgress, reception_model.Cancelled}:  {user_model.Employee, user_model.Moderator},
+	{reception_model.Paused, reception_model.InProgress}:     {user_model.Employee},
+	{reception_model.Paused, reception_model.Cancelled}:      {user_model.Employee, user_model.Moderator},
+	{reception_model.Close, reception_model.InProgress}:      {user_model.Moderator},
+	{reception_model.Close, reception_model.Verified}:        {user_model.Moderator},
+	{reception_model.AutoClosed, reception_model.InProgress}: {user_model.Moderator},
+	{reception_model.AutoClosed, reception_model.Verified}:   {user_model.Moderator},
+}
+
+var systemReceptionTransitions = map[receptionTransition]struct{}{
+	{reception_model.InProgress, reception_model.AutoClosed}: {},
+	{reception_model.Paused, reception_model.AutoClosed}:     {},
+}
+
+func AutoClosableReceptionStatuses() []reception_model.ReceptionStatus {
+	statuses := make([]reception_model.ReceptionStatus, 0, len(systemReceptionTransitions))
+	for transition := range systemReceptionTransitions {
+		if transition.to == reception_model.AutoClosed {
+			statuses = append(statuses, transition.from)
+		}
+	}
+	slices.Sort(statuses)
+
+	return statuses
+}
+
+func IsReceptionOpen(status reception_model.ReceptionStatus) bool {
+	return status == reception_model.InProgress || status == reception_model.Paused
+}
+
+func IsReceptionClosed(status reception_model.ReceptionStatus) bool {
+	return status == reception_model.Close || status == reception_model.AutoClosed
+}
+
+func ValidateReceptionTransition(from, to reception_model.ReceptionStatus) error {
+	if _, ok := receptionTransitionRoles[receptionTransition{from, to}]; ok {
+		return nil
+	}
+
+	switch from {
+	case reception_model.Verified, reception_model.Cancelled:
+		log.Warn().Msg(custom_errors.ErrReceptionFinalized.Message)
+		return custom_errors.ErrReceptionFinalized
+	case reception_model.Paused:
+		log.Warn().Msg(custom_errors.ErrReceptionPaused.Message)
+		return custom_errors.ErrReceptionPaused
+	default:
+		log.Warn().Msgf("%s: %s -> %s", custom_errors.ErrReceptionTransition.Message, from, to)
+		return custom_errors.ErrReceptionTransition
+	}
+}
+
+func ValidateReceptionTransitionRole(from, to reception_model.ReceptionStatus, role user_model.UserRole) error {
+	if err := ValidateReceptionTransition(from, to); err != nil {
+		return err
+	}
+
+	if !slices.Contains(receptionTransitionRoles[receptionTransition{from, to}], role) {
+		log.Warn().Msg(custom_errors.ErrTransitionRole.Message)
+		return custom_errors.ErrTransitionRole
+	}
+
+	return nil
+}
+
+func ValidateReceptionAcceptsProducts(status reception_model.ReceptionStatus) error {
+	switch status {
+	case reception_model.InProgress:
+		return nil
+	case reception_model.Paused:
+		log.Warn().Msg(custom_errors.ErrReceptionPaused.Message)
+		return custom_errors.ErrReceptionPaused
+	default:
+		log.Warn().Msg(custom_errors.ErrNoOpenReception.Message)
+		return custom_errors.ErrNoOpenReception
+	}
+}
@@ -1,11 +1,16 @@
 package services
 
 import (
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/rs/zerolog/log"
+	"time"
 )
 
 func GenerateUuid() pgtype.UUID {
@@ -48,3 +53,117 @@ func ConvertPgUuidToOpenAPI(pgUuid pgtype.UUID) (openapi_types.UUID, error) {
 
 	return stdUuid, nil
 }
+
+func ExponentialBackoff(attempts int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+
+	return delay
+}
+
+func MapProductToDto(product *product_model.Product) (*generated.Product, error) {
+	idDto, err := ConvertPgUuidToOpenAPI(product.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	receptionIdDto, err := ConvertPgUuidToOpenAPI(product.ReceptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	addingTime := product.AddingTime
+	productDto := &generated.Product{
+		Id:          &idDto,
+		DateTime:    &addingTime,
+		ReceptionId: receptionIdDto,
+		Type:        string(product.ProductType),
+		Barcode:     product.Barcode,
+		Sku:         product.Sku,
+		OrderId:     product.OrderId,
+		WeightGrams: product.WeightGrams,
+	}
+
+	if product.Dimensions != nil {
+		productDto.Dimensions = &generated.ProductDimensions{
+			LengthMm: product.Dimensions.LengthMm,
+			WidthMm:  product.Dimensions.WidthMm,
+			HeightMm: product.Dimensions.HeightMm,
+		}
+	}
+
+	return productDto, nil
+}
+
+func MapPvzToDto(pvz *pvz_model.Pvz) (*generated.PVZ, error) {
+	idDto, err := ConvertPgUuidToOpenAPI(pvz.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	registrationDate := pvz.RegistrationDate
+	status := generated.PVZStatus(pvz.Status)
+	return &generated.PVZ{
+		Id:               &idDto,
+		RegistrationDate: &registrationDate,
+		City:             string(pvz.City),
+		Address:          pvz.Address,
+		WorkingHours:     pvz.WorkingHours,
+		Capacity:         pvz.Capacity,
+		Status:           &status,
+		ArchivedAt:       pvz.ArchivedAt,
+		Latitude:         pvz.Latitude,
+		Longitude:        pvz.Longitude,
+	}, nil
+}
+
+func ValidatePvzAcceptsReceptions(status pvz_model.PvzStatus) error {
+	switch status {
+	case pvz_model.Inactive:
+		log.Warn().Msg(custom_errors.ErrPvzInactive.Message)
+		return custom_errors.ErrPvzInactive
+	case pvz_model.Archived:
+		log.Warn().Msg(custom_errors.ErrPvzArchived.Message)
+		return custom_errors.ErrPvzArchived
+	default:
+		return nil
+	}
+}
+
+func GetLimit(limitParam *int) (int, error) {
+	if limitParam == nil {
+		return 10, nil
+	}
+
+	if *limitParam < 1 || *limitParam > 30 {
+		log.Error().Msg(custom_errors.ErrLimitValue.Message)
+		return 0, custom_errors.ErrLimitValue
+	}
+
+	return *limitParam, nil
+}
+
+func MapReceptionStatusDtoToStatus(statusDto string) (reception_model.ReceptionStatus, error) {
+	switch statusDto {
+	case string(reception_model.InProgress):
+		return reception_model.InProgress, nil
+	case string(reception_model.Close):
+		return reception_model.Close, nil
+	case string(reception_model.AutoClosed):
+		return reception_model.AutoClosed, nil
+	case string(reception_model.Paused):
+		return reception_model.Paused, nil
+	case string(reception_model.Verified):
+		return reception_model.Verified, nil
+	case string(reception_model.Cancelled):
+		return reception_model.Cancelled, nil
+	default:
+		log.Error().Msg(custom_errors.ErrReceptionStatus.Message)
+		return "", custom_errors.ErrReceptionStatus
+	}
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
+	"github.com/jackc/pgx/v5/pgtype"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 type IUserService interface {
-	DummyLogin(ctx context.Context, roleDto generated.UserRole) (string, error)
-	Register(ctx context.Context, emailDto openapi_types.Email, password string, roleDto generated.UserRole) (*generated.User, string, error)
-	Login(ctx context.Context, emailDto openapi_types.Email, password string) (string, error)
+	DummyLogin(ctx context.Context, roleDto generated.UserRole) (*user_model.Tokens, error)
+	Register(ctx context.Context, emailDto openapi_types.Email, password string, roleDto generated.UserRole) (*generated.User, *user_model.Tokens, error)
+	Login(ctx context.Context, emailDto openapi_types.Email, password string) (*user_model.Tokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*user_model.Tokens, error)
+	Logout(ctx context.Context, token string) error
+	LogoutAll(ctx context.Context, userId pgtype.UUID) error
 	ValidateToken(ctx context.Context, token string) (*user_model.User, error)
+	GetJwks() *generated.Jwks
 }
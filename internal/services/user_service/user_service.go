@@ -2,6 +2,9 @@ package user_service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/user_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
@@ -18,17 +21,24 @@ import (
 )
 
 type UserService struct {
-	driver user_driver.IUserDriver
+	driver            user_driver.IUserDriver
+	keys              *user_model.JwtKeySet
+	dummyLoginEnabled bool
 }
 
-func NewUserService(driver user_driver.IUserDriver) *UserService {
-	return &UserService{driver: driver}
+func NewUserService(driver user_driver.IUserDriver, keys *user_model.JwtKeySet, dummyLoginEnabled bool) *UserService {
+	return &UserService{driver: driver, keys: keys, dummyLoginEnabled: dummyLoginEnabled}
 }
 
-func (s *UserService) DummyLogin(ctx context.Context, roleDto generated.UserRole) (string, error) {
+func (s *UserService) DummyLogin(ctx context.Context, roleDto generated.UserRole) (*user_model.Tokens, error) {
+	if !s.dummyLoginEnabled {
+		log.Warn().Str("role", string(roleDto)).Msg(custom_errors.ErrDummyLoginDisabled.Message)
+		return nil, custom_errors.ErrDummyLoginDisabled
+	}
+
 	role, err := mapRoleDtoToRole(roleDto)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	email := "dummy." + string(role) + "@example.com"
@@ -36,40 +46,31 @@ func (s *UserService) DummyLogin(ctx context.Context, roleDto generated.UserRole
 	user, err := s.driver.GetUserByEmail(ctx, email)
 	var userErr *custom_errors.UserError
 	if !errors.As(err, &userErr) && err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var id pgtype.UUID
 	if user == nil {
-		id = services.GenerateUuid()
 		passwordHash := make([]byte, 0)
 
-		user = &user_model.User{Id: id, Email: email, PasswordHash: passwordHash, Role: role}
+		user = &user_model.User{Id: services.GenerateUuid(), Email: email, PasswordHash: passwordHash, Role: role}
 		err = s.driver.CreateUser(ctx, user)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-	} else {
-		id = user.Id
-	}
-
-	token, err := createToken(id.String(), email, string(role))
-	if err != nil {
-		return "", err
 	}
 
-	return token, nil
+	return s.issueTokens(ctx, user)
 }
 
-func (s *UserService) Register(ctx context.Context, emailDto openapi_types.Email, password string, roleDto generated.UserRole) (*generated.User, string, error) {
+func (s *UserService) Register(ctx context.Context, emailDto openapi_types.Email, password string, roleDto generated.UserRole) (*generated.User, *user_model.Tokens, error) {
 	role, err := mapRoleDtoToRole(roleDto)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	err = validateEmail(emailDto)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	id := services.GenerateUuid()
@@ -77,19 +78,19 @@ func (s *UserService) Register(ctx context.Context, emailDto openapi_types.Email
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrHashPassword.Message)
-		return nil, "", custom_errors.ErrHashPassword
+		return nil, nil, custom_errors.ErrHashPassword
 	}
 
 	email := string(emailDto)
 
 	user, err := s.driver.GetUserByEmail(ctx, email)
 	if err != nil && !errors.Is(err, custom_errors.ErrUserNotFound) {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	if user != nil {
 		log.Error().Msg(custom_errors.ErrExistingUser.Message)
-		return nil, "", custom_errors.ErrExistingUser
+		return nil, nil, custom_errors.ErrExistingUser
 	}
 
 	user = &user_model.User{
@@ -101,17 +102,17 @@ func (s *UserService) Register(ctx context.Context, emailDto openapi_types.Email
 
 	err = s.driver.CreateUser(ctx, user)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
-	token, err := createToken(id.String(), email, string(role))
+	tokens, err := s.issueTokens(ctx, user)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	idDto, err := services.ConvertPgUuidToOpenAPI(id)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	userDto := generated.User{
@@ -120,13 +121,13 @@ func (s *UserService) Register(ctx context.Context, emailDto openapi_types.Email
 		Role:  roleDto,
 	}
 
-	return &userDto, token, nil
+	return &userDto, tokens, nil
 }
 
-func (s *UserService) Login(ctx context.Context, emailDto openapi_types.Email, password string) (string, error) {
+func (s *UserService) Login(ctx context.Context, emailDto openapi_types.Email, password string) (*user_model.Tokens, error) {
 	err := validateEmail(emailDto)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	email := string(emailDto)
@@ -134,28 +135,112 @@ func (s *UserService) Login(ctx context.Context, emailDto openapi_types.Email, p
 	user, err := s.driver.GetUserByEmail(ctx, email)
 	if err != nil {
 		log.Warn().Err(err).Msg(custom_errors.ErrUserNotFound.Message)
-		return "", err
+		return nil, err
+	}
+
+	if len(user.PasswordHash) == 0 {
+		log.Warn().Str("email", email).Msg("login refused for account without password")
+		return nil, custom_errors.ErrLoginPassword
 	}
 
 	if err := bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password)); err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrHashPassword.Message)
-		return "", custom_errors.ErrLoginPassword
+		return nil, custom_errors.ErrLoginPassword
+	}
+
+	return s.issueTokens(ctx, user)
+}
+
+func (s *UserService) Refresh(ctx context.Context, refreshToken string) (*user_model.Tokens, error) {
+	if refreshToken == "" {
+		log.Warn().Msg(custom_errors.ErrRefreshToken.Message)
+		return nil, custom_errors.ErrRefreshToken
+	}
+
+	now := time.Now()
+	rotatedRefreshToken, token, err := newRefreshToken(now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.driver.RotateRefreshToken(ctx, hashRefreshToken(refreshToken), token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.driver.GetUserById(ctx, token.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.createToken(user, token.AccessJti.String(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_model.Tokens{AccessToken: accessToken, RefreshToken: rotatedRefreshToken}, nil
+}
+
+func (s *UserService) Logout(ctx context.Context, token string) error {
+	claims, err := s.parseToken(token)
+	if err != nil {
+		return err
 	}
 
-	token, err := createToken(user.Id.String(), email, string(user.Role))
+	jti, err := parseTokenJti(claims)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	return token, nil
+	return s.driver.RevokeSession(ctx, jti, claims.ExpiresAt.Time, time.Now())
+}
+
+func (s *UserService) LogoutAll(ctx context.Context, userId pgtype.UUID) error {
+	return s.driver.RevokeUserSessions(ctx, userId, time.Now())
+}
+
+func (s *UserService) DeleteExpiredTokens(ctx context.Context) error {
+	return s.driver.DeleteExpiredTokens(ctx, time.Now())
+}
+
+func (s *UserService) RunTokenCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.DeleteExpiredTokens(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to delete expired tokens")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
 }
 
 func (s *UserService) ValidateToken(ctx context.Context, token string) (*user_model.User, error) {
-	claims, err := user_model.ValidateToken(token)
+	claims, err := s.parseToken(token)
 	if err != nil {
 		return nil, err
 	}
 
+	jti, err := parseTokenJti(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.driver.IsTokenRevoked(ctx, jti)
+	if err != nil {
+		return nil, err
+	}
+
+	if revoked {
+		log.Warn().Msg(custom_errors.ErrTokenRevoked.Message)
+		return nil, custom_errors.ErrTokenRevoked
+	}
+
 	pgUuid, err := mapStringUuidToPgUuid(claims.UserId)
 	if err != nil {
 		return nil, err
@@ -170,6 +255,25 @@ func (s *UserService) ValidateToken(ctx context.Context, token string) (*user_mo
 	return user, nil
 }
 
+func (s *UserService) GetJwks() *generated.Jwks {
+	keys := s.keys.Jwks()
+	jwks := &generated.Jwks{Keys: make([]generated.Jwk, 0, len(keys))}
+	for _, key := range keys {
+		jwks.Keys = append(jwks.Keys, generated.Jwk{
+			Kty: generated.JwkKty(key.Kty),
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: generated.JwkAlg(key.Alg),
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+
+	return jwks
+}
+
 func mapRoleDtoToRole(roleDto generated.UserRole) (user_model.UserRole, error) {
 	switch roleDto {
 	case generated.UserRoleEmployee:
@@ -182,20 +286,90 @@ func mapRoleDtoToRole(roleDto generated.UserRole) (user_model.UserRole, error) {
 	}
 }
 
-func createToken(userID, email, role string) (string, error) {
+func (s *UserService) issueTokens(ctx context.Context, user *user_model.User) (*user_model.Tokens, error) {
+	now := time.Now()
+	refreshToken, token, err := newRefreshToken(now)
+	if err != nil {
+		return nil, err
+	}
+
+	token.FamilyId = services.GenerateUuid()
+	token.UserId = user.Id
+
+	accessToken, err := s.createToken(user, token.AccessJti.String(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.driver.CreateRefreshToken(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return &user_model.Tokens{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+func newRefreshToken(now time.Time) (string, *user_model.RefreshToken, error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrGenerateRefreshToken.Message)
+		return "", nil, custom_errors.ErrGenerateRefreshToken
+	}
+
+	refreshToken := base64.RawURLEncoding.EncodeToString(tokenBytes)
+	token := &user_model.RefreshToken{
+		Id:              services.GenerateUuid(),
+		TokenHash:       hashRefreshToken(refreshToken),
+		AccessJti:       services.GenerateUuid(),
+		AccessExpiresAt: now.Add(user_model.JwtExpiry),
+		CreatedAt:       now,
+		ExpiresAt:       now.Add(user_model.RefreshTokenExpiry),
+	}
+
+	return refreshToken, token, nil
+}
+
+func hashRefreshToken(refreshToken string) []byte {
+	hash := sha256.Sum256([]byte(refreshToken))
+	return hash[:]
+}
+
+func (s *UserService) parseToken(token string) (*user_model.JwtClaims, error) {
+	claims, err := s.keys.ValidateToken(token)
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		log.Warn().Msg(custom_errors.ErrTokenExpired.Message)
+		return nil, custom_errors.ErrTokenExpired
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+func parseTokenJti(claims *user_model.JwtClaims) (pgtype.UUID, error) {
+	jti, err := uuid.Parse(claims.ID)
+	if err != nil {
+		log.Warn().Err(err).Msg(custom_errors.ErrAccessToken.Message)
+		return pgtype.UUID{}, custom_errors.ErrAccessToken
+	}
+
+	return pgtype.UUID{Bytes: jti, Valid: true}, nil
+}
+
+func (s *UserService) createToken(user *user_model.User, jti string, now time.Time) (string, error) {
 	claims := user_model.JwtClaims{
-		UserId: userID,
-		Email:  email,
-		Role:   role,
+		UserId: user.Id.String(),
+		Email:  user.Email,
+		Role:   string(user.Role),
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(user_model.JwtExpiry)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(now.Add(user_model.JwtExpiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString(user_model.GetJwtSecret())
+	signedToken, err := s.keys.Sign(claims)
 	if err != nil {
 		log.Error().Err(err).Msg(custom_errors.ErrGenerateJWTToken.Message)
 		return "", custom_errors.ErrGenerateJWTToken
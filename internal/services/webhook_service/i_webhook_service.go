@@ -0,0 +1,18 @@
+package webhook_service
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"time"
+)
+
+type IWebhookService interface {
+	CreateSubscription(ctx context.Context, subscriptionReq generated.PostWebhooksJSONRequestBody) (*generated.WebhookSubscription, error)
+	GetSubscriptions(ctx context.Context) ([]generated.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, idDto openapi_types.UUID) error
+	GetDeliveries(ctx context.Context, subscriptionIdDto openapi_types.UUID, params generated.GetWebhooksWebhookIdDeliveriesParams) ([]generated.WebhookDelivery, error)
+	ReplayDelivery(ctx context.Context, idDto openapi_types.UUID) (*generated.WebhookDelivery, error)
+	DeliverPendingWebhooks(ctx context.Context) error
+	Run(ctx context.Context, interval time.Duration)
+}
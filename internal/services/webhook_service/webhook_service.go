@@ -0,0 +1,348 @@
+package webhook_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/webhook_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/webhook_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/pvz_service"
+	"github.com/jackc/pgx/v5/pgtype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/rs/zerolog/log"
+	"net/url"
+	"time"
+)
+
+const (
+	deliveryBatchSize = 100
+	deliveryLease     = time.Minute
+	deliveriesLimit   = 100
+	maxAttempts       = 10
+	baseBackoff       = time.Second
+	maxBackoff        = time.Hour
+)
+
+type WebhookService struct {
+	driver     webhook_driver.IWebhookDriver
+	sender     webhook_driver.IWebhookSenderDriver
+	pvzService pvz_service.IPvzService
+}
+
+func NewWebhookService(driver webhook_driver.IWebhookDriver, sender webhook_driver.IWebhookSenderDriver, pvzService pvz_service.IPvzService) *WebhookService {
+	return &WebhookService{driver: driver, sender: sender, pvzService: pvzService}
+}
+
+func (s *WebhookService) CreateSubscription(ctx context.Context, subscriptionReq generated.PostWebhooksJSONRequestBody) (*generated.WebhookSubscription, error) {
+	if err := validateWebhookUrl(subscriptionReq.Url); err != nil {
+		return nil, err
+	}
+
+	if subscriptionReq.Secret == "" {
+		log.Warn().Msg(custom_errors.ErrWebhookSecret.Message)
+		return nil, custom_errors.ErrWebhookSecret
+	}
+
+	eventTypes, err := mapEventTypesDtoToEventTypes(subscriptionReq.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	var pvzId pgtype.UUID
+	if subscriptionReq.PvzId != nil {
+		pvzId, err = services.ConvertOpenAPIUuidToPgType(*subscriptionReq.PvzId)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.pvzService.GetPvzById(ctx, pvzId)
+		if errors.Is(err, custom_errors.ErrPvzNotFound) {
+			log.Warn().Msg(custom_errors.ErrWebhookPvzNotFound.Message)
+			return nil, custom_errors.ErrWebhookPvzNotFound
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	subscription := &webhook_model.Subscription{
+		Id:         services.GenerateUuid(),
+		Url:        subscriptionReq.Url,
+		Secret:     subscriptionReq.Secret,
+		EventTypes: eventTypes,
+		PvzId:      pvzId,
+		CreatedAt:  time.Now(),
+	}
+
+	if err = s.driver.CreateSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return mapSubscriptionToDto(subscription)
+}
+
+func (s *WebhookService) GetSubscriptions(ctx context.Context) ([]generated.WebhookSubscription, error) {
+	subscriptions, err := s.driver.GetSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionsDto := make([]generated.WebhookSubscription, 0, len(subscriptions))
+	for i := range subscriptions {
+		subscriptionDto, err := mapSubscriptionToDto(&subscriptions[i])
+		if err != nil {
+			return nil, err
+		}
+		subscriptionsDto = append(subscriptionsDto, *subscriptionDto)
+	}
+
+	return subscriptionsDto, nil
+}
+
+func (s *WebhookService) DeleteSubscription(ctx context.Context, idDto openapi_types.UUID) error {
+	id, err := services.ConvertOpenAPIUuidToPgType(idDto)
+	if err != nil {
+		return err
+	}
+
+	return s.driver.DeleteSubscription(ctx, id)
+}
+
+func (s *WebhookService) GetDeliveries(ctx context.Context, subscriptionIdDto openapi_types.UUID, params generated.GetWebhooksWebhookIdDeliveriesParams) ([]generated.WebhookDelivery, error) {
+	subscriptionId, err := services.ConvertOpenAPIUuidToPgType(subscriptionIdDto)
+	if err != nil {
+		return nil, err
+	}
+
+	var status *webhook_model.DeliveryStatus
+	if params.Status != nil {
+		mappedStatus, err := mapDeliveryStatusDtoToStatus(*params.Status)
+		if err != nil {
+			return nil, err
+		}
+		status = &mappedStatus
+	}
+
+	deliveries, err := s.driver.GetDeliveries(ctx, subscriptionId, status, deliveriesLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveriesDto := make([]generated.WebhookDelivery, 0, len(deliveries))
+	for i := range deliveries {
+		deliveryDto, err := mapDeliveryToDto(&deliveries[i])
+		if err != nil {
+			return nil, err
+		}
+		deliveriesDto = append(deliveriesDto, *deliveryDto)
+	}
+
+	return deliveriesDto, nil
+}
+
+func (s *WebhookService) ReplayDelivery(ctx context.Context, idDto openapi_types.UUID) (*generated.WebhookDelivery, error) {
+	id, err := services.ConvertOpenAPIUuidToPgType(idDto)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.driver.ReplayDelivery(ctx, id, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDeliveryToDto(delivery)
+}
+
+func (s *WebhookService) DeliverPendingWebhooks(ctx context.Context) error {
+	now := time.Now()
+	deliveries, err := s.driver.ClaimPendingDeliveries(ctx, deliveryBatchSize, now, now.Add(deliveryLease))
+	if err != nil {
+		return err
+	}
+
+	for i := range deliveries {
+		delivery := &deliveries[i]
+
+		if err = s.sender.Send(ctx, delivery); err != nil {
+			s.markFailed(ctx, delivery, err)
+			continue
+		}
+
+		if err = s.driver.MarkDelivered(ctx, delivery.Id, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *WebhookService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.DeliverPendingWebhooks(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to deliver webhooks")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *WebhookService) markFailed(ctx context.Context, delivery *webhook_model.Delivery, sendErr error) {
+	lastError := sendErr.Error()
+	delivery.LastError = &lastError
+	delivery.NextAttemptAt = time.Now().Add(services.ExponentialBackoff(delivery.Attempts, baseBackoff, maxBackoff))
+	if delivery.Attempts >= maxAttempts {
+		delivery.Status = webhook_model.Dead
+		log.Warn().Msgf("webhook delivery %s moved to dead after %d attempts", delivery.Id.String(), delivery.Attempts)
+	}
+
+	if err := s.driver.MarkFailed(ctx, delivery); err != nil {
+		log.Error().Err(err).Msgf("failed to record webhook attempt for delivery %s", delivery.Id.String())
+	}
+}
+
+func validateWebhookUrl(rawUrl string) error {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		log.Warn().Msg(custom_errors.ErrWebhookUrl.Message)
+		return custom_errors.ErrWebhookUrl
+	}
+
+	return nil
+}
+
+func mapEventTypesDtoToEventTypes(eventTypesDto []generated.WebhookEventType) ([]event_model.EventType, error) {
+	if len(eventTypesDto) == 0 {
+		log.Warn().Msg(custom_errors.ErrWebhookEventType.Message)
+		return nil, custom_errors.ErrWebhookEventType
+	}
+
+	seen := make(map[event_model.EventType]struct{}, len(eventTypesDto))
+	eventTypes := make([]event_model.EventType, 0, len(eventTypesDto))
+	for _, eventTypeDto := range eventTypesDto {
+		var eventType event_model.EventType
+		switch eventTypeDto {
+		case generated.ReceptionOpened:
+			eventType = event_model.ReceptionOpened
+		case generated.ReceptionClosed:
+			eventType = event_model.ReceptionClosed
+		case generated.ReceptionReopened:
+			eventType = event_model.ReceptionReopened
+		case generated.ReceptionPaused:
+			eventType = event_model.ReceptionPaused
+		case generated.ReceptionResumed:
+			eventType = event_model.ReceptionResumed
+		case generated.ReceptionCancelled:
+			eventType = event_model.ReceptionCancelled
+		case generated.ReceptionVerified:
+			eventType = event_model.ReceptionVerified
+		case generated.ProductAdded:
+			eventType = event_model.ProductAdded
+		case generated.ProductDeleted:
+			eventType = event_model.ProductDeleted
+		default:
+			log.Warn().Msg(custom_errors.ErrWebhookEventType.Message)
+			return nil, custom_errors.ErrWebhookEventType
+		}
+
+		if _, exists := seen[eventType]; exists {
+			continue
+		}
+		seen[eventType] = struct{}{}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	return eventTypes, nil
+}
+
+func mapDeliveryStatusDtoToStatus(statusDto generated.GetWebhooksWebhookIdDeliveriesParamsStatus) (webhook_model.DeliveryStatus, error) {
+	switch statusDto {
+	case generated.GetWebhooksWebhookIdDeliveriesParamsStatusPending:
+		return webhook_model.Pending, nil
+	case generated.GetWebhooksWebhookIdDeliveriesParamsStatusDelivered:
+		return webhook_model.Delivered, nil
+	case generated.GetWebhooksWebhookIdDeliveriesParamsStatusDead:
+		return webhook_model.Dead, nil
+	default:
+		log.Warn().Msg(custom_errors.ErrWebhookStatus.Message)
+		return "", custom_errors.ErrWebhookStatus
+	}
+}
+
+func mapSubscriptionToDto(subscription *webhook_model.Subscription) (*generated.WebhookSubscription, error) {
+	idDto, err := services.ConvertPgUuidToOpenAPI(subscription.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypesDto := make([]generated.WebhookEventType, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypesDto = append(eventTypesDto, generated.WebhookEventType(eventType))
+	}
+
+	subscriptionDto := &generated.WebhookSubscription{
+		Id:         &idDto,
+		Url:        subscription.Url,
+		EventTypes: eventTypesDto,
+		CreatedAt:  &subscription.CreatedAt,
+	}
+
+	if subscription.PvzId.Valid {
+		pvzIdDto, err := services.ConvertPgUuidToOpenAPI(subscription.PvzId)
+		if err != nil {
+			return nil, err
+		}
+		subscriptionDto.PvzId = &pvzIdDto
+	}
+
+	return subscriptionDto, nil
+}
+
+func mapDeliveryToDto(delivery *webhook_model.Delivery) (*generated.WebhookDelivery, error) {
+	idDto, err := services.ConvertPgUuidToOpenAPI(delivery.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionIdDto, err := services.ConvertPgUuidToOpenAPI(delivery.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	eventIdDto, err := services.ConvertPgUuidToOpenAPI(delivery.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload map[string]interface{}
+	if err = json.Unmarshal(delivery.Payload, &payload); err != nil {
+		log.Error().Err(err).Msg(custom_errors.ErrUnmarshalEvent.Message)
+		return nil, custom_errors.ErrUnmarshalEvent
+	}
+
+	return &generated.WebhookDelivery{
+		Id:             idDto,
+		SubscriptionId: subscriptionIdDto,
+		EventId:        eventIdDto,
+		EventType:      generated.WebhookEventType(delivery.EventType),
+		Payload:        payload,
+		Status:         generated.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+		LastError:      delivery.LastError,
+	}, nil
+}
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_outbox_status_and_next_attempt_at;
+
+DROP TABLE IF EXISTS outbox CASCADE;
+
+DROP TYPE IF EXISTS outbox_status CASCADE;
\ No newline at end of file
@@ -0,0 +1,20 @@
+CREATE TYPE outbox_status AS enum (
+    'pending',
+    'published',
+    'failed'
+    );
+
+CREATE TABLE IF NOT EXISTS outbox
+(
+    id              UUID PRIMARY KEY,
+    event_type      VARCHAR(64)   NOT NULL,
+    payload         JSONB         NOT NULL,
+    created_at      TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    status          outbox_status NOT NULL DEFAULT 'pending',
+    attempts        INTEGER       NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at    TIMESTAMP,
+    last_error      TEXT
+);
+
+CREATE INDEX idx_outbox_status_and_next_attempt_at ON outbox (status, next_attempt_at);
\ No newline at end of file
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_webhook_deliveries_status_and_next_attempt_at;
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription_id_and_created_at;
+
+DROP TABLE IF EXISTS webhook_deliveries CASCADE;
+DROP TABLE IF EXISTS webhook_subscriptions CASCADE;
+
+DROP TYPE IF EXISTS webhook_delivery_status CASCADE;
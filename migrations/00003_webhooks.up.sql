@@ -0,0 +1,36 @@
+CREATE TYPE webhook_delivery_status AS enum (
+    'pending',
+    'delivered',
+    'dead'
+    );
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    id          UUID PRIMARY KEY,
+    url         TEXT          NOT NULL,
+    secret      TEXT          NOT NULL,
+    event_types VARCHAR(64)[] NOT NULL,
+    pvz_id      UUID,
+    created_at  TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (pvz_id) REFERENCES pvz (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              UUID PRIMARY KEY,
+    subscription_id UUID                    NOT NULL,
+    event_id        UUID                    NOT NULL,
+    event_type      VARCHAR(64)             NOT NULL,
+    payload         JSONB                   NOT NULL,
+    status          webhook_delivery_status NOT NULL DEFAULT 'pending',
+    attempts        INTEGER                 NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP               NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at      TIMESTAMP               NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at    TIMESTAMP,
+    last_error      TEXT,
+    UNIQUE (subscription_id, event_id),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webhook_deliveries_status_and_next_attempt_at ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX idx_webhook_deliveries_subscription_id_and_created_at ON webhook_deliveries (subscription_id, created_at);
@@ -0,0 +1 @@
+CREATE INDEX idx_pvz_registration_date_and_id ON pvz (registration_date, id);
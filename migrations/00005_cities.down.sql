@@ -0,0 +1,12 @@
+CREATE TYPE city AS enum (
+    'Москва',
+    'Санкт-Петербург',
+    'Казань'
+    );
+
+DROP INDEX IF EXISTS idx_pvz_city;
+
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS fk_pvz_city;
+ALTER TABLE pvz ALTER COLUMN city TYPE city USING city::city;
+
+DROP TABLE IF EXISTS cities;
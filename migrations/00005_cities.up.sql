@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS cities
+(
+    name       VARCHAR(128) PRIMARY KEY,
+    region     VARCHAR(128) NOT NULL,
+    timezone   VARCHAR(64)  NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO cities (name, region, timezone)
+VALUES ('Москва', 'Москва', 'Europe/Moscow'),
+       ('Санкт-Петербург', 'Санкт-Петербург', 'Europe/Moscow'),
+       ('Казань', 'Республика Татарстан', 'Europe/Moscow');
+
+ALTER TABLE pvz ALTER COLUMN city TYPE VARCHAR(128) USING city::text;
+ALTER TABLE pvz ADD CONSTRAINT fk_pvz_city FOREIGN KEY (city) REFERENCES cities (name) ON UPDATE CASCADE;
+
+CREATE INDEX idx_pvz_city ON pvz (city);
+
+DROP TYPE city;
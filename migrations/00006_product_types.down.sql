@@ -0,0 +1,12 @@
+CREATE TYPE product_type AS enum (
+    'электроника',
+    'одежда',
+    'обувь'
+    );
+
+DROP INDEX IF EXISTS idx_products_reception_id_and_product_type;
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS fk_products_product_type;
+ALTER TABLE products ALTER COLUMN product_type TYPE product_type USING product_type::product_type;
+
+DROP TABLE IF EXISTS product_types;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS product_types
+(
+    code              VARCHAR(64) PRIMARY KEY,
+    display_name_ru   VARCHAR(128) NOT NULL,
+    display_name_en   VARCHAR(128) NOT NULL,
+    is_fragile        BOOLEAN      NOT NULL DEFAULT FALSE,
+    is_oversize       BOOLEAN      NOT NULL DEFAULT FALSE,
+    max_per_reception INTEGER CHECK (max_per_reception > 0),
+    created_at        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO product_types (code, display_name_ru, display_name_en, is_fragile, is_oversize)
+VALUES ('электроника', 'Электроника', 'Electronics', TRUE, FALSE),
+       ('одежда', 'Одежда', 'Clothes', FALSE, FALSE),
+       ('обувь', 'Обувь', 'Shoes', FALSE, FALSE);
+
+ALTER TABLE products ALTER COLUMN product_type TYPE VARCHAR(64) USING product_type::text;
+ALTER TABLE products ADD CONSTRAINT fk_products_product_type FOREIGN KEY (product_type) REFERENCES product_types (code) ON UPDATE CASCADE;
+
+CREATE INDEX idx_products_reception_id_and_product_type ON products (reception_id, product_type);
+
+DROP TYPE product_type;
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_products_barcode;
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_dimensions_check,
+    DROP COLUMN IF EXISTS barcode,
+    DROP COLUMN IF EXISTS sku,
+    DROP COLUMN IF EXISTS order_id,
+    DROP COLUMN IF EXISTS weight_grams,
+    DROP COLUMN IF EXISTS length_mm,
+    DROP COLUMN IF EXISTS width_mm,
+    DROP COLUMN IF EXISTS height_mm;
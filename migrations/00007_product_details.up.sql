@@ -0,0 +1,13 @@
+ALTER TABLE products
+    ADD COLUMN barcode      VARCHAR(128),
+    ADD COLUMN sku          VARCHAR(128),
+    ADD COLUMN order_id     VARCHAR(128),
+    ADD COLUMN weight_grams INTEGER CHECK (weight_grams > 0),
+    ADD COLUMN length_mm    INTEGER CHECK (length_mm > 0),
+    ADD COLUMN width_mm     INTEGER CHECK (width_mm > 0),
+    ADD COLUMN height_mm    INTEGER CHECK (height_mm > 0),
+    ADD CONSTRAINT products_dimensions_check CHECK (
+        (length_mm IS NULL) = (width_mm IS NULL) AND (width_mm IS NULL) = (height_mm IS NULL)
+    );
+
+CREATE INDEX idx_products_barcode ON products (barcode) WHERE barcode IS NOT NULL;
@@ -0,0 +1,9 @@
+DELETE FROM products
+WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_products_barcode;
+CREATE INDEX idx_products_barcode ON products (barcode) WHERE barcode IS NOT NULL;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS deleted_by,
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,6 @@
+ALTER TABLE products
+    ADD COLUMN deleted_at TIMESTAMP,
+    ADD COLUMN deleted_by UUID REFERENCES users (id) ON DELETE SET NULL;
+
+DROP INDEX IF EXISTS idx_products_barcode;
+CREATE INDEX idx_products_barcode ON products (barcode) WHERE barcode IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS reception_corrections;
+
+ALTER TABLE receptions
+    DROP COLUMN IF EXISTS closed_at;
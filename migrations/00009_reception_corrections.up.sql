@@ -0,0 +1,16 @@
+ALTER TABLE receptions
+    ADD COLUMN closed_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS reception_corrections
+(
+    id           UUID PRIMARY KEY,
+    reception_id UUID      NOT NULL,
+    closed_at    TIMESTAMP,
+    reopened_by  UUID,
+    reopened_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reason       TEXT      NOT NULL,
+    FOREIGN KEY (reception_id) REFERENCES receptions (id) ON DELETE CASCADE,
+    FOREIGN KEY (reopened_by) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_reception_corrections_reception_id_and_reopened_at ON reception_corrections (reception_id, reopened_at);
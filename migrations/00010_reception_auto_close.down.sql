@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_receptions_status_and_reception_time;
+
+ALTER TABLE cities
+    DROP COLUMN IF EXISTS reception_auto_close_hours;
+
+UPDATE receptions
+SET status = 'close'
+WHERE status = 'auto_closed';
+
+ALTER TABLE receptions
+    DROP COLUMN IF EXISTS close_reason;
@@ -0,0 +1,9 @@
+ALTER TYPE reception_status ADD VALUE IF NOT EXISTS 'auto_closed';
+
+ALTER TABLE receptions
+    ADD COLUMN close_reason TEXT;
+
+ALTER TABLE cities
+    ADD COLUMN reception_auto_close_hours INTEGER CHECK (reception_auto_close_hours > 0);
+
+CREATE INDEX idx_receptions_status_and_reception_time ON receptions (status, reception_time);
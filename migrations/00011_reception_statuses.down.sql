@@ -0,0 +1,7 @@
+UPDATE receptions
+SET status = 'in_progress'
+WHERE status = 'paused';
+
+UPDATE receptions
+SET status = 'close'
+WHERE status IN ('verified', 'cancelled');
@@ -0,0 +1,3 @@
+ALTER TYPE reception_status ADD VALUE IF NOT EXISTS 'paused';
+ALTER TYPE reception_status ADD VALUE IF NOT EXISTS 'verified';
+ALTER TYPE reception_status ADD VALUE IF NOT EXISTS 'cancelled';
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_receptions_single_open_per_pvz;
@@ -0,0 +1,12 @@
+UPDATE receptions r
+SET status = 'close', closed_at = CURRENT_TIMESTAMP
+WHERE r.status IN ('in_progress', 'paused')
+  AND EXISTS (
+    SELECT 1
+    FROM receptions newer
+    WHERE newer.pvz_id = r.pvz_id
+      AND newer.status IN ('in_progress', 'paused')
+      AND (newer.reception_time, newer.id) > (r.reception_time, r.id)
+);
+
+CREATE UNIQUE INDEX idx_receptions_single_open_per_pvz ON receptions (pvz_id) WHERE status IN ('in_progress', 'paused');
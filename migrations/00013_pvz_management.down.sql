@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_pvz_status;
+
+ALTER TABLE pvz
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS capacity,
+    DROP COLUMN IF EXISTS working_hours,
+    DROP COLUMN IF EXISTS address;
+
+DROP TYPE IF EXISTS pvz_status;
@@ -0,0 +1,14 @@
+CREATE TYPE pvz_status AS enum (
+    'active',
+    'inactive',
+    'archived'
+    );
+
+ALTER TABLE pvz
+    ADD COLUMN address       TEXT,
+    ADD COLUMN working_hours TEXT,
+    ADD COLUMN capacity      INTEGER CHECK (capacity > 0),
+    ADD COLUMN status        pvz_status NOT NULL DEFAULT 'active',
+    ADD COLUMN archived_at   TIMESTAMP;
+
+CREATE INDEX idx_pvz_status ON pvz (status);
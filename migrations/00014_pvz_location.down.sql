@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_pvz_latitude;
+
+ALTER TABLE pvz
+    DROP CONSTRAINT IF EXISTS pvz_location_check,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude;
@@ -0,0 +1,6 @@
+ALTER TABLE pvz
+    ADD COLUMN latitude  DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    ADD CONSTRAINT pvz_location_check CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+CREATE INDEX idx_pvz_latitude ON pvz (latitude) WHERE latitude IS NOT NULL AND status = 'active';
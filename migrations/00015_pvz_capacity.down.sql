@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS pvz_product_type_capacities;
+
+ALTER TABLE pvz
+    DROP COLUMN IF EXISTS storage_hours;
@@ -0,0 +1,12 @@
+ALTER TABLE pvz
+    ADD COLUMN storage_hours INTEGER NOT NULL DEFAULT 72 CHECK (storage_hours > 0);
+
+CREATE TABLE IF NOT EXISTS pvz_product_type_capacities
+(
+    pvz_id       UUID        NOT NULL,
+    product_type VARCHAR(64) NOT NULL,
+    capacity     INTEGER     NOT NULL CHECK (capacity > 0),
+    PRIMARY KEY (pvz_id, product_type),
+    FOREIGN KEY (pvz_id) REFERENCES pvz (id) ON DELETE CASCADE,
+    FOREIGN KEY (product_type) REFERENCES product_types (code) ON UPDATE CASCADE ON DELETE CASCADE
+);
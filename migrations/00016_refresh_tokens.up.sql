@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS refresh_tokens
+(
+    id                UUID PRIMARY KEY,
+    family_id         UUID      NOT NULL,
+    user_id           UUID      NOT NULL,
+    token_hash        BYTEA     NOT NULL UNIQUE,
+    access_jti        UUID      NOT NULL UNIQUE,
+    access_expires_at TIMESTAMP NOT NULL,
+    created_at        TIMESTAMP NOT NULL,
+    expires_at        TIMESTAMP NOT NULL,
+    revoked_at        TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+CREATE INDEX idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX idx_refresh_tokens_expires_at ON refresh_tokens (expires_at);
+
+CREATE TABLE IF NOT EXISTS revoked_tokens
+(
+    jti        UUID PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
@@ -0,0 +1,2 @@
+ALTER TABLE receptions
+    DROP COLUMN IF EXISTS reopened_at;
@@ -0,0 +1,9 @@
+ALTER TABLE receptions
+    ADD COLUMN reopened_at TIMESTAMP;
+
+UPDATE receptions r
+SET reopened_at = c.reopened_at
+FROM (SELECT reception_id, MAX(reopened_at) AS reopened_at
+      FROM reception_corrections
+      GROUP BY reception_id) c
+WHERE c.reception_id = r.id;
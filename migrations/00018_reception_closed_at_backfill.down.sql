@@ -0,0 +1 @@
+-- backfilled closed_at values are kept: they cannot be told apart from real closing times
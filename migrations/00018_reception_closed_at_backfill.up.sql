@@ -0,0 +1,6 @@
+UPDATE receptions r
+SET closed_at = COALESCE((SELECT MAX(p.adding_time)
+                          FROM products p
+                          WHERE p.reception_id = r.id), r.reception_time)
+WHERE r.status NOT IN ('in_progress', 'paused')
+  AND r.closed_at IS NULL;
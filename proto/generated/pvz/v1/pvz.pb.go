@@ -22,11 +22,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PVZStatus int32
+
+const (
+	PVZStatus_PVZ_STATUS_ACTIVE   PVZStatus = 0
+	PVZStatus_PVZ_STATUS_INACTIVE PVZStatus = 1
+	PVZStatus_PVZ_STATUS_ARCHIVED PVZStatus = 2
+)
+
+// Enum value maps for PVZStatus.
+var (
+	PVZStatus_name = map[int32]string{
+		0: "PVZ_STATUS_ACTIVE",
+		1: "PVZ_STATUS_INACTIVE",
+		2: "PVZ_STATUS_ARCHIVED",
+	}
+	PVZStatus_value = map[string]int32{
+		"PVZ_STATUS_ACTIVE":   0,
+		"PVZ_STATUS_INACTIVE": 1,
+		"PVZ_STATUS_ARCHIVED": 2,
+	}
+)
+
+func (x PVZStatus) Enum() *PVZStatus {
+	p := new(PVZStatus)
+	*p = x
+	return p
+}
+
+func (x PVZStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PVZStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_v1_pvz_proto_enumTypes[0].Descriptor()
+}
+
+func (PVZStatus) Type() protoreflect.EnumType {
+	return &file_pvz_v1_pvz_proto_enumTypes[0]
+}
+
+func (x PVZStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PVZStatus.Descriptor instead.
+func (PVZStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{0}
+}
+
 type ReceptionStatus int32
 
 const (
 	ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS ReceptionStatus = 0
 	ReceptionStatus_RECEPTION_STATUS_CLOSED      ReceptionStatus = 1
+	ReceptionStatus_RECEPTION_STATUS_AUTO_CLOSED ReceptionStatus = 2
+	ReceptionStatus_RECEPTION_STATUS_PAUSED      ReceptionStatus = 3
+	ReceptionStatus_RECEPTION_STATUS_VERIFIED    ReceptionStatus = 4
+	ReceptionStatus_RECEPTION_STATUS_CANCELLED   ReceptionStatus = 5
 )
 
 // Enum value maps for ReceptionStatus.
@@ -34,10 +87,18 @@ var (
 	ReceptionStatus_name = map[int32]string{
 		0: "RECEPTION_STATUS_IN_PROGRESS",
 		1: "RECEPTION_STATUS_CLOSED",
+		2: "RECEPTION_STATUS_AUTO_CLOSED",
+		3: "RECEPTION_STATUS_PAUSED",
+		4: "RECEPTION_STATUS_VERIFIED",
+		5: "RECEPTION_STATUS_CANCELLED",
 	}
 	ReceptionStatus_value = map[string]int32{
 		"RECEPTION_STATUS_IN_PROGRESS": 0,
 		"RECEPTION_STATUS_CLOSED":      1,
+		"RECEPTION_STATUS_AUTO_CLOSED": 2,
+		"RECEPTION_STATUS_PAUSED":      3,
+		"RECEPTION_STATUS_VERIFIED":    4,
+		"RECEPTION_STATUS_CANCELLED":   5,
 	}
 )
 
@@ -52,11 +113,11 @@ func (x ReceptionStatus) String() string {
 }
 
 func (ReceptionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pvz_v1_pvz_proto_enumTypes[0].Descriptor()
+	return file_pvz_v1_pvz_proto_enumTypes[1].Descriptor()
 }
 
 func (ReceptionStatus) Type() protoreflect.EnumType {
-	return &file_pvz_v1_pvz_proto_enumTypes[0]
+	return &file_pvz_v1_pvz_proto_enumTypes[1]
 }
 
 func (x ReceptionStatus) Number() protoreflect.EnumNumber {
@@ -65,7 +126,123 @@ func (x ReceptionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReceptionStatus.Descriptor instead.
 func (ReceptionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{0}
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{1}
+}
+
+type PVZSortBy int32
+
+const (
+	PVZSortBy_PVZ_SORT_BY_REGISTRATION_DATE PVZSortBy = 0
+	PVZSortBy_PVZ_SORT_BY_LAST_ACTIVITY     PVZSortBy = 1
+)
+
+// Enum value maps for PVZSortBy.
+var (
+	PVZSortBy_name = map[int32]string{
+		0: "PVZ_SORT_BY_REGISTRATION_DATE",
+		1: "PVZ_SORT_BY_LAST_ACTIVITY",
+	}
+	PVZSortBy_value = map[string]int32{
+		"PVZ_SORT_BY_REGISTRATION_DATE": 0,
+		"PVZ_SORT_BY_LAST_ACTIVITY":     1,
+	}
+)
+
+func (x PVZSortBy) Enum() *PVZSortBy {
+	p := new(PVZSortBy)
+	*p = x
+	return p
+}
+
+func (x PVZSortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PVZSortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_v1_pvz_proto_enumTypes[2].Descriptor()
+}
+
+func (PVZSortBy) Type() protoreflect.EnumType {
+	return &file_pvz_v1_pvz_proto_enumTypes[2]
+}
+
+func (x PVZSortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PVZSortBy.Descriptor instead.
+func (PVZSortBy) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{2}
+}
+
+type PVZEventType int32
+
+const (
+	PVZEventType_PVZ_EVENT_TYPE_UNSPECIFIED         PVZEventType = 0
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_OPENED    PVZEventType = 1
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CLOSED    PVZEventType = 2
+	PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED       PVZEventType = 3
+	PVZEventType_PVZ_EVENT_TYPE_PRODUCT_DELETED     PVZEventType = 4
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_REOPENED  PVZEventType = 5
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_PAUSED    PVZEventType = 6
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_RESUMED   PVZEventType = 7
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CANCELLED PVZEventType = 8
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_VERIFIED  PVZEventType = 9
+)
+
+// Enum value maps for PVZEventType.
+var (
+	PVZEventType_name = map[int32]string{
+		0: "PVZ_EVENT_TYPE_UNSPECIFIED",
+		1: "PVZ_EVENT_TYPE_RECEPTION_OPENED",
+		2: "PVZ_EVENT_TYPE_RECEPTION_CLOSED",
+		3: "PVZ_EVENT_TYPE_PRODUCT_ADDED",
+		4: "PVZ_EVENT_TYPE_PRODUCT_DELETED",
+		5: "PVZ_EVENT_TYPE_RECEPTION_REOPENED",
+		6: "PVZ_EVENT_TYPE_RECEPTION_PAUSED",
+		7: "PVZ_EVENT_TYPE_RECEPTION_RESUMED",
+		8: "PVZ_EVENT_TYPE_RECEPTION_CANCELLED",
+		9: "PVZ_EVENT_TYPE_RECEPTION_VERIFIED",
+	}
+	PVZEventType_value = map[string]int32{
+		"PVZ_EVENT_TYPE_UNSPECIFIED":         0,
+		"PVZ_EVENT_TYPE_RECEPTION_OPENED":    1,
+		"PVZ_EVENT_TYPE_RECEPTION_CLOSED":    2,
+		"PVZ_EVENT_TYPE_PRODUCT_ADDED":       3,
+		"PVZ_EVENT_TYPE_PRODUCT_DELETED":     4,
+		"PVZ_EVENT_TYPE_RECEPTION_REOPENED":  5,
+		"PVZ_EVENT_TYPE_RECEPTION_PAUSED":    6,
+		"PVZ_EVENT_TYPE_RECEPTION_RESUMED":   7,
+		"PVZ_EVENT_TYPE_RECEPTION_CANCELLED": 8,
+		"PVZ_EVENT_TYPE_RECEPTION_VERIFIED":  9,
+	}
+)
+
+func (x PVZEventType) Enum() *PVZEventType {
+	p := new(PVZEventType)
+	*p = x
+	return p
+}
+
+func (x PVZEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PVZEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_v1_pvz_proto_enumTypes[3].Descriptor()
+}
+
+func (PVZEventType) Type() protoreflect.EnumType {
+	return &file_pvz_v1_pvz_proto_enumTypes[3]
+}
+
+func (x PVZEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PVZEventType.Descriptor instead.
+func (PVZEventType) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{3}
 }
 
 type PVZ struct {
@@ -73,6 +250,13 @@ type PVZ struct {
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RegistrationDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
 	City             string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Address          *string                `protobuf:"bytes,4,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	WorkingHours     *string                `protobuf:"bytes,5,opt,name=working_hours,json=workingHours,proto3,oneof" json:"working_hours,omitempty"`
+	Capacity         *int32                 `protobuf:"varint,6,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	Status           PVZStatus              `protobuf:"varint,7,opt,name=status,proto3,enum=pvz.v1.PVZStatus" json:"status,omitempty"`
+	ArchivedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	Latitude         *float64               `protobuf:"fixed64,9,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude        *float64               `protobuf:"fixed64,10,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -128,26 +312,80 @@ func (x *PVZ) GetCity() string {
 	return ""
 }
 
-type GetPVZListRequest struct {
+func (x *PVZ) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *PVZ) GetWorkingHours() string {
+	if x != nil && x.WorkingHours != nil {
+		return *x.WorkingHours
+	}
+	return ""
+}
+
+func (x *PVZ) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *PVZ) GetStatus() PVZStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PVZStatus_PVZ_STATUS_ACTIVE
+}
+
+func (x *PVZ) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *PVZ) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *PVZ) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+type Reception struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	PvzId         string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        ReceptionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=pvz.v1.ReceptionStatus" json:"status,omitempty"`
+	CloseReason   *string                `protobuf:"bytes,5,opt,name=close_reason,json=closeReason,proto3,oneof" json:"close_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPVZListRequest) Reset() {
-	*x = GetPVZListRequest{}
+func (x *Reception) Reset() {
+	*x = Reception{}
 	mi := &file_pvz_v1_pvz_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPVZListRequest) String() string {
+func (x *Reception) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPVZListRequest) ProtoMessage() {}
+func (*Reception) ProtoMessage() {}
 
-func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
+func (x *Reception) ProtoReflect() protoreflect.Message {
 	mi := &file_pvz_v1_pvz_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -159,32 +397,69 @@ func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
-func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Reception.ProtoReflect.Descriptor instead.
+func (*Reception) Descriptor() ([]byte, []int) {
 	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{1}
 }
 
-type GetPVZListResponse struct {
+func (x *Reception) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reception) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Reception) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *Reception) GetStatus() ReceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+func (x *Reception) GetCloseReason() string {
+	if x != nil && x.CloseReason != nil {
+		return *x.CloseReason
+	}
+	return ""
+}
+
+type ProductDimensions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	LengthMm      int32                  `protobuf:"varint,1,opt,name=length_mm,json=lengthMm,proto3" json:"length_mm,omitempty"`
+	WidthMm       int32                  `protobuf:"varint,2,opt,name=width_mm,json=widthMm,proto3" json:"width_mm,omitempty"`
+	HeightMm      int32                  `protobuf:"varint,3,opt,name=height_mm,json=heightMm,proto3" json:"height_mm,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPVZListResponse) Reset() {
-	*x = GetPVZListResponse{}
+func (x *ProductDimensions) Reset() {
+	*x = ProductDimensions{}
 	mi := &file_pvz_v1_pvz_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPVZListResponse) String() string {
+func (x *ProductDimensions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPVZListResponse) ProtoMessage() {}
+func (*ProductDimensions) ProtoMessage() {}
 
-func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
+func (x *ProductDimensions) ProtoReflect() protoreflect.Message {
 	mi := &file_pvz_v1_pvz_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -196,37 +471,3557 @@ func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
-func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProductDimensions.ProtoReflect.Descriptor instead.
+func (*ProductDimensions) Descriptor() ([]byte, []int) {
 	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetPVZListResponse) GetPvzs() []*PVZ {
+func (x *ProductDimensions) GetLengthMm() int32 {
 	if x != nil {
-		return x.Pvzs
+		return x.LengthMm
+	}
+	return 0
+}
+
+func (x *ProductDimensions) GetWidthMm() int32 {
+	if x != nil {
+		return x.WidthMm
+	}
+	return 0
+}
+
+func (x *ProductDimensions) GetHeightMm() int32 {
+	if x != nil {
+		return x.HeightMm
+	}
+	return 0
+}
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,4,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Barcode       *string                `protobuf:"bytes,5,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	Sku           *string                `protobuf:"bytes,6,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	OrderId       *string                `protobuf:"bytes,7,opt,name=order_id,json=orderId,proto3,oneof" json:"order_id,omitempty"`
+	WeightGrams   *int32                 `protobuf:"varint,8,opt,name=weight_grams,json=weightGrams,proto3,oneof" json:"weight_grams,omitempty"`
+	Dimensions    *ProductDimensions     `protobuf:"bytes,9,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
 	}
 	return nil
 }
 
-var File_pvz_v1_pvz_proto protoreflect.FileDescriptor
+func (x *Product) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
 
-const file_pvz_v1_pvz_proto_rawDesc = "" +
-	"\n" +
-	"\x10pvz/v1/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"r\n" +
-	"\x03PVZ\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
-	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
-	"\x04city\x18\x03 \x01(\tR\x04city\"\x13\n" +
-	"\x11GetPVZListRequest\"5\n" +
-	"\x12GetPVZListResponse\x12\x1f\n" +
-	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs*P\n" +
-	"\x0fReceptionStatus\x12 \n" +
-	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
-	"\x17RECEPTION_STATUS_CLOSED\x10\x012Q\n" +
-	"\n" +
-	"PVZService\x12C\n" +
+func (x *Product) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *Product) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *Product) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetOrderId() string {
+	if x != nil && x.OrderId != nil {
+		return *x.OrderId
+	}
+	return ""
+}
+
+func (x *Product) GetWeightGrams() int32 {
+	if x != nil && x.WeightGrams != nil {
+		return *x.WeightGrams
+	}
+	return 0
+}
+
+func (x *Product) GetDimensions() *ProductDimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+type ReceptionWithProducts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionWithProducts) Reset() {
+	*x = ReceptionWithProducts{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionWithProducts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionWithProducts) ProtoMessage() {}
+
+func (x *ReceptionWithProducts) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionWithProducts.ProtoReflect.Descriptor instead.
+func (*ReceptionWithProducts) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReceptionWithProducts) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ReceptionWithProducts) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type PVZWithReceptions struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pvz           *PVZ                     `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Receptions    []*ReceptionWithProducts `protobuf:"bytes,2,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	LastActivity  *timestamppb.Timestamp   `protobuf:"bytes,3,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZWithReceptions) Reset() {
+	*x = PVZWithReceptions{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZWithReceptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZWithReceptions) ProtoMessage() {}
+
+func (x *PVZWithReceptions) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZWithReceptions.ProtoReflect.Descriptor instead.
+func (*PVZWithReceptions) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PVZWithReceptions) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *PVZWithReceptions) GetReceptions() []*ReceptionWithProducts {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+func (x *PVZWithReceptions) GetLastActivity() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivity
+	}
+	return nil
+}
+
+type GetPVZListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListRequest) Reset() {
+	*x = GetPVZListRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListRequest) ProtoMessage() {}
+
+func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPVZListRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetPVZListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetPVZListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListResponse) Reset() {
+	*x = GetPVZListResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListResponse) ProtoMessage() {}
+
+func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPVZListResponse) GetPvzs() []*PVZ {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+func (x *GetPVZListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetPVZFullInfoRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Page            *int32                 `protobuf:"varint,3,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Limit           *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor          string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	City            string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	ReceptionStatus *ReceptionStatus       `protobuf:"varint,7,opt,name=reception_status,json=receptionStatus,proto3,enum=pvz.v1.ReceptionStatus,oneof" json:"reception_status,omitempty"`
+	ProductType     string                 `protobuf:"bytes,8,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
+	MinProducts     *int32                 `protobuf:"varint,9,opt,name=min_products,json=minProducts,proto3,oneof" json:"min_products,omitempty"`
+	SortBy          PVZSortBy              `protobuf:"varint,10,opt,name=sort_by,json=sortBy,proto3,enum=pvz.v1.PVZSortBy" json:"sort_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPVZFullInfoRequest) Reset() {
+	*x = GetPVZFullInfoRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZFullInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZFullInfoRequest) ProtoMessage() {}
+
+func (x *GetPVZFullInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZFullInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZFullInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPVZFullInfoRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetPVZFullInfoRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetPVZFullInfoRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetPVZFullInfoRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetPVZFullInfoRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetPVZFullInfoRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *GetPVZFullInfoRequest) GetReceptionStatus() ReceptionStatus {
+	if x != nil && x.ReceptionStatus != nil {
+		return *x.ReceptionStatus
+	}
+	return ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+func (x *GetPVZFullInfoRequest) GetProductType() string {
+	if x != nil {
+		return x.ProductType
+	}
+	return ""
+}
+
+func (x *GetPVZFullInfoRequest) GetMinProducts() int32 {
+	if x != nil && x.MinProducts != nil {
+		return *x.MinProducts
+	}
+	return 0
+}
+
+func (x *GetPVZFullInfoRequest) GetSortBy() PVZSortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return PVZSortBy_PVZ_SORT_BY_REGISTRATION_DATE
+}
+
+type GetPVZFullInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs          []*PVZWithReceptions   `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZFullInfoResponse) Reset() {
+	*x = GetPVZFullInfoResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZFullInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZFullInfoResponse) ProtoMessage() {}
+
+func (x *GetPVZFullInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZFullInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZFullInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPVZFullInfoResponse) GetPvzs() []*PVZWithReceptions {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+func (x *GetPVZFullInfoResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPVZFullInfoResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPVZFullInfoResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetPVZFullInfoResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *GetPVZFullInfoResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreatePVZRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RegistrationDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
+	City             string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Address          *string                `protobuf:"bytes,4,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	WorkingHours     *string                `protobuf:"bytes,5,opt,name=working_hours,json=workingHours,proto3,oneof" json:"working_hours,omitempty"`
+	Capacity         *int32                 `protobuf:"varint,6,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	Latitude         *float64               `protobuf:"fixed64,7,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude        *float64               `protobuf:"fixed64,8,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreatePVZRequest) Reset() {
+	*x = CreatePVZRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZRequest) ProtoMessage() {}
+
+func (x *CreatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZRequest.ProtoReflect.Descriptor instead.
+func (*CreatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreatePVZRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetRegistrationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationDate
+	}
+	return nil
+}
+
+func (x *CreatePVZRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetWorkingHours() string {
+	if x != nil && x.WorkingHours != nil {
+		return *x.WorkingHours
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *CreatePVZRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *CreatePVZRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+type CreatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZResponse) Reset() {
+	*x = CreatePVZResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZResponse) ProtoMessage() {}
+
+func (x *CreatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZResponse.ProtoReflect.Descriptor instead.
+func (*CreatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type UpdatePVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Address       *string                `protobuf:"bytes,2,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	WorkingHours  *string                `protobuf:"bytes,3,opt,name=working_hours,json=workingHours,proto3,oneof" json:"working_hours,omitempty"`
+	Capacity      *int32                 `protobuf:"varint,4,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,5,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,6,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePVZRequest) Reset() {
+	*x = UpdatePVZRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePVZRequest) ProtoMessage() {}
+
+func (x *UpdatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePVZRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdatePVZRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *UpdatePVZRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *UpdatePVZRequest) GetWorkingHours() string {
+	if x != nil && x.WorkingHours != nil {
+		return *x.WorkingHours
+	}
+	return ""
+}
+
+func (x *UpdatePVZRequest) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *UpdatePVZRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdatePVZRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+type UpdatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePVZResponse) Reset() {
+	*x = UpdatePVZResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePVZResponse) ProtoMessage() {}
+
+func (x *UpdatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePVZResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type DeactivatePVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePVZRequest) Reset() {
+	*x = DeactivatePVZRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePVZRequest) ProtoMessage() {}
+
+func (x *DeactivatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePVZRequest.ProtoReflect.Descriptor instead.
+func (*DeactivatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeactivatePVZRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type DeactivatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePVZResponse) Reset() {
+	*x = DeactivatePVZResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePVZResponse) ProtoMessage() {}
+
+func (x *DeactivatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePVZResponse.ProtoReflect.Descriptor instead.
+func (*DeactivatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeactivatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type ActivatePVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivatePVZRequest) Reset() {
+	*x = ActivatePVZRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivatePVZRequest) ProtoMessage() {}
+
+func (x *ActivatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivatePVZRequest.ProtoReflect.Descriptor instead.
+func (*ActivatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ActivatePVZRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type ActivatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivatePVZResponse) Reset() {
+	*x = ActivatePVZResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivatePVZResponse) ProtoMessage() {}
+
+func (x *ActivatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivatePVZResponse.ProtoReflect.Descriptor instead.
+func (*ActivatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ActivatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type ArchivePVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePVZRequest) Reset() {
+	*x = ArchivePVZRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePVZRequest) ProtoMessage() {}
+
+func (x *ArchivePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePVZRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ArchivePVZRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type ArchivePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePVZResponse) Reset() {
+	*x = ArchivePVZResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePVZResponse) ProtoMessage() {}
+
+func (x *ArchivePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePVZResponse.ProtoReflect.Descriptor instead.
+func (*ArchivePVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ArchivePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type GetNearbyPVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Radius        *float64               `protobuf:"fixed64,3,opt,name=radius,proto3,oneof" json:"radius,omitempty"`
+	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNearbyPVZRequest) Reset() {
+	*x = GetNearbyPVZRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNearbyPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearbyPVZRequest) ProtoMessage() {}
+
+func (x *GetNearbyPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearbyPVZRequest.ProtoReflect.Descriptor instead.
+func (*GetNearbyPVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetNearbyPVZRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetNearbyPVZRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetNearbyPVZRequest) GetRadius() float64 {
+	if x != nil && x.Radius != nil {
+		return *x.Radius
+	}
+	return 0
+}
+
+func (x *GetNearbyPVZRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type NearbyPVZ struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Distance      float64                `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyPVZ) Reset() {
+	*x = NearbyPVZ{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyPVZ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyPVZ) ProtoMessage() {}
+
+func (x *NearbyPVZ) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyPVZ.ProtoReflect.Descriptor instead.
+func (*NearbyPVZ) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NearbyPVZ) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *NearbyPVZ) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type GetNearbyPVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs          []*NearbyPVZ           `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNearbyPVZResponse) Reset() {
+	*x = GetNearbyPVZResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNearbyPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearbyPVZResponse) ProtoMessage() {}
+
+func (x *GetNearbyPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearbyPVZResponse.ProtoReflect.Descriptor instead.
+func (*GetNearbyPVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetNearbyPVZResponse) GetPvzs() []*NearbyPVZ {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+type ProductTypeOccupancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Capacity      *int32                 `protobuf:"varint,2,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	Occupied      int32                  `protobuf:"varint,3,opt,name=occupied,proto3" json:"occupied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductTypeOccupancy) Reset() {
+	*x = ProductTypeOccupancy{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductTypeOccupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductTypeOccupancy) ProtoMessage() {}
+
+func (x *ProductTypeOccupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductTypeOccupancy.ProtoReflect.Descriptor instead.
+func (*ProductTypeOccupancy) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ProductTypeOccupancy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductTypeOccupancy) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *ProductTypeOccupancy) GetOccupied() int32 {
+	if x != nil {
+		return x.Occupied
+	}
+	return 0
+}
+
+type PVZOccupancy struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	PvzId         string                  `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Capacity      *int32                  `protobuf:"varint,2,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	Occupied      int32                   `protobuf:"varint,3,opt,name=occupied,proto3" json:"occupied,omitempty"`
+	StorageHours  int32                   `protobuf:"varint,4,opt,name=storage_hours,json=storageHours,proto3" json:"storage_hours,omitempty"`
+	ProductTypes  []*ProductTypeOccupancy `protobuf:"bytes,5,rep,name=product_types,json=productTypes,proto3" json:"product_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZOccupancy) Reset() {
+	*x = PVZOccupancy{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZOccupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZOccupancy) ProtoMessage() {}
+
+func (x *PVZOccupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZOccupancy.ProtoReflect.Descriptor instead.
+func (*PVZOccupancy) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PVZOccupancy) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *PVZOccupancy) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *PVZOccupancy) GetOccupied() int32 {
+	if x != nil {
+		return x.Occupied
+	}
+	return 0
+}
+
+func (x *PVZOccupancy) GetStorageHours() int32 {
+	if x != nil {
+		return x.StorageHours
+	}
+	return 0
+}
+
+func (x *PVZOccupancy) GetProductTypes() []*ProductTypeOccupancy {
+	if x != nil {
+		return x.ProductTypes
+	}
+	return nil
+}
+
+type GetPVZOccupancyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZOccupancyRequest) Reset() {
+	*x = GetPVZOccupancyRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZOccupancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZOccupancyRequest) ProtoMessage() {}
+
+func (x *GetPVZOccupancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZOccupancyRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZOccupancyRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPVZOccupancyRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type GetPVZOccupancyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Occupancy     *PVZOccupancy          `protobuf:"bytes,1,opt,name=occupancy,proto3" json:"occupancy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZOccupancyResponse) Reset() {
+	*x = GetPVZOccupancyResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZOccupancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZOccupancyResponse) ProtoMessage() {}
+
+func (x *GetPVZOccupancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZOccupancyResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZOccupancyResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPVZOccupancyResponse) GetOccupancy() *PVZOccupancy {
+	if x != nil {
+		return x.Occupancy
+	}
+	return nil
+}
+
+type ProductTypeCapacity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductTypeCapacity) Reset() {
+	*x = ProductTypeCapacity{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductTypeCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductTypeCapacity) ProtoMessage() {}
+
+func (x *ProductTypeCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductTypeCapacity.ProtoReflect.Descriptor instead.
+func (*ProductTypeCapacity) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProductTypeCapacity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductTypeCapacity) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type UpdatePVZCapacityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	StorageHours  int32                  `protobuf:"varint,2,opt,name=storage_hours,json=storageHours,proto3" json:"storage_hours,omitempty"`
+	ProductTypes  []*ProductTypeCapacity `protobuf:"bytes,3,rep,name=product_types,json=productTypes,proto3" json:"product_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePVZCapacityRequest) Reset() {
+	*x = UpdatePVZCapacityRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePVZCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePVZCapacityRequest) ProtoMessage() {}
+
+func (x *UpdatePVZCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePVZCapacityRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePVZCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdatePVZCapacityRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *UpdatePVZCapacityRequest) GetStorageHours() int32 {
+	if x != nil {
+		return x.StorageHours
+	}
+	return 0
+}
+
+func (x *UpdatePVZCapacityRequest) GetProductTypes() []*ProductTypeCapacity {
+	if x != nil {
+		return x.ProductTypes
+	}
+	return nil
+}
+
+type UpdatePVZCapacityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Occupancy     *PVZOccupancy          `protobuf:"bytes,1,opt,name=occupancy,proto3" json:"occupancy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePVZCapacityResponse) Reset() {
+	*x = UpdatePVZCapacityResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePVZCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePVZCapacityResponse) ProtoMessage() {}
+
+func (x *UpdatePVZCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePVZCapacityResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePVZCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdatePVZCapacityResponse) GetOccupancy() *PVZOccupancy {
+	if x != nil {
+		return x.Occupancy
+	}
+	return nil
+}
+
+type CreateReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionRequest) Reset() {
+	*x = CreateReceptionRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionRequest) ProtoMessage() {}
+
+func (x *CreateReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CreateReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionResponse) Reset() {
+	*x = CreateReceptionResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionResponse) ProtoMessage() {}
+
+func (x *CreateReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type CloseLastReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLastReceptionRequest) Reset() {
+	*x = CloseLastReceptionRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLastReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLastReceptionRequest) ProtoMessage() {}
+
+func (x *CloseLastReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLastReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CloseLastReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CloseLastReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CloseLastReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLastReceptionResponse) Reset() {
+	*x = CloseLastReceptionResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLastReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLastReceptionResponse) ProtoMessage() {}
+
+func (x *CloseLastReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLastReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CloseLastReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CloseLastReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type GetReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionRequest) Reset() {
+	*x = GetReceptionRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionRequest) ProtoMessage() {}
+
+func (x *GetReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionRequest.ProtoReflect.Descriptor instead.
+func (*GetReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetReceptionRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+type GetReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *ReceptionWithProducts `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionResponse) Reset() {
+	*x = GetReceptionResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionResponse) ProtoMessage() {}
+
+func (x *GetReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionResponse.ProtoReflect.Descriptor instead.
+func (*GetReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetReceptionResponse) GetReception() *ReceptionWithProducts {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type GetPVZReceptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        *ReceptionStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=pvz.v1.ReceptionStatus,oneof" json:"status,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Page          *int32                 `protobuf:"varint,5,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Limit         *int32                 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZReceptionsRequest) Reset() {
+	*x = GetPVZReceptionsRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZReceptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZReceptionsRequest) ProtoMessage() {}
+
+func (x *GetPVZReceptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZReceptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZReceptionsRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetPVZReceptionsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *GetPVZReceptionsRequest) GetStatus() ReceptionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+func (x *GetPVZReceptionsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetPVZReceptionsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetPVZReceptionsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetPVZReceptionsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetPVZReceptionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Receptions    []*ReceptionWithProducts `protobuf:"bytes,1,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	Page          int32                    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total         int32                    `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages    int32                    `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZReceptionsResponse) Reset() {
+	*x = GetPVZReceptionsResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZReceptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZReceptionsResponse) ProtoMessage() {}
+
+func (x *GetPVZReceptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZReceptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZReceptionsResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetPVZReceptionsResponse) GetReceptions() []*ReceptionWithProducts {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+func (x *GetPVZReceptionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPVZReceptionsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPVZReceptionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetPVZReceptionsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type ReceptionCorrection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,2,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	ReopenedBy    string                 `protobuf:"bytes,4,opt,name=reopened_by,json=reopenedBy,proto3" json:"reopened_by,omitempty"`
+	ReopenedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reopened_at,json=reopenedAt,proto3" json:"reopened_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionCorrection) Reset() {
+	*x = ReceptionCorrection{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionCorrection) ProtoMessage() {}
+
+func (x *ReceptionCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionCorrection.ProtoReflect.Descriptor instead.
+func (*ReceptionCorrection) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReceptionCorrection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReceptionCorrection) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *ReceptionCorrection) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *ReceptionCorrection) GetReopenedBy() string {
+	if x != nil {
+		return x.ReopenedBy
+	}
+	return ""
+}
+
+func (x *ReceptionCorrection) GetReopenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReopenedAt
+	}
+	return nil
+}
+
+func (x *ReceptionCorrection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReopenReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenReceptionRequest) Reset() {
+	*x = ReopenReceptionRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenReceptionRequest) ProtoMessage() {}
+
+func (x *ReopenReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenReceptionRequest.ProtoReflect.Descriptor instead.
+func (*ReopenReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReopenReceptionRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *ReopenReceptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReopenReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenReceptionResponse) Reset() {
+	*x = ReopenReceptionResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenReceptionResponse) ProtoMessage() {}
+
+func (x *ReopenReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenReceptionResponse.ProtoReflect.Descriptor instead.
+func (*ReopenReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReopenReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type ChangeReceptionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Status        ReceptionStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=pvz.v1.ReceptionStatus" json:"status,omitempty"`
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeReceptionStatusRequest) Reset() {
+	*x = ChangeReceptionStatusRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeReceptionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeReceptionStatusRequest) ProtoMessage() {}
+
+func (x *ChangeReceptionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeReceptionStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeReceptionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ChangeReceptionStatusRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *ChangeReceptionStatusRequest) GetStatus() ReceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+func (x *ChangeReceptionStatusRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type ChangeReceptionStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeReceptionStatusResponse) Reset() {
+	*x = ChangeReceptionStatusResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeReceptionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeReceptionStatusResponse) ProtoMessage() {}
+
+func (x *ChangeReceptionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeReceptionStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangeReceptionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ChangeReceptionStatusResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type GetReceptionCorrectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionCorrectionsRequest) Reset() {
+	*x = GetReceptionCorrectionsRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionCorrectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionCorrectionsRequest) ProtoMessage() {}
+
+func (x *GetReceptionCorrectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionCorrectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetReceptionCorrectionsRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetReceptionCorrectionsRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+type GetReceptionCorrectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Corrections   []*ReceptionCorrection `protobuf:"bytes,1,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionCorrectionsResponse) Reset() {
+	*x = GetReceptionCorrectionsResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionCorrectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionCorrectionsResponse) ProtoMessage() {}
+
+func (x *GetReceptionCorrectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionCorrectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetReceptionCorrectionsResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetReceptionCorrectionsResponse) GetCorrections() []*ReceptionCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+type AddProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Barcode       *string                `protobuf:"bytes,3,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	Sku           *string                `protobuf:"bytes,4,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	OrderId       *string                `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3,oneof" json:"order_id,omitempty"`
+	WeightGrams   *int32                 `protobuf:"varint,6,opt,name=weight_grams,json=weightGrams,proto3,oneof" json:"weight_grams,omitempty"`
+	Dimensions    *ProductDimensions     `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductRequest) Reset() {
+	*x = AddProductRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductRequest) ProtoMessage() {}
+
+func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductRequest.ProtoReflect.Descriptor instead.
+func (*AddProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetOrderId() string {
+	if x != nil && x.OrderId != nil {
+		return *x.OrderId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetWeightGrams() int32 {
+	if x != nil && x.WeightGrams != nil {
+		return *x.WeightGrams
+	}
+	return 0
+}
+
+func (x *AddProductRequest) GetDimensions() *ProductDimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+type AddProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductResponse) Reset() {
+	*x = AddProductResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductResponse) ProtoMessage() {}
+
+func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductResponse.ProtoReflect.Descriptor instead.
+func (*AddProductResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ProductBatchItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductBatchItemResult) Reset() {
+	*x = ProductBatchItemResult{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductBatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductBatchItemResult) ProtoMessage() {}
+
+func (x *ProductBatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductBatchItemResult.ProtoReflect.Descriptor instead.
+func (*ProductBatchItemResult) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ProductBatchItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ProductBatchItemResult) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ProductBatchItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddProductsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	ReceptionId   string                    `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Created       int32                     `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Failed        int32                     `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Items         []*ProductBatchItemResult `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductsResponse) Reset() {
+	*x = AddProductsResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsResponse) ProtoMessage() {}
+
+func (x *AddProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsResponse.ProtoReflect.Descriptor instead.
+func (*AddProductsResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddProductsResponse) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *AddProductsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *AddProductsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *AddProductsResponse) GetItems() []*ProductBatchItemResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteLastProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductRequest) Reset() {
+	*x = DeleteLastProductRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductRequest) ProtoMessage() {}
+
+func (x *DeleteLastProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteLastProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type DeleteLastProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductResponse) Reset() {
+	*x = DeleteLastProductResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductResponse) ProtoMessage() {}
+
+func (x *DeleteLastProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{50}
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *DeleteProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{52}
+}
+
+type RestoreProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductRequest) ProtoMessage() {}
+
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RestoreProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *RestoreProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type RestoreProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductResponse) Reset() {
+	*x = RestoreProductResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductResponse) ProtoMessage() {}
+
+func (x *RestoreProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductResponse.ProtoReflect.Descriptor instead.
+func (*RestoreProductResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RestoreProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type GetProductByBarcodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Barcode       string                 `protobuf:"bytes,1,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductByBarcodeRequest) Reset() {
+	*x = GetProductByBarcodeRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByBarcodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByBarcodeRequest) ProtoMessage() {}
+
+func (x *GetProductByBarcodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductByBarcodeRequest.ProtoReflect.Descriptor instead.
+func (*GetProductByBarcodeRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetProductByBarcodeRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+type GetProductByBarcodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Reception     *Reception             `protobuf:"bytes,2,opt,name=reception,proto3" json:"reception,omitempty"`
+	Pvz           *PVZ                   `protobuf:"bytes,3,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductByBarcodeResponse) Reset() {
+	*x = GetProductByBarcodeResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByBarcodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByBarcodeResponse) ProtoMessage() {}
+
+func (x *GetProductByBarcodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductByBarcodeResponse.ProtoReflect.Descriptor instead.
+func (*GetProductByBarcodeResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetProductByBarcodeResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetProductByBarcodeResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *GetProductByBarcodeResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type WatchPVZEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPVZEventsRequest) Reset() {
+	*x = WatchPVZEventsRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPVZEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPVZEventsRequest) ProtoMessage() {}
+
+func (x *WatchPVZEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPVZEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPVZEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *WatchPVZEventsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *WatchPVZEventsRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type PVZEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          PVZEventType           `protobuf:"varint,2,opt,name=type,proto3,enum=pvz.v1.PVZEventType" json:"type,omitempty"`
+	PvzId         string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,4,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductType   string                 `protobuf:"bytes,6,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZEvent) Reset() {
+	*x = PVZEvent{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZEvent) ProtoMessage() {}
+
+func (x *PVZEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZEvent.ProtoReflect.Descriptor instead.
+func (*PVZEvent) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PVZEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetType() PVZEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PVZEventType_PVZ_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PVZEvent) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetProductType() string {
+	if x != nil {
+		return x.ProductType
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_pvz_v1_pvz_proto protoreflect.FileDescriptor
+
+const file_pvz_v1_pvz_proto_rawDesc = "" +
+	"\n" +
+	"\x10pvz/v1/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x03\n" +
+	"\x03PVZ\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
+	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x1d\n" +
+	"\aaddress\x18\x04 \x01(\tH\x00R\aaddress\x88\x01\x01\x12(\n" +
+	"\rworking_hours\x18\x05 \x01(\tH\x01R\fworkingHours\x88\x01\x01\x12\x1f\n" +
+	"\bcapacity\x18\x06 \x01(\x05H\x02R\bcapacity\x88\x01\x01\x12)\n" +
+	"\x06status\x18\a \x01(\x0e2\x11.pvz.v1.PVZStatusR\x06status\x12;\n" +
+	"\varchived_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12\x1f\n" +
+	"\blatitude\x18\t \x01(\x01H\x03R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\n" +
+	" \x01(\x01H\x04R\tlongitude\x88\x01\x01B\n" +
+	"\n" +
+	"\b_addressB\x10\n" +
+	"\x0e_working_hoursB\v\n" +
+	"\t_capacityB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\xd5\x01\n" +
+	"\tReception\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x15\n" +
+	"\x06pvz_id\x18\x03 \x01(\tR\x05pvzId\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.pvz.v1.ReceptionStatusR\x06status\x12&\n" +
+	"\fclose_reason\x18\x05 \x01(\tH\x00R\vcloseReason\x88\x01\x01B\x0f\n" +
+	"\r_close_reason\"h\n" +
+	"\x11ProductDimensions\x12\x1b\n" +
+	"\tlength_mm\x18\x01 \x01(\x05R\blengthMm\x12\x19\n" +
+	"\bwidth_mm\x18\x02 \x01(\x05R\awidthMm\x12\x1b\n" +
+	"\theight_mm\x18\x03 \x01(\x05R\bheightMm\"\xf4\x02\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12!\n" +
+	"\freception_id\x18\x04 \x01(\tR\vreceptionId\x12\x1d\n" +
+	"\abarcode\x18\x05 \x01(\tH\x00R\abarcode\x88\x01\x01\x12\x15\n" +
+	"\x03sku\x18\x06 \x01(\tH\x01R\x03sku\x88\x01\x01\x12\x1e\n" +
+	"\border_id\x18\a \x01(\tH\x02R\aorderId\x88\x01\x01\x12&\n" +
+	"\fweight_grams\x18\b \x01(\x05H\x03R\vweightGrams\x88\x01\x01\x129\n" +
+	"\n" +
+	"dimensions\x18\t \x01(\v2\x19.pvz.v1.ProductDimensionsR\n" +
+	"dimensionsB\n" +
+	"\n" +
+	"\b_barcodeB\x06\n" +
+	"\x04_skuB\v\n" +
+	"\t_order_idB\x0f\n" +
+	"\r_weight_grams\"u\n" +
+	"\x15ReceptionWithProducts\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12+\n" +
+	"\bproducts\x18\x02 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"\xb2\x01\n" +
+	"\x11PVZWithReceptions\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x12=\n" +
+	"\n" +
+	"receptions\x18\x02 \x03(\v2\x1d.pvz.v1.ReceptionWithProductsR\n" +
+	"receptions\x12?\n" +
+	"\rlast_activity\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity\"P\n" +
+	"\x11GetPVZListRequest\x12\x19\n" +
+	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursorB\b\n" +
+	"\x06_limit\"V\n" +
+	"\x12GetPVZListResponse\x12\x1f\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xe2\x03\n" +
+	"\x15GetPVZFullInfoRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x17\n" +
+	"\x04page\x18\x03 \x01(\x05H\x00R\x04page\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x01R\x05limit\x88\x01\x01\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x12G\n" +
+	"\x10reception_status\x18\a \x01(\x0e2\x17.pvz.v1.ReceptionStatusH\x02R\x0freceptionStatus\x88\x01\x01\x12!\n" +
+	"\fproduct_type\x18\b \x01(\tR\vproductType\x12&\n" +
+	"\fmin_products\x18\t \x01(\x05H\x03R\vminProducts\x88\x01\x01\x12*\n" +
+	"\asort_by\x18\n" +
+	" \x01(\x0e2\x11.pvz.v1.PVZSortByR\x06sortByB\a\n" +
+	"\x05_pageB\b\n" +
+	"\x06_limitB\x13\n" +
+	"\x11_reception_statusB\x0f\n" +
+	"\r_min_products\"\xc9\x01\n" +
+	"\x16GetPVZFullInfoResponse\x12-\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\x19.pvz.v1.PVZWithReceptionsR\x04pvzs\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\x12\x1f\n" +
+	"\vnext_cursor\x18\x06 \x01(\tR\n" +
+	"nextCursor\"\xf3\x02\n" +
+	"\x10CreatePVZRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
+	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x1d\n" +
+	"\aaddress\x18\x04 \x01(\tH\x00R\aaddress\x88\x01\x01\x12(\n" +
+	"\rworking_hours\x18\x05 \x01(\tH\x01R\fworkingHours\x88\x01\x01\x12\x1f\n" +
+	"\bcapacity\x18\x06 \x01(\x05H\x02R\bcapacity\x88\x01\x01\x12\x1f\n" +
+	"\blatitude\x18\a \x01(\x01H\x03R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\b \x01(\x01H\x04R\tlongitude\x88\x01\x01B\n" +
+	"\n" +
+	"\b_addressB\x10\n" +
+	"\x0e_working_hoursB\v\n" +
+	"\t_capacityB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"2\n" +
+	"\x11CreatePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"\x9d\x02\n" +
+	"\x10UpdatePVZRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x1d\n" +
+	"\aaddress\x18\x02 \x01(\tH\x00R\aaddress\x88\x01\x01\x12(\n" +
+	"\rworking_hours\x18\x03 \x01(\tH\x01R\fworkingHours\x88\x01\x01\x12\x1f\n" +
+	"\bcapacity\x18\x04 \x01(\x05H\x02R\bcapacity\x88\x01\x01\x12\x1f\n" +
+	"\blatitude\x18\x05 \x01(\x01H\x03R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x06 \x01(\x01H\x04R\tlongitude\x88\x01\x01B\n" +
+	"\n" +
+	"\b_addressB\x10\n" +
+	"\x0e_working_hoursB\v\n" +
+	"\t_capacityB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"2\n" +
+	"\x11UpdatePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"-\n" +
+	"\x14DeactivatePVZRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"6\n" +
+	"\x15DeactivatePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"+\n" +
+	"\x12ActivatePVZRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"4\n" +
+	"\x13ActivatePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"*\n" +
+	"\x11ArchivePVZRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"3\n" +
+	"\x12ArchivePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"\x9c\x01\n" +
+	"\x13GetNearbyPVZRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1b\n" +
+	"\x06radius\x18\x03 \x01(\x01H\x00R\x06radius\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x01R\x05limit\x88\x01\x01B\t\n" +
+	"\a_radiusB\b\n" +
+	"\x06_limit\"F\n" +
+	"\tNearbyPVZ\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x01R\bdistance\"=\n" +
+	"\x14GetNearbyPVZResponse\x12%\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\x11.pvz.v1.NearbyPVZR\x04pvzs\"t\n" +
+	"\x14ProductTypeOccupancy\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1f\n" +
+	"\bcapacity\x18\x02 \x01(\x05H\x00R\bcapacity\x88\x01\x01\x12\x1a\n" +
+	"\boccupied\x18\x03 \x01(\x05R\boccupiedB\v\n" +
+	"\t_capacity\"\xd7\x01\n" +
+	"\fPVZOccupancy\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x1f\n" +
+	"\bcapacity\x18\x02 \x01(\x05H\x00R\bcapacity\x88\x01\x01\x12\x1a\n" +
+	"\boccupied\x18\x03 \x01(\x05R\boccupied\x12#\n" +
+	"\rstorage_hours\x18\x04 \x01(\x05R\fstorageHours\x12A\n" +
+	"\rproduct_types\x18\x05 \x03(\v2\x1c.pvz.v1.ProductTypeOccupancyR\fproductTypesB\v\n" +
+	"\t_capacity\"/\n" +
+	"\x16GetPVZOccupancyRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"M\n" +
+	"\x17GetPVZOccupancyResponse\x122\n" +
+	"\toccupancy\x18\x01 \x01(\v2\x14.pvz.v1.PVZOccupancyR\toccupancy\"E\n" +
+	"\x13ProductTypeCapacity\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\"\x98\x01\n" +
+	"\x18UpdatePVZCapacityRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12#\n" +
+	"\rstorage_hours\x18\x02 \x01(\x05R\fstorageHours\x12@\n" +
+	"\rproduct_types\x18\x03 \x03(\v2\x1b.pvz.v1.ProductTypeCapacityR\fproductTypes\"O\n" +
+	"\x19UpdatePVZCapacityResponse\x122\n" +
+	"\toccupancy\x18\x01 \x01(\v2\x14.pvz.v1.PVZOccupancyR\toccupancy\"/\n" +
+	"\x16CreateReceptionRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"J\n" +
+	"\x17CreateReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"2\n" +
+	"\x19CloseLastReceptionRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"M\n" +
+	"\x1aCloseLastReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"8\n" +
+	"\x13GetReceptionRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\"S\n" +
+	"\x14GetReceptionResponse\x12;\n" +
+	"\treception\x18\x01 \x01(\v2\x1d.pvz.v1.ReceptionWithProductsR\treception\"\xaa\x02\n" +
+	"\x17GetPVZReceptionsRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.pvz.v1.ReceptionStatusH\x00R\x06status\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x17\n" +
+	"\x04page\x18\x05 \x01(\x05H\x01R\x04page\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x06 \x01(\x05H\x02R\x05limit\x88\x01\x01B\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_pageB\b\n" +
+	"\x06_limit\"\xba\x01\n" +
+	"\x18GetPVZReceptionsResponse\x12=\n" +
+	"\n" +
+	"receptions\x18\x01 \x03(\v2\x1d.pvz.v1.ReceptionWithProductsR\n" +
+	"receptions\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"\xf7\x01\n" +
+	"\x13ReceptionCorrection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\freception_id\x18\x02 \x01(\tR\vreceptionId\x127\n" +
+	"\tclosed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12\x1f\n" +
+	"\vreopened_by\x18\x04 \x01(\tR\n" +
+	"reopenedBy\x12;\n" +
+	"\vreopened_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reopenedAt\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"S\n" +
+	"\x16ReopenReceptionRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"J\n" +
+	"\x17ReopenReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"\x9a\x01\n" +
+	"\x1cChangeReceptionStatusRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.pvz.v1.ReceptionStatusR\x06status\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"P\n" +
+	"\x1dChangeReceptionStatusResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"C\n" +
+	"\x1eGetReceptionCorrectionsRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\"`\n" +
+	"\x1fGetReceptionCorrectionsResponse\x12=\n" +
+	"\vcorrections\x18\x01 \x03(\v2\x1b.pvz.v1.ReceptionCorrectionR\vcorrections\"\xa9\x02\n" +
+	"\x11AddProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\abarcode\x18\x03 \x01(\tH\x00R\abarcode\x88\x01\x01\x12\x15\n" +
+	"\x03sku\x18\x04 \x01(\tH\x01R\x03sku\x88\x01\x01\x12\x1e\n" +
+	"\border_id\x18\x05 \x01(\tH\x02R\aorderId\x88\x01\x01\x12&\n" +
+	"\fweight_grams\x18\x06 \x01(\x05H\x03R\vweightGrams\x88\x01\x01\x129\n" +
+	"\n" +
+	"dimensions\x18\a \x01(\v2\x19.pvz.v1.ProductDimensionsR\n" +
+	"dimensionsB\n" +
+	"\n" +
+	"\b_barcodeB\x06\n" +
+	"\x04_skuB\v\n" +
+	"\t_order_idB\x0f\n" +
+	"\r_weight_grams\"?\n" +
+	"\x12AddProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"o\n" +
+	"\x16ProductBatchItemResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12)\n" +
+	"\aproduct\x18\x02 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xa0\x01\n" +
+	"\x13AddProductsResponse\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x124\n" +
+	"\x05items\x18\x04 \x03(\v2\x1e.pvz.v1.ProductBatchItemResultR\x05items\"1\n" +
+	"\x18DeleteLastProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"\x1b\n" +
+	"\x19DeleteLastProductResponse\"L\n" +
+	"\x14DeleteProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"\x17\n" +
+	"\x15DeleteProductResponse\"M\n" +
+	"\x15RestoreProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"C\n" +
+	"\x16RestoreProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"6\n" +
+	"\x1aGetProductByBarcodeRequest\x12\x18\n" +
+	"\abarcode\x18\x01 \x01(\tR\abarcode\"\x98\x01\n" +
+	"\x1bGetProductByBarcodeResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\x12/\n" +
+	"\treception\x18\x02 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12\x1d\n" +
+	"\x03pvz\x18\x03 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"B\n" +
+	"\x15WatchPVZEventsRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\"\xfd\x01\n" +
+	"\bPVZEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x14.pvz.v1.PVZEventTypeR\x04type\x12\x15\n" +
+	"\x06pvz_id\x18\x03 \x01(\tR\x05pvzId\x12!\n" +
+	"\freception_id\x18\x04 \x01(\tR\vreceptionId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x05 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_type\x18\x06 \x01(\tR\vproductType\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt*T\n" +
+	"\tPVZStatus\x12\x15\n" +
+	"\x11PVZ_STATUS_ACTIVE\x10\x00\x12\x17\n" +
+	"\x13PVZ_STATUS_INACTIVE\x10\x01\x12\x17\n" +
+	"\x13PVZ_STATUS_ARCHIVED\x10\x02*\xce\x01\n" +
+	"\x0fReceptionStatus\x12 \n" +
+	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
+	"\x17RECEPTION_STATUS_CLOSED\x10\x01\x12 \n" +
+	"\x1cRECEPTION_STATUS_AUTO_CLOSED\x10\x02\x12\x1b\n" +
+	"\x17RECEPTION_STATUS_PAUSED\x10\x03\x12\x1d\n" +
+	"\x19RECEPTION_STATUS_VERIFIED\x10\x04\x12\x1e\n" +
+	"\x1aRECEPTION_STATUS_CANCELLED\x10\x05*M\n" +
+	"\tPVZSortBy\x12!\n" +
+	"\x1dPVZ_SORT_BY_REGISTRATION_DATE\x10\x00\x12\x1d\n" +
+	"\x19PVZ_SORT_BY_LAST_ACTIVITY\x10\x01*\xff\x02\n" +
+	"\fPVZEventType\x12\x1e\n" +
+	"\x1aPVZ_EVENT_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fPVZ_EVENT_TYPE_RECEPTION_OPENED\x10\x01\x12#\n" +
+	"\x1fPVZ_EVENT_TYPE_RECEPTION_CLOSED\x10\x02\x12 \n" +
+	"\x1cPVZ_EVENT_TYPE_PRODUCT_ADDED\x10\x03\x12\"\n" +
+	"\x1ePVZ_EVENT_TYPE_PRODUCT_DELETED\x10\x04\x12%\n" +
+	"!PVZ_EVENT_TYPE_RECEPTION_REOPENED\x10\x05\x12#\n" +
+	"\x1fPVZ_EVENT_TYPE_RECEPTION_PAUSED\x10\x06\x12$\n" +
+	" PVZ_EVENT_TYPE_RECEPTION_RESUMED\x10\a\x12&\n" +
+	"\"PVZ_EVENT_TYPE_RECEPTION_CANCELLED\x10\b\x12%\n" +
+	"!PVZ_EVENT_TYPE_RECEPTION_VERIFIED\x10\t2\x9f\x0f\n" +
+	"\n" +
+	"PVZService\x12C\n" +
+	"\n" +
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse\x12O\n" +
+	"\x0eGetPVZFullInfo\x12\x1d.pvz.v1.GetPVZFullInfoRequest\x1a\x1e.pvz.v1.GetPVZFullInfoResponse\x12@\n" +
+	"\tCreatePVZ\x12\x18.pvz.v1.CreatePVZRequest\x1a\x19.pvz.v1.CreatePVZResponse\x12@\n" +
+	"\tUpdatePVZ\x12\x18.pvz.v1.UpdatePVZRequest\x1a\x19.pvz.v1.UpdatePVZResponse\x12L\n" +
+	"\rDeactivatePVZ\x12\x1c.pvz.v1.DeactivatePVZRequest\x1a\x1d.pvz.v1.DeactivatePVZResponse\x12F\n" +
+	"\vActivatePVZ\x12\x1a.pvz.v1.ActivatePVZRequest\x1a\x1b.pvz.v1.ActivatePVZResponse\x12C\n" +
+	"\n" +
+	"ArchivePVZ\x12\x19.pvz.v1.ArchivePVZRequest\x1a\x1a.pvz.v1.ArchivePVZResponse\x12I\n" +
+	"\fGetNearbyPVZ\x12\x1b.pvz.v1.GetNearbyPVZRequest\x1a\x1c.pvz.v1.GetNearbyPVZResponse\x12R\n" +
+	"\x0fGetPVZOccupancy\x12\x1e.pvz.v1.GetPVZOccupancyRequest\x1a\x1f.pvz.v1.GetPVZOccupancyResponse\x12X\n" +
+	"\x11UpdatePVZCapacity\x12 .pvz.v1.UpdatePVZCapacityRequest\x1a!.pvz.v1.UpdatePVZCapacityResponse\x12R\n" +
+	"\x0fCreateReception\x12\x1e.pvz.v1.CreateReceptionRequest\x1a\x1f.pvz.v1.CreateReceptionResponse\x12[\n" +
+	"\x12CloseLastReception\x12!.pvz.v1.CloseLastReceptionRequest\x1a\".pvz.v1.CloseLastReceptionResponse\x12I\n" +
+	"\fGetReception\x12\x1b.pvz.v1.GetReceptionRequest\x1a\x1c.pvz.v1.GetReceptionResponse\x12U\n" +
+	"\x10GetPVZReceptions\x12\x1f.pvz.v1.GetPVZReceptionsRequest\x1a .pvz.v1.GetPVZReceptionsResponse\x12R\n" +
+	"\x0fReopenReception\x12\x1e.pvz.v1.ReopenReceptionRequest\x1a\x1f.pvz.v1.ReopenReceptionResponse\x12d\n" +
+	"\x15ChangeReceptionStatus\x12$.pvz.v1.ChangeReceptionStatusRequest\x1a%.pvz.v1.ChangeReceptionStatusResponse\x12j\n" +
+	"\x17GetReceptionCorrections\x12&.pvz.v1.GetReceptionCorrectionsRequest\x1a'.pvz.v1.GetReceptionCorrectionsResponse\x12C\n" +
 	"\n" +
-	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponseB4Z2github.com/Dmitrii-Dmitrii/pvz/proto/pvz/vq;pvz_v1b\x06proto3"
+	"AddProduct\x12\x19.pvz.v1.AddProductRequest\x1a\x1a.pvz.v1.AddProductResponse\x12G\n" +
+	"\vAddProducts\x12\x19.pvz.v1.AddProductRequest\x1a\x1b.pvz.v1.AddProductsResponse(\x01\x12X\n" +
+	"\x11DeleteLastProduct\x12 .pvz.v1.DeleteLastProductRequest\x1a!.pvz.v1.DeleteLastProductResponse\x12L\n" +
+	"\rDeleteProduct\x12\x1c.pvz.v1.DeleteProductRequest\x1a\x1d.pvz.v1.DeleteProductResponse\x12O\n" +
+	"\x0eRestoreProduct\x12\x1d.pvz.v1.RestoreProductRequest\x1a\x1e.pvz.v1.RestoreProductResponse\x12^\n" +
+	"\x13GetProductByBarcode\x12\".pvz.v1.GetProductByBarcodeRequest\x1a#.pvz.v1.GetProductByBarcodeResponse\x12C\n" +
+	"\x0eWatchPVZEvents\x12\x1d.pvz.v1.WatchPVZEventsRequest\x1a\x10.pvz.v1.PVZEvent0\x01B>Z<github.com/Dmitrii-Dmitrii/pvz/proto/generated/pvz/v1;pvz_v1b\x06proto3"
 
 var (
 	file_pvz_v1_pvz_proto_rawDescOnce sync.Once
@@ -240,25 +4035,181 @@ func file_pvz_v1_pvz_proto_rawDescGZIP() []byte {
 	return file_pvz_v1_pvz_proto_rawDescData
 }
 
-var file_pvz_v1_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pvz_v1_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pvz_v1_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pvz_v1_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_pvz_v1_pvz_proto_goTypes = []any{
-	(ReceptionStatus)(0),          // 0: pvz.v1.ReceptionStatus
-	(*PVZ)(nil),                   // 1: pvz.v1.PVZ
-	(*GetPVZListRequest)(nil),     // 2: pvz.v1.GetPVZListRequest
-	(*GetPVZListResponse)(nil),    // 3: pvz.v1.GetPVZListResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(PVZStatus)(0),                          // 0: pvz.v1.PVZStatus
+	(ReceptionStatus)(0),                    // 1: pvz.v1.ReceptionStatus
+	(PVZSortBy)(0),                          // 2: pvz.v1.PVZSortBy
+	(PVZEventType)(0),                       // 3: pvz.v1.PVZEventType
+	(*PVZ)(nil),                             // 4: pvz.v1.PVZ
+	(*Reception)(nil),                       // 5: pvz.v1.Reception
+	(*ProductDimensions)(nil),               // 6: pvz.v1.ProductDimensions
+	(*Product)(nil),                         // 7: pvz.v1.Product
+	(*ReceptionWithProducts)(nil),           // 8: pvz.v1.ReceptionWithProducts
+	(*PVZWithReceptions)(nil),               // 9: pvz.v1.PVZWithReceptions
+	(*GetPVZListRequest)(nil),               // 10: pvz.v1.GetPVZListRequest
+	(*GetPVZListResponse)(nil),              // 11: pvz.v1.GetPVZListResponse
+	(*GetPVZFullInfoRequest)(nil),           // 12: pvz.v1.GetPVZFullInfoRequest
+	(*GetPVZFullInfoResponse)(nil),          // 13: pvz.v1.GetPVZFullInfoResponse
+	(*CreatePVZRequest)(nil),                // 14: pvz.v1.CreatePVZRequest
+	(*CreatePVZResponse)(nil),               // 15: pvz.v1.CreatePVZResponse
+	(*UpdatePVZRequest)(nil),                // 16: pvz.v1.UpdatePVZRequest
+	(*UpdatePVZResponse)(nil),               // 17: pvz.v1.UpdatePVZResponse
+	(*DeactivatePVZRequest)(nil),            // 18: pvz.v1.DeactivatePVZRequest
+	(*DeactivatePVZResponse)(nil),           // 19: pvz.v1.DeactivatePVZResponse
+	(*ActivatePVZRequest)(nil),              // 20: pvz.v1.ActivatePVZRequest
+	(*ActivatePVZResponse)(nil),             // 21: pvz.v1.ActivatePVZResponse
+	(*ArchivePVZRequest)(nil),               // 22: pvz.v1.ArchivePVZRequest
+	(*ArchivePVZResponse)(nil),              // 23: pvz.v1.ArchivePVZResponse
+	(*GetNearbyPVZRequest)(nil),             // 24: pvz.v1.GetNearbyPVZRequest
+	(*NearbyPVZ)(nil),                       // 25: pvz.v1.NearbyPVZ
+	(*GetNearbyPVZResponse)(nil),            // 26: pvz.v1.GetNearbyPVZResponse
+	(*ProductTypeOccupancy)(nil),            // 27: pvz.v1.ProductTypeOccupancy
+	(*PVZOccupancy)(nil),                    // 28: pvz.v1.PVZOccupancy
+	(*GetPVZOccupancyRequest)(nil),          // 29: pvz.v1.GetPVZOccupancyRequest
+	(*GetPVZOccupancyResponse)(nil),         // 30: pvz.v1.GetPVZOccupancyResponse
+	(*ProductTypeCapacity)(nil),             // 31: pvz.v1.ProductTypeCapacity
+	(*UpdatePVZCapacityRequest)(nil),        // 32: pvz.v1.UpdatePVZCapacityRequest
+	(*UpdatePVZCapacityResponse)(nil),       // 33: pvz.v1.UpdatePVZCapacityResponse
+	(*CreateReceptionRequest)(nil),          // 34: pvz.v1.CreateReceptionRequest
+	(*CreateReceptionResponse)(nil),         // 35: pvz.v1.CreateReceptionResponse
+	(*CloseLastReceptionRequest)(nil),       // 36: pvz.v1.CloseLastReceptionRequest
+	(*CloseLastReceptionResponse)(nil),      // 37: pvz.v1.CloseLastReceptionResponse
+	(*GetReceptionRequest)(nil),             // 38: pvz.v1.GetReceptionRequest
+	(*GetReceptionResponse)(nil),            // 39: pvz.v1.GetReceptionResponse
+	(*GetPVZReceptionsRequest)(nil),         // 40: pvz.v1.GetPVZReceptionsRequest
+	(*GetPVZReceptionsResponse)(nil),        // 41: pvz.v1.GetPVZReceptionsResponse
+	(*ReceptionCorrection)(nil),             // 42: pvz.v1.ReceptionCorrection
+	(*ReopenReceptionRequest)(nil),          // 43: pvz.v1.ReopenReceptionRequest
+	(*ReopenReceptionResponse)(nil),         // 44: pvz.v1.ReopenReceptionResponse
+	(*ChangeReceptionStatusRequest)(nil),    // 45: pvz.v1.ChangeReceptionStatusRequest
+	(*ChangeReceptionStatusResponse)(nil),   // 46: pvz.v1.ChangeReceptionStatusResponse
+	(*GetReceptionCorrectionsRequest)(nil),  // 47: pvz.v1.GetReceptionCorrectionsRequest
+	(*GetReceptionCorrectionsResponse)(nil), // 48: pvz.v1.GetReceptionCorrectionsResponse
+	(*AddProductRequest)(nil),               // 49: pvz.v1.AddProductRequest
+	(*AddProductResponse)(nil),              // 50: pvz.v1.AddProductResponse
+	(*ProductBatchItemResult)(nil),          // 51: pvz.v1.ProductBatchItemResult
+	(*AddProductsResponse)(nil),             // 52: pvz.v1.AddProductsResponse
+	(*DeleteLastProductRequest)(nil),        // 53: pvz.v1.DeleteLastProductRequest
+	(*DeleteLastProductResponse)(nil),       // 54: pvz.v1.DeleteLastProductResponse
+	(*DeleteProductRequest)(nil),            // 55: pvz.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),           // 56: pvz.v1.DeleteProductResponse
+	(*RestoreProductRequest)(nil),           // 57: pvz.v1.RestoreProductRequest
+	(*RestoreProductResponse)(nil),          // 58: pvz.v1.RestoreProductResponse
+	(*GetProductByBarcodeRequest)(nil),      // 59: pvz.v1.GetProductByBarcodeRequest
+	(*GetProductByBarcodeResponse)(nil),     // 60: pvz.v1.GetProductByBarcodeResponse
+	(*WatchPVZEventsRequest)(nil),           // 61: pvz.v1.WatchPVZEventsRequest
+	(*PVZEvent)(nil),                        // 62: pvz.v1.PVZEvent
+	(*timestamppb.Timestamp)(nil),           // 63: google.protobuf.Timestamp
 }
 var file_pvz_v1_pvz_proto_depIdxs = []int32{
-	4, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
-	1, // 1: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
-	2, // 2: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
-	3, // 3: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	63, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
+	0,  // 1: pvz.v1.PVZ.status:type_name -> pvz.v1.PVZStatus
+	63, // 2: pvz.v1.PVZ.archived_at:type_name -> google.protobuf.Timestamp
+	63, // 3: pvz.v1.Reception.date_time:type_name -> google.protobuf.Timestamp
+	1,  // 4: pvz.v1.Reception.status:type_name -> pvz.v1.ReceptionStatus
+	63, // 5: pvz.v1.Product.date_time:type_name -> google.protobuf.Timestamp
+	6,  // 6: pvz.v1.Product.dimensions:type_name -> pvz.v1.ProductDimensions
+	5,  // 7: pvz.v1.ReceptionWithProducts.reception:type_name -> pvz.v1.Reception
+	7,  // 8: pvz.v1.ReceptionWithProducts.products:type_name -> pvz.v1.Product
+	4,  // 9: pvz.v1.PVZWithReceptions.pvz:type_name -> pvz.v1.PVZ
+	8,  // 10: pvz.v1.PVZWithReceptions.receptions:type_name -> pvz.v1.ReceptionWithProducts
+	63, // 11: pvz.v1.PVZWithReceptions.last_activity:type_name -> google.protobuf.Timestamp
+	4,  // 12: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
+	63, // 13: pvz.v1.GetPVZFullInfoRequest.start_date:type_name -> google.protobuf.Timestamp
+	63, // 14: pvz.v1.GetPVZFullInfoRequest.end_date:type_name -> google.protobuf.Timestamp
+	1,  // 15: pvz.v1.GetPVZFullInfoRequest.reception_status:type_name -> pvz.v1.ReceptionStatus
+	2,  // 16: pvz.v1.GetPVZFullInfoRequest.sort_by:type_name -> pvz.v1.PVZSortBy
+	9,  // 17: pvz.v1.GetPVZFullInfoResponse.pvzs:type_name -> pvz.v1.PVZWithReceptions
+	63, // 18: pvz.v1.CreatePVZRequest.registration_date:type_name -> google.protobuf.Timestamp
+	4,  // 19: pvz.v1.CreatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	4,  // 20: pvz.v1.UpdatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	4,  // 21: pvz.v1.DeactivatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	4,  // 22: pvz.v1.ActivatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	4,  // 23: pvz.v1.ArchivePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	4,  // 24: pvz.v1.NearbyPVZ.pvz:type_name -> pvz.v1.PVZ
+	25, // 25: pvz.v1.GetNearbyPVZResponse.pvzs:type_name -> pvz.v1.NearbyPVZ
+	27, // 26: pvz.v1.PVZOccupancy.product_types:type_name -> pvz.v1.ProductTypeOccupancy
+	28, // 27: pvz.v1.GetPVZOccupancyResponse.occupancy:type_name -> pvz.v1.PVZOccupancy
+	31, // 28: pvz.v1.UpdatePVZCapacityRequest.product_types:type_name -> pvz.v1.ProductTypeCapacity
+	28, // 29: pvz.v1.UpdatePVZCapacityResponse.occupancy:type_name -> pvz.v1.PVZOccupancy
+	5,  // 30: pvz.v1.CreateReceptionResponse.reception:type_name -> pvz.v1.Reception
+	5,  // 31: pvz.v1.CloseLastReceptionResponse.reception:type_name -> pvz.v1.Reception
+	8,  // 32: pvz.v1.GetReceptionResponse.reception:type_name -> pvz.v1.ReceptionWithProducts
+	1,  // 33: pvz.v1.GetPVZReceptionsRequest.status:type_name -> pvz.v1.ReceptionStatus
+	63, // 34: pvz.v1.GetPVZReceptionsRequest.start_date:type_name -> google.protobuf.Timestamp
+	63, // 35: pvz.v1.GetPVZReceptionsRequest.end_date:type_name -> google.protobuf.Timestamp
+	8,  // 36: pvz.v1.GetPVZReceptionsResponse.receptions:type_name -> pvz.v1.ReceptionWithProducts
+	63, // 37: pvz.v1.ReceptionCorrection.closed_at:type_name -> google.protobuf.Timestamp
+	63, // 38: pvz.v1.ReceptionCorrection.reopened_at:type_name -> google.protobuf.Timestamp
+	5,  // 39: pvz.v1.ReopenReceptionResponse.reception:type_name -> pvz.v1.Reception
+	1,  // 40: pvz.v1.ChangeReceptionStatusRequest.status:type_name -> pvz.v1.ReceptionStatus
+	5,  // 41: pvz.v1.ChangeReceptionStatusResponse.reception:type_name -> pvz.v1.Reception
+	42, // 42: pvz.v1.GetReceptionCorrectionsResponse.corrections:type_name -> pvz.v1.ReceptionCorrection
+	6,  // 43: pvz.v1.AddProductRequest.dimensions:type_name -> pvz.v1.ProductDimensions
+	7,  // 44: pvz.v1.AddProductResponse.product:type_name -> pvz.v1.Product
+	7,  // 45: pvz.v1.ProductBatchItemResult.product:type_name -> pvz.v1.Product
+	51, // 46: pvz.v1.AddProductsResponse.items:type_name -> pvz.v1.ProductBatchItemResult
+	7,  // 47: pvz.v1.RestoreProductResponse.product:type_name -> pvz.v1.Product
+	7,  // 48: pvz.v1.GetProductByBarcodeResponse.product:type_name -> pvz.v1.Product
+	5,  // 49: pvz.v1.GetProductByBarcodeResponse.reception:type_name -> pvz.v1.Reception
+	4,  // 50: pvz.v1.GetProductByBarcodeResponse.pvz:type_name -> pvz.v1.PVZ
+	3,  // 51: pvz.v1.PVZEvent.type:type_name -> pvz.v1.PVZEventType
+	63, // 52: pvz.v1.PVZEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	10, // 53: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
+	12, // 54: pvz.v1.PVZService.GetPVZFullInfo:input_type -> pvz.v1.GetPVZFullInfoRequest
+	14, // 55: pvz.v1.PVZService.CreatePVZ:input_type -> pvz.v1.CreatePVZRequest
+	16, // 56: pvz.v1.PVZService.UpdatePVZ:input_type -> pvz.v1.UpdatePVZRequest
+	18, // 57: pvz.v1.PVZService.DeactivatePVZ:input_type -> pvz.v1.DeactivatePVZRequest
+	20, // 58: pvz.v1.PVZService.ActivatePVZ:input_type -> pvz.v1.ActivatePVZRequest
+	22, // 59: pvz.v1.PVZService.ArchivePVZ:input_type -> pvz.v1.ArchivePVZRequest
+	24, // 60: pvz.v1.PVZService.GetNearbyPVZ:input_type -> pvz.v1.GetNearbyPVZRequest
+	29, // 61: pvz.v1.PVZService.GetPVZOccupancy:input_type -> pvz.v1.GetPVZOccupancyRequest
+	32, // 62: pvz.v1.PVZService.UpdatePVZCapacity:input_type -> pvz.v1.UpdatePVZCapacityRequest
+	34, // 63: pvz.v1.PVZService.CreateReception:input_type -> pvz.v1.CreateReceptionRequest
+	36, // 64: pvz.v1.PVZService.CloseLastReception:input_type -> pvz.v1.CloseLastReceptionRequest
+	38, // 65: pvz.v1.PVZService.GetReception:input_type -> pvz.v1.GetReceptionRequest
+	40, // 66: pvz.v1.PVZService.GetPVZReceptions:input_type -> pvz.v1.GetPVZReceptionsRequest
+	43, // 67: pvz.v1.PVZService.ReopenReception:input_type -> pvz.v1.ReopenReceptionRequest
+	45, // 68: pvz.v1.PVZService.ChangeReceptionStatus:input_type -> pvz.v1.ChangeReceptionStatusRequest
+	47, // 69: pvz.v1.PVZService.GetReceptionCorrections:input_type -> pvz.v1.GetReceptionCorrectionsRequest
+	49, // 70: pvz.v1.PVZService.AddProduct:input_type -> pvz.v1.AddProductRequest
+	49, // 71: pvz.v1.PVZService.AddProducts:input_type -> pvz.v1.AddProductRequest
+	53, // 72: pvz.v1.PVZService.DeleteLastProduct:input_type -> pvz.v1.DeleteLastProductRequest
+	55, // 73: pvz.v1.PVZService.DeleteProduct:input_type -> pvz.v1.DeleteProductRequest
+	57, // 74: pvz.v1.PVZService.RestoreProduct:input_type -> pvz.v1.RestoreProductRequest
+	59, // 75: pvz.v1.PVZService.GetProductByBarcode:input_type -> pvz.v1.GetProductByBarcodeRequest
+	61, // 76: pvz.v1.PVZService.WatchPVZEvents:input_type -> pvz.v1.WatchPVZEventsRequest
+	11, // 77: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
+	13, // 78: pvz.v1.PVZService.GetPVZFullInfo:output_type -> pvz.v1.GetPVZFullInfoResponse
+	15, // 79: pvz.v1.PVZService.CreatePVZ:output_type -> pvz.v1.CreatePVZResponse
+	17, // 80: pvz.v1.PVZService.UpdatePVZ:output_type -> pvz.v1.UpdatePVZResponse
+	19, // 81: pvz.v1.PVZService.DeactivatePVZ:output_type -> pvz.v1.DeactivatePVZResponse
+	21, // 82: pvz.v1.PVZService.ActivatePVZ:output_type -> pvz.v1.ActivatePVZResponse
+	23, // 83: pvz.v1.PVZService.ArchivePVZ:output_type -> pvz.v1.ArchivePVZResponse
+	26, // 84: pvz.v1.PVZService.GetNearbyPVZ:output_type -> pvz.v1.GetNearbyPVZResponse
+	30, // 85: pvz.v1.PVZService.GetPVZOccupancy:output_type -> pvz.v1.GetPVZOccupancyResponse
+	33, // 86: pvz.v1.PVZService.UpdatePVZCapacity:output_type -> pvz.v1.UpdatePVZCapacityResponse
+	35, // 87: pvz.v1.PVZService.CreateReception:output_type -> pvz.v1.CreateReceptionResponse
+	37, // 88: pvz.v1.PVZService.CloseLastReception:output_type -> pvz.v1.CloseLastReceptionResponse
+	39, // 89: pvz.v1.PVZService.GetReception:output_type -> pvz.v1.GetReceptionResponse
+	41, // 90: pvz.v1.PVZService.GetPVZReceptions:output_type -> pvz.v1.GetPVZReceptionsResponse
+	44, // 91: pvz.v1.PVZService.ReopenReception:output_type -> pvz.v1.ReopenReceptionResponse
+	46, // 92: pvz.v1.PVZService.ChangeReceptionStatus:output_type -> pvz.v1.ChangeReceptionStatusResponse
+	48, // 93: pvz.v1.PVZService.GetReceptionCorrections:output_type -> pvz.v1.GetReceptionCorrectionsResponse
+	50, // 94: pvz.v1.PVZService.AddProduct:output_type -> pvz.v1.AddProductResponse
+	52, // 95: pvz.v1.PVZService.AddProducts:output_type -> pvz.v1.AddProductsResponse
+	54, // 96: pvz.v1.PVZService.DeleteLastProduct:output_type -> pvz.v1.DeleteLastProductResponse
+	56, // 97: pvz.v1.PVZService.DeleteProduct:output_type -> pvz.v1.DeleteProductResponse
+	58, // 98: pvz.v1.PVZService.RestoreProduct:output_type -> pvz.v1.RestoreProductResponse
+	60, // 99: pvz.v1.PVZService.GetProductByBarcode:output_type -> pvz.v1.GetProductByBarcodeResponse
+	62, // 100: pvz.v1.PVZService.WatchPVZEvents:output_type -> pvz.v1.PVZEvent
+	77, // [77:101] is the sub-list for method output_type
+	53, // [53:77] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_pvz_v1_pvz_proto_init() }
@@ -266,13 +4217,26 @@ func file_pvz_v1_pvz_proto_init() {
 	if File_pvz_v1_pvz_proto != nil {
 		return
 	}
+	file_pvz_v1_pvz_proto_msgTypes[0].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[1].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[3].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[6].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[8].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[10].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[12].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[20].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[23].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[24].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[36].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[41].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[45].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pvz_v1_pvz_proto_rawDesc), len(file_pvz_v1_pvz_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      4,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PVZService_GetPVZList_FullMethodName = "/pvz.v1.PVZService/GetPVZList"
+	PVZService_GetPVZList_FullMethodName              = "/pvz.v1.PVZService/GetPVZList"
+	PVZService_GetPVZFullInfo_FullMethodName          = "/pvz.v1.PVZService/GetPVZFullInfo"
+	PVZService_CreatePVZ_FullMethodName               = "/pvz.v1.PVZService/CreatePVZ"
+	PVZService_UpdatePVZ_FullMethodName               = "/pvz.v1.PVZService/UpdatePVZ"
+	PVZService_DeactivatePVZ_FullMethodName           = "/pvz.v1.PVZService/DeactivatePVZ"
+	PVZService_ActivatePVZ_FullMethodName             = "/pvz.v1.PVZService/ActivatePVZ"
+	PVZService_ArchivePVZ_FullMethodName              = "/pvz.v1.PVZService/ArchivePVZ"
+	PVZService_GetNearbyPVZ_FullMethodName            = "/pvz.v1.PVZService/GetNearbyPVZ"
+	PVZService_GetPVZOccupancy_FullMethodName         = "/pvz.v1.PVZService/GetPVZOccupancy"
+	PVZService_UpdatePVZCapacity_FullMethodName       = "/pvz.v1.PVZService/UpdatePVZCapacity"
+	PVZService_CreateReception_FullMethodName         = "/pvz.v1.PVZService/CreateReception"
+	PVZService_CloseLastReception_FullMethodName      = "/pvz.v1.PVZService/CloseLastReception"
+	PVZService_GetReception_FullMethodName            = "/pvz.v1.PVZService/GetReception"
+	PVZService_GetPVZReceptions_FullMethodName        = "/pvz.v1.PVZService/GetPVZReceptions"
+	PVZService_ReopenReception_FullMethodName         = "/pvz.v1.PVZService/ReopenReception"
+	PVZService_ChangeReceptionStatus_FullMethodName   = "/pvz.v1.PVZService/ChangeReceptionStatus"
+	PVZService_GetReceptionCorrections_FullMethodName = "/pvz.v1.PVZService/GetReceptionCorrections"
+	PVZService_AddProduct_FullMethodName              = "/pvz.v1.PVZService/AddProduct"
+	PVZService_AddProducts_FullMethodName             = "/pvz.v1.PVZService/AddProducts"
+	PVZService_DeleteLastProduct_FullMethodName       = "/pvz.v1.PVZService/DeleteLastProduct"
+	PVZService_DeleteProduct_FullMethodName           = "/pvz.v1.PVZService/DeleteProduct"
+	PVZService_RestoreProduct_FullMethodName          = "/pvz.v1.PVZService/RestoreProduct"
+	PVZService_GetProductByBarcode_FullMethodName     = "/pvz.v1.PVZService/GetProductByBarcode"
+	PVZService_WatchPVZEvents_FullMethodName          = "/pvz.v1.PVZService/WatchPVZEvents"
 )
 
 // PVZServiceClient is the client API for PVZService service.
@@ -27,6 +50,29 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PVZServiceClient interface {
 	GetPVZList(ctx context.Context, in *GetPVZListRequest, opts ...grpc.CallOption) (*GetPVZListResponse, error)
+	GetPVZFullInfo(ctx context.Context, in *GetPVZFullInfoRequest, opts ...grpc.CallOption) (*GetPVZFullInfoResponse, error)
+	CreatePVZ(ctx context.Context, in *CreatePVZRequest, opts ...grpc.CallOption) (*CreatePVZResponse, error)
+	UpdatePVZ(ctx context.Context, in *UpdatePVZRequest, opts ...grpc.CallOption) (*UpdatePVZResponse, error)
+	DeactivatePVZ(ctx context.Context, in *DeactivatePVZRequest, opts ...grpc.CallOption) (*DeactivatePVZResponse, error)
+	ActivatePVZ(ctx context.Context, in *ActivatePVZRequest, opts ...grpc.CallOption) (*ActivatePVZResponse, error)
+	ArchivePVZ(ctx context.Context, in *ArchivePVZRequest, opts ...grpc.CallOption) (*ArchivePVZResponse, error)
+	GetNearbyPVZ(ctx context.Context, in *GetNearbyPVZRequest, opts ...grpc.CallOption) (*GetNearbyPVZResponse, error)
+	GetPVZOccupancy(ctx context.Context, in *GetPVZOccupancyRequest, opts ...grpc.CallOption) (*GetPVZOccupancyResponse, error)
+	UpdatePVZCapacity(ctx context.Context, in *UpdatePVZCapacityRequest, opts ...grpc.CallOption) (*UpdatePVZCapacityResponse, error)
+	CreateReception(ctx context.Context, in *CreateReceptionRequest, opts ...grpc.CallOption) (*CreateReceptionResponse, error)
+	CloseLastReception(ctx context.Context, in *CloseLastReceptionRequest, opts ...grpc.CallOption) (*CloseLastReceptionResponse, error)
+	GetReception(ctx context.Context, in *GetReceptionRequest, opts ...grpc.CallOption) (*GetReceptionResponse, error)
+	GetPVZReceptions(ctx context.Context, in *GetPVZReceptionsRequest, opts ...grpc.CallOption) (*GetPVZReceptionsResponse, error)
+	ReopenReception(ctx context.Context, in *ReopenReceptionRequest, opts ...grpc.CallOption) (*ReopenReceptionResponse, error)
+	ChangeReceptionStatus(ctx context.Context, in *ChangeReceptionStatusRequest, opts ...grpc.CallOption) (*ChangeReceptionStatusResponse, error)
+	GetReceptionCorrections(ctx context.Context, in *GetReceptionCorrectionsRequest, opts ...grpc.CallOption) (*GetReceptionCorrectionsResponse, error)
+	AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*AddProductResponse, error)
+	AddProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddProductRequest, AddProductsResponse], error)
+	DeleteLastProduct(ctx context.Context, in *DeleteLastProductRequest, opts ...grpc.CallOption) (*DeleteLastProductResponse, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error)
+	GetProductByBarcode(ctx context.Context, in *GetProductByBarcodeRequest, opts ...grpc.CallOption) (*GetProductByBarcodeResponse, error)
+	WatchPVZEvents(ctx context.Context, in *WatchPVZEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PVZEvent], error)
 }
 
 type pVZServiceClient struct {
@@ -47,11 +93,276 @@ func (c *pVZServiceClient) GetPVZList(ctx context.Context, in *GetPVZListRequest
 	return out, nil
 }
 
+func (c *pVZServiceClient) GetPVZFullInfo(ctx context.Context, in *GetPVZFullInfoRequest, opts ...grpc.CallOption) (*GetPVZFullInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPVZFullInfoResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetPVZFullInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreatePVZ(ctx context.Context, in *CreatePVZRequest, opts ...grpc.CallOption) (*CreatePVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreatePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) UpdatePVZ(ctx context.Context, in *UpdatePVZRequest, opts ...grpc.CallOption) (*UpdatePVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_UpdatePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) DeactivatePVZ(ctx context.Context, in *DeactivatePVZRequest, opts ...grpc.CallOption) (*DeactivatePVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivatePVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeactivatePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ActivatePVZ(ctx context.Context, in *ActivatePVZRequest, opts ...grpc.CallOption) (*ActivatePVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivatePVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_ActivatePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ArchivePVZ(ctx context.Context, in *ArchivePVZRequest, opts ...grpc.CallOption) (*ArchivePVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivePVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_ArchivePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetNearbyPVZ(ctx context.Context, in *GetNearbyPVZRequest, opts ...grpc.CallOption) (*GetNearbyPVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNearbyPVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetNearbyPVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetPVZOccupancy(ctx context.Context, in *GetPVZOccupancyRequest, opts ...grpc.CallOption) (*GetPVZOccupancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPVZOccupancyResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetPVZOccupancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) UpdatePVZCapacity(ctx context.Context, in *UpdatePVZCapacityRequest, opts ...grpc.CallOption) (*UpdatePVZCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePVZCapacityResponse)
+	err := c.cc.Invoke(ctx, PVZService_UpdatePVZCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreateReception(ctx context.Context, in *CreateReceptionRequest, opts ...grpc.CallOption) (*CreateReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreateReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CloseLastReception(ctx context.Context, in *CloseLastReceptionRequest, opts ...grpc.CallOption) (*CloseLastReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseLastReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_CloseLastReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetReception(ctx context.Context, in *GetReceptionRequest, opts ...grpc.CallOption) (*GetReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetPVZReceptions(ctx context.Context, in *GetPVZReceptionsRequest, opts ...grpc.CallOption) (*GetPVZReceptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPVZReceptionsResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetPVZReceptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ReopenReception(ctx context.Context, in *ReopenReceptionRequest, opts ...grpc.CallOption) (*ReopenReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_ReopenReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ChangeReceptionStatus(ctx context.Context, in *ChangeReceptionStatusRequest, opts ...grpc.CallOption) (*ChangeReceptionStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeReceptionStatusResponse)
+	err := c.cc.Invoke(ctx, PVZService_ChangeReceptionStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetReceptionCorrections(ctx context.Context, in *GetReceptionCorrectionsRequest, opts ...grpc.CallOption) (*GetReceptionCorrectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceptionCorrectionsResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetReceptionCorrections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*AddProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_AddProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AddProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddProductRequest, AddProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PVZService_ServiceDesc.Streams[0], PVZService_AddProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddProductRequest, AddProductsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_AddProductsClient = grpc.ClientStreamingClient[AddProductRequest, AddProductsResponse]
+
+func (c *pVZServiceClient) DeleteLastProduct(ctx context.Context, in *DeleteLastProductRequest, opts ...grpc.CallOption) (*DeleteLastProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLastProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeleteLastProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_RestoreProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetProductByBarcode(ctx context.Context, in *GetProductByBarcodeRequest, opts ...grpc.CallOption) (*GetProductByBarcodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductByBarcodeResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetProductByBarcode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) WatchPVZEvents(ctx context.Context, in *WatchPVZEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PVZEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PVZService_ServiceDesc.Streams[1], PVZService_WatchPVZEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPVZEventsRequest, PVZEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_WatchPVZEventsClient = grpc.ServerStreamingClient[PVZEvent]
+
 // PVZServiceServer is the server API for PVZService service.
 // All implementations must embed UnimplementedPVZServiceServer
 // for forward compatibility.
 type PVZServiceServer interface {
 	GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error)
+	GetPVZFullInfo(context.Context, *GetPVZFullInfoRequest) (*GetPVZFullInfoResponse, error)
+	CreatePVZ(context.Context, *CreatePVZRequest) (*CreatePVZResponse, error)
+	UpdatePVZ(context.Context, *UpdatePVZRequest) (*UpdatePVZResponse, error)
+	DeactivatePVZ(context.Context, *DeactivatePVZRequest) (*DeactivatePVZResponse, error)
+	ActivatePVZ(context.Context, *ActivatePVZRequest) (*ActivatePVZResponse, error)
+	ArchivePVZ(context.Context, *ArchivePVZRequest) (*ArchivePVZResponse, error)
+	GetNearbyPVZ(context.Context, *GetNearbyPVZRequest) (*GetNearbyPVZResponse, error)
+	GetPVZOccupancy(context.Context, *GetPVZOccupancyRequest) (*GetPVZOccupancyResponse, error)
+	UpdatePVZCapacity(context.Context, *UpdatePVZCapacityRequest) (*UpdatePVZCapacityResponse, error)
+	CreateReception(context.Context, *CreateReceptionRequest) (*CreateReceptionResponse, error)
+	CloseLastReception(context.Context, *CloseLastReceptionRequest) (*CloseLastReceptionResponse, error)
+	GetReception(context.Context, *GetReceptionRequest) (*GetReceptionResponse, error)
+	GetPVZReceptions(context.Context, *GetPVZReceptionsRequest) (*GetPVZReceptionsResponse, error)
+	ReopenReception(context.Context, *ReopenReceptionRequest) (*ReopenReceptionResponse, error)
+	ChangeReceptionStatus(context.Context, *ChangeReceptionStatusRequest) (*ChangeReceptionStatusResponse, error)
+	GetReceptionCorrections(context.Context, *GetReceptionCorrectionsRequest) (*GetReceptionCorrectionsResponse, error)
+	AddProduct(context.Context, *AddProductRequest) (*AddProductResponse, error)
+	AddProducts(grpc.ClientStreamingServer[AddProductRequest, AddProductsResponse]) error
+	DeleteLastProduct(context.Context, *DeleteLastProductRequest) (*DeleteLastProductResponse, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductResponse, error)
+	GetProductByBarcode(context.Context, *GetProductByBarcodeRequest) (*GetProductByBarcodeResponse, error)
+	WatchPVZEvents(*WatchPVZEventsRequest, grpc.ServerStreamingServer[PVZEvent]) error
 	mustEmbedUnimplementedPVZServiceServer()
 }
 
@@ -65,6 +376,75 @@ type UnimplementedPVZServiceServer struct{}
 func (UnimplementedPVZServiceServer) GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPVZList not implemented")
 }
+func (UnimplementedPVZServiceServer) GetPVZFullInfo(context.Context, *GetPVZFullInfoRequest) (*GetPVZFullInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPVZFullInfo not implemented")
+}
+func (UnimplementedPVZServiceServer) CreatePVZ(context.Context, *CreatePVZRequest) (*CreatePVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) UpdatePVZ(context.Context, *UpdatePVZRequest) (*UpdatePVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) DeactivatePVZ(context.Context, *DeactivatePVZRequest) (*DeactivatePVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivatePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) ActivatePVZ(context.Context, *ActivatePVZRequest) (*ActivatePVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivatePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) ArchivePVZ(context.Context, *ArchivePVZRequest) (*ArchivePVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) GetNearbyPVZ(context.Context, *GetNearbyPVZRequest) (*GetNearbyPVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNearbyPVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) GetPVZOccupancy(context.Context, *GetPVZOccupancyRequest) (*GetPVZOccupancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPVZOccupancy not implemented")
+}
+func (UnimplementedPVZServiceServer) UpdatePVZCapacity(context.Context, *UpdatePVZCapacityRequest) (*UpdatePVZCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePVZCapacity not implemented")
+}
+func (UnimplementedPVZServiceServer) CreateReception(context.Context, *CreateReceptionRequest) (*CreateReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReception not implemented")
+}
+func (UnimplementedPVZServiceServer) CloseLastReception(context.Context, *CloseLastReceptionRequest) (*CloseLastReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLastReception not implemented")
+}
+func (UnimplementedPVZServiceServer) GetReception(context.Context, *GetReceptionRequest) (*GetReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReception not implemented")
+}
+func (UnimplementedPVZServiceServer) GetPVZReceptions(context.Context, *GetPVZReceptionsRequest) (*GetPVZReceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPVZReceptions not implemented")
+}
+func (UnimplementedPVZServiceServer) ReopenReception(context.Context, *ReopenReceptionRequest) (*ReopenReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenReception not implemented")
+}
+func (UnimplementedPVZServiceServer) ChangeReceptionStatus(context.Context, *ChangeReceptionStatusRequest) (*ChangeReceptionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeReceptionStatus not implemented")
+}
+func (UnimplementedPVZServiceServer) GetReceptionCorrections(context.Context, *GetReceptionCorrectionsRequest) (*GetReceptionCorrectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceptionCorrections not implemented")
+}
+func (UnimplementedPVZServiceServer) AddProduct(context.Context, *AddProductRequest) (*AddProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) AddProducts(grpc.ClientStreamingServer[AddProductRequest, AddProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AddProducts not implemented")
+}
+func (UnimplementedPVZServiceServer) DeleteLastProduct(context.Context, *DeleteLastProductRequest) (*DeleteLastProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLastProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) GetProductByBarcode(context.Context, *GetProductByBarcodeRequest) (*GetProductByBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductByBarcode not implemented")
+}
+func (UnimplementedPVZServiceServer) WatchPVZEvents(*WatchPVZEventsRequest, grpc.ServerStreamingServer[PVZEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPVZEvents not implemented")
+}
 func (UnimplementedPVZServiceServer) mustEmbedUnimplementedPVZServiceServer() {}
 func (UnimplementedPVZServiceServer) testEmbeddedByValue()                    {}
 
@@ -104,18 +484,509 @@ func _PVZService_GetPVZList_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-// PVZService_ServiceDesc is the grpc.ServiceDesc for PVZService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var PVZService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "pvz.v1.PVZService",
-	HandlerType: (*PVZServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetPVZList",
-			Handler:    _PVZService_GetPVZList_Handler,
+func _PVZService_GetPVZFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPVZFullInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetPVZFullInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetPVZFullInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetPVZFullInfo(ctx, req.(*GetPVZFullInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CreatePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreatePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreatePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreatePVZ(ctx, req.(*CreatePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_UpdatePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).UpdatePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_UpdatePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).UpdatePVZ(ctx, req.(*UpdatePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_DeactivatePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivatePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeactivatePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeactivatePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeactivatePVZ(ctx, req.(*DeactivatePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ActivatePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivatePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ActivatePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ActivatePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ActivatePVZ(ctx, req.(*ActivatePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ArchivePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ArchivePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ArchivePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ArchivePVZ(ctx, req.(*ArchivePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetNearbyPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNearbyPVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetNearbyPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetNearbyPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetNearbyPVZ(ctx, req.(*GetNearbyPVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetPVZOccupancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPVZOccupancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetPVZOccupancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetPVZOccupancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetPVZOccupancy(ctx, req.(*GetPVZOccupancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_UpdatePVZCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePVZCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).UpdatePVZCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_UpdatePVZCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).UpdatePVZCapacity(ctx, req.(*UpdatePVZCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CreateReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreateReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreateReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreateReception(ctx, req.(*CreateReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CloseLastReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseLastReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CloseLastReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CloseLastReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CloseLastReception(ctx, req.(*CloseLastReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetReception(ctx, req.(*GetReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetPVZReceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPVZReceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetPVZReceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetPVZReceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetPVZReceptions(ctx, req.(*GetPVZReceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ReopenReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ReopenReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ReopenReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ReopenReception(ctx, req.(*ReopenReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ChangeReceptionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeReceptionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ChangeReceptionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ChangeReceptionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ChangeReceptionStatus(ctx, req.(*ChangeReceptionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetReceptionCorrections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceptionCorrectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetReceptionCorrections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetReceptionCorrections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetReceptionCorrections(ctx, req.(*GetReceptionCorrectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AddProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).AddProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_AddProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).AddProduct(ctx, req.(*AddProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AddProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PVZServiceServer).AddProducts(&grpc.GenericServerStream[AddProductRequest, AddProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_AddProductsServer = grpc.ClientStreamingServer[AddProductRequest, AddProductsResponse]
+
+func _PVZService_DeleteLastProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLastProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeleteLastProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeleteLastProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeleteLastProduct(ctx, req.(*DeleteLastProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).RestoreProduct(ctx, req.(*RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetProductByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetProductByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetProductByBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetProductByBarcode(ctx, req.(*GetProductByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_WatchPVZEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPVZEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PVZServiceServer).WatchPVZEvents(m, &grpc.GenericServerStream[WatchPVZEventsRequest, PVZEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_WatchPVZEventsServer = grpc.ServerStreamingServer[PVZEvent]
+
+// PVZService_ServiceDesc is the grpc.ServiceDesc for PVZService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PVZService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pvz.v1.PVZService",
+	HandlerType: (*PVZServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPVZList",
+			Handler:    _PVZService_GetPVZList_Handler,
+		},
+		{
+			MethodName: "GetPVZFullInfo",
+			Handler:    _PVZService_GetPVZFullInfo_Handler,
+		},
+		{
+			MethodName: "CreatePVZ",
+			Handler:    _PVZService_CreatePVZ_Handler,
+		},
+		{
+			MethodName: "UpdatePVZ",
+			Handler:    _PVZService_UpdatePVZ_Handler,
+		},
+		{
+			MethodName: "DeactivatePVZ",
+			Handler:    _PVZService_DeactivatePVZ_Handler,
+		},
+		{
+			MethodName: "ActivatePVZ",
+			Handler:    _PVZService_ActivatePVZ_Handler,
+		},
+		{
+			MethodName: "ArchivePVZ",
+			Handler:    _PVZService_ArchivePVZ_Handler,
+		},
+		{
+			MethodName: "GetNearbyPVZ",
+			Handler:    _PVZService_GetNearbyPVZ_Handler,
+		},
+		{
+			MethodName: "GetPVZOccupancy",
+			Handler:    _PVZService_GetPVZOccupancy_Handler,
+		},
+		{
+			MethodName: "UpdatePVZCapacity",
+			Handler:    _PVZService_UpdatePVZCapacity_Handler,
+		},
+		{
+			MethodName: "CreateReception",
+			Handler:    _PVZService_CreateReception_Handler,
+		},
+		{
+			MethodName: "CloseLastReception",
+			Handler:    _PVZService_CloseLastReception_Handler,
+		},
+		{
+			MethodName: "GetReception",
+			Handler:    _PVZService_GetReception_Handler,
+		},
+		{
+			MethodName: "GetPVZReceptions",
+			Handler:    _PVZService_GetPVZReceptions_Handler,
+		},
+		{
+			MethodName: "ReopenReception",
+			Handler:    _PVZService_ReopenReception_Handler,
+		},
+		{
+			MethodName: "ChangeReceptionStatus",
+			Handler:    _PVZService_ChangeReceptionStatus_Handler,
+		},
+		{
+			MethodName: "GetReceptionCorrections",
+			Handler:    _PVZService_GetReceptionCorrections_Handler,
+		},
+		{
+			MethodName: "AddProduct",
+			Handler:    _PVZService_AddProduct_Handler,
+		},
+		{
+			MethodName: "DeleteLastProduct",
+			Handler:    _PVZService_DeleteLastProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _PVZService_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _PVZService_RestoreProduct_Handler,
+		},
+		{
+			MethodName: "GetProductByBarcode",
+			Handler:    _PVZService_GetProductByBarcode_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AddProducts",
+			Handler:       _PVZService_AddProducts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchPVZEvents",
+			Handler:       _PVZService_WatchPVZEvents_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pvz/v1/pvz.proto",
 }
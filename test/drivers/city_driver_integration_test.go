@@ -0,0 +1,42 @@
+package drivers
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/city_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/city_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCityIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := city_driver.NewCityDriver(pool)
+	ctx := context.Background()
+
+	city := &city_model.City{Name: "Новосибирск", Region: "Новосибирская область", Timezone: "Asia/Novosibirsk", CreatedAt: time.Now()}
+	require.NoError(t, driver.CreateCity(ctx, city))
+	assert.Equal(t, custom_errors.ErrCityExists, driver.CreateCity(ctx, city))
+
+	cities, err := driver.GetCities(ctx)
+	require.NoError(t, err)
+	assert.Len(t, cities, 4)
+
+	city.Region = "НСО"
+	require.NoError(t, driver.UpdateCity(ctx, city))
+
+	dbCity, err := driver.GetCityByName(ctx, city.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "НСО", dbCity.Region)
+
+	_, err = pool.Exec(ctx, queryCreatePvz, uuid.New(), time.Now(), city.Name)
+	require.NoError(t, err)
+
+	assert.Equal(t, custom_errors.ErrCityInUse, driver.DeleteCity(ctx, city.Name))
+	assert.Equal(t, custom_errors.ErrCityNotFound, driver.DeleteCity(ctx, "Владивосток"))
+}
@@ -0,0 +1,151 @@
+package drivers
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/city_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/city_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateCity(t *testing.T) {
+	ctx := context.Background()
+	city := &city_model.City{Name: "Новосибирск", Region: "Новосибирская область", Timezone: "Asia/Novosibirsk", CreatedAt: time.Now()}
+	params := []interface{}{city.Name, city.Region, city.Timezone, city.ReceptionAutoCloseHours, city.CreatedAt}
+
+	t.Run("Create city", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := city_driver.NewCityDriver(mockAdapter)
+
+		mockAdapter.On("Exec", ctx, drivers.QueryCreateCity, params).Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+
+		err := driver.CreateCity(ctx, city)
+
+		assert.NoError(t, err)
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Create existing city", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := city_driver.NewCityDriver(mockAdapter)
+
+		mockAdapter.On("Exec", ctx, drivers.QueryCreateCity, params).
+			Return(pgconn.CommandTag{}, &pgconn.PgError{Code: drivers.UniqueViolationCode})
+
+		err := driver.CreateCity(ctx, city)
+
+		assert.Equal(t, custom_errors.ErrCityExists, err)
+		mockAdapter.AssertExpectations(t)
+	})
+}
+
+func TestGetCities(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	mockRows := new(MockRows)
+	driver := city_driver.NewCityDriver(mockAdapter)
+
+	createdAt := time.Now()
+	mockAdapter.On("Query", ctx, drivers.QueryGetCities).Return(mockRows, nil)
+	mockRows.On("Next").Return(true).Once()
+	mockRows.On("Next").Return(false).Once()
+	autoCloseHours := 12
+	mockRows.On("Scan", mock.AnythingOfType("*string"), mock.AnythingOfType("*string"), mock.AnythingOfType("*string"),
+		mock.AnythingOfType("**int"), mock.AnythingOfType("*time.Time")).
+		Run(func(args mock.Arguments) {
+			*(args.Get(0).(*string)) = "Казань"
+			*(args.Get(1).(*string)) = "Республика Татарстан"
+			*(args.Get(2).(*string)) = "Europe/Moscow"
+			*(args.Get(3).(**int)) = &autoCloseHours
+			*(args.Get(4).(*time.Time)) = createdAt
+		}).
+		Return(nil)
+	mockRows.On("Close").Return()
+	mockRows.On("Err").Return(nil)
+
+	cities, err := driver.GetCities(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []city_model.City{{Name: "Казань", Region: "Республика Татарстан", Timezone: "Europe/Moscow",
+		ReceptionAutoCloseHours: &autoCloseHours, CreatedAt: createdAt}}, cities)
+	mockAdapter.AssertExpectations(t)
+	mockRows.AssertExpectations(t)
+}
+
+func TestGetCityByName(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get missing city", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		driver := city_driver.NewCityDriver(mockAdapter)
+
+		mockAdapter.On("QueryRow", ctx, drivers.QueryGetCityByName, []interface{}{"Владивосток"}).Return(mockRow)
+		mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+
+		city, err := driver.GetCityByName(ctx, "Владивосток")
+
+		assert.Nil(t, city)
+		assert.Equal(t, custom_errors.ErrCityNotFound, err)
+		mockAdapter.AssertExpectations(t)
+	})
+}
+
+func TestUpdateCity(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	mockRow := new(MockRow)
+	driver := city_driver.NewCityDriver(mockAdapter)
+
+	createdAt := time.Now()
+	autoCloseHours := 36
+	city := &city_model.City{Name: "Казань", Region: "Татарстан", Timezone: "Europe/Moscow", ReceptionAutoCloseHours: &autoCloseHours}
+	mockAdapter.On("QueryRow", ctx, drivers.QueryUpdateCity, []interface{}{city.Name, city.Region, city.Timezone, city.ReceptionAutoCloseHours}).Return(mockRow)
+	mockRow.On("Scan", mock.AnythingOfType("*time.Time")).
+		Run(func(args mock.Arguments) {
+			*(args.Get(0).(*time.Time)) = createdAt
+		}).
+		Return(nil)
+
+	err := driver.UpdateCity(ctx, city)
+
+	require.NoError(t, err)
+	assert.Equal(t, createdAt, city.CreatedAt)
+	mockAdapter.AssertExpectations(t)
+}
+
+func TestDeleteCity(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name     string
+		tag      pgconn.CommandTag
+		err      error
+		expected error
+	}{
+		{"Delete city", pgconn.NewCommandTag("DELETE 1"), nil, nil},
+		{"Delete missing city", pgconn.NewCommandTag("DELETE 0"), nil, custom_errors.ErrCityNotFound},
+		{"Delete city used by pvz", pgconn.CommandTag{}, &pgconn.PgError{Code: drivers.ForeignKeyViolationCode}, custom_errors.ErrCityInUse},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockAdapter := new(MockAdapter)
+			driver := city_driver.NewCityDriver(mockAdapter)
+
+			mockAdapter.On("Exec", ctx, drivers.QueryDeleteCity, []interface{}{"Казань"}).Return(tc.tag, tc.err)
+
+			err := driver.DeleteCity(ctx, "Казань")
+
+			assert.Equal(t, tc.expected, err)
+			mockAdapter.AssertExpectations(t)
+		})
+	}
+}
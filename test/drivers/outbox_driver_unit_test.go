@@ -0,0 +1,121 @@
+package drivers
+
+import (
+	"context"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/outbox_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/outbox_model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestClaimPendingEvents(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Claim pending events", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRows := new(MockRows)
+		driver := outbox_driver.NewOutboxDriver(mockAdapter)
+
+		now := time.Now()
+		leaseUntil := now.Add(time.Minute)
+		firstId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		secondId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		mockAdapter.On("Query", ctx, drivers.QueryClaimOutboxEvents, []interface{}{10, leaseUntil, now}).
+			Return(mockRows, nil)
+		mockRows.On("Next").Return(true).Twice()
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*pgtype.UUID) = secondId
+				*args.Get(1).(*string) = "product_added"
+				*args.Get(3).(*time.Time) = now.Add(time.Second)
+				*args.Get(4).(*int) = 2
+			}).Return(nil).Once()
+		mockRows.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*pgtype.UUID) = firstId
+				*args.Get(1).(*string) = "reception_closed"
+				*args.Get(3).(*time.Time) = now
+				*args.Get(4).(*int) = 1
+			}).Return(nil).Once()
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
+
+		events, err := driver.ClaimPendingEvents(ctx, 10, now, leaseUntil)
+
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, firstId, events[0].Id)
+		assert.Equal(t, secondId, events[1].Id)
+		assert.Equal(t, 2, events[1].Attempts)
+		assert.Equal(t, outbox_model.Pending, events[0].Status)
+		mockAdapter.AssertExpectations(t)
+		mockRows.AssertExpectations(t)
+	})
+
+	t.Run("Claim pending events with error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := outbox_driver.NewOutboxDriver(mockAdapter)
+
+		now := time.Now()
+		leaseUntil := now.Add(time.Minute)
+
+		mockAdapter.On("Query", ctx, drivers.QueryClaimOutboxEvents, []interface{}{10, leaseUntil, now}).
+			Return(new(MockRows), errors.New("db error"))
+
+		events, err := driver.ClaimPendingEvents(ctx, 10, now, leaseUntil)
+
+		assert.Nil(t, events)
+		assert.Equal(t, custom_errors.ErrClaimOutboxEvents, err)
+		mockAdapter.AssertExpectations(t)
+	})
+}
+
+func TestMarkPublished(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := outbox_driver.NewOutboxDriver(mockAdapter)
+
+	id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	publishedAt := time.Now()
+
+	mockAdapter.On("Exec", ctx, drivers.QueryMarkOutboxEventPublished, []interface{}{id, publishedAt}).
+		Return(pgconn.CommandTag{}, nil)
+
+	err := driver.MarkPublished(ctx, id, publishedAt)
+
+	assert.NoError(t, err)
+	mockAdapter.AssertExpectations(t)
+}
+
+func TestMarkFailed(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := outbox_driver.NewOutboxDriver(mockAdapter)
+
+	event := &outbox_model.OutboxEvent{
+		Id:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		Status:        outbox_model.Failed,
+		NextAttemptAt: time.Now(),
+		LastError:     "sink error",
+	}
+
+	params := []interface{}{event.Id, event.Status, event.NextAttemptAt, event.LastError}
+	mockAdapter.On("Exec", ctx, drivers.QueryMarkOutboxEventFailed, params).
+		Return(pgconn.CommandTag{}, errors.New("db error"))
+
+	err := driver.MarkFailed(ctx, event)
+
+	assert.Equal(t, custom_errors.ErrUpdateOutboxEvent, err)
+	mockAdapter.AssertExpectations(t)
+}
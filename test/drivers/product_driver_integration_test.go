@@ -2,13 +2,12 @@ package drivers
 
 import (
 	"context"
-	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/product_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/pvz_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/reception_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,7 +38,7 @@ func TestCreateProductIntegration(t *testing.T) {
 		ProductType: productType,
 	}
 
-	result, err := driver.CreateProduct(ctx, product, pvzIds[0])
+	result, err := driver.CreateProduct(ctx, product, pvzIds[0], nil)
 	require.NoError(t, err)
 	assert.Equal(t, receptionIds[0], *result)
 
@@ -79,33 +78,260 @@ func TestDeleteLastProductIntegration(t *testing.T) {
 			ProductType: productType,
 		}
 
-		_, err := productDriver.CreateProduct(ctx, product, pvzIds[0])
+		_, err := productDriver.CreateProduct(ctx, product, pvzIds[0], nil)
 
-		err = productDriver.DeleteLastProduct(ctx, pvzIds[0])
+		deletedProduct, err := productDriver.DeleteLastProduct(ctx, pvzIds[0], pgtype.UUID{})
 		require.NoError(t, err)
+		assert.Equal(t, id, deletedProduct.Id)
 
-		var dbTime time.Time
-		var dbReceptionId pgtype.UUID
-		var dbProductType string
-		err = pool.QueryRow(ctx, queryGetProduct, id).Scan(&dbTime, &dbProductType, &dbReceptionId)
+		var deletedAt *time.Time
+		var deletedBy pgtype.UUID
+		err = pool.QueryRow(ctx, queryGetProductDeletion, id).Scan(&deletedAt, &deletedBy)
 
-		assert.True(t, errors.Is(err, pgx.ErrNoRows))
+		require.NoError(t, err)
+		assert.NotNil(t, deletedAt)
+		assert.False(t, deletedBy.Valid)
 	})
 
 	t.Run("Product with existing pvzId in reception closed", func(t *testing.T) {
 		_, err := receptionDriver.CloseReception(ctx, pvzIds[1])
 		require.NoError(t, err)
 
-		err = productDriver.DeleteLastProduct(ctx, pvzIds[1])
+		_, err = productDriver.DeleteLastProduct(ctx, pvzIds[1], pgtype.UUID{})
 		assert.Error(t, err)
 		assert.Equal(t, custom_errors.ErrNoOpenReception, err)
 	})
 
 	t.Run("Product with non-existing pvzId", func(t *testing.T) {
 		nonExistentId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
-		err = productDriver.DeleteLastProduct(ctx, nonExistentId)
+		_, err = productDriver.DeleteLastProduct(ctx, nonExistentId, pgtype.UUID{})
 
 		assert.Error(t, err)
 		assert.Equal(t, custom_errors.ErrNoOpenReception, err)
 	})
 }
+
+func TestCreateProductTypeLimitIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := product_driver.NewProductDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, _, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+
+	newProduct := func() *product_model.Product {
+		return &product_model.Product{
+			Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			AddingTime:  time.Now().UTC(),
+			ProductType: product_model.Electronics,
+		}
+	}
+
+	limit := 1
+	_, err = driver.CreateProduct(ctx, newProduct(), pvzIds[0], &limit)
+	assert.Equal(t, custom_errors.ErrProductTypeLimit, err)
+
+	limit = 2
+	_, err = driver.CreateProduct(ctx, newProduct(), pvzIds[0], &limit)
+	require.NoError(t, err)
+
+	_, err = driver.CreateProduct(ctx, newProduct(), pvzIds[0], &limit)
+	assert.Equal(t, custom_errors.ErrProductTypeLimit, err)
+}
+
+func TestCreateProductPvzCapacityIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := product_driver.NewProductDriver(pool)
+	pvzDriver := pvz_driver.NewPvzDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, _, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+
+	newProduct := func() *product_model.Product {
+		return &product_model.Product{
+			Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			AddingTime:  time.Now().UTC(),
+			ProductType: product_model.Electronics,
+		}
+	}
+
+	err = pvzDriver.UpdatePvzCapacity(ctx, pvzIds[0], 24, map[product_model.ProductType]int{product_model.Electronics: 2})
+	require.NoError(t, err)
+
+	_, err = driver.CreateProduct(ctx, newProduct(), pvzIds[0], nil)
+	require.NoError(t, err)
+
+	_, err = driver.CreateProduct(ctx, newProduct(), pvzIds[0], nil)
+	assert.Equal(t, custom_errors.ErrPvzProductTypeFull, err)
+
+	occupancy, err := pvzDriver.GetPvzOccupancy(ctx, pvzIds[0], time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 24, occupancy.StorageHours)
+	assert.Equal(t, 3, occupancy.Occupied)
+	assert.Equal(t, 2, occupancy.TypeOccupied[product_model.Electronics])
+	assert.Equal(t, 2, occupancy.TypeCapacities[product_model.Electronics])
+}
+
+func TestProductBarcodeIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := product_driver.NewProductDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, receptionIds, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+
+	barcode := "4600000000011"
+	weight := 750
+	product := &product_model.Product{
+		Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		AddingTime:  time.Now().UTC(),
+		ProductType: product_model.Electronics,
+		Barcode:     &barcode,
+		WeightGrams: &weight,
+		Dimensions:  &product_model.Dimensions{LengthMm: 200, WidthMm: 100, HeightMm: 50},
+	}
+
+	_, err = driver.CreateProduct(ctx, product, pvzIds[0], nil)
+	require.NoError(t, err)
+
+	duplicate := &product_model.Product{
+		Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		AddingTime:  time.Now().UTC(),
+		ProductType: product_model.Electronics,
+		Barcode:     &barcode,
+	}
+	_, err = driver.CreateProduct(ctx, duplicate, pvzIds[0], nil)
+	assert.Equal(t, custom_errors.ErrBarcodeExists, err)
+
+	location, err := driver.GetProductByBarcode(ctx, barcode)
+	require.NoError(t, err)
+	assert.Equal(t, product.Id, location.Product.Id)
+	assert.Equal(t, weight, *location.Product.WeightGrams)
+	assert.Equal(t, *product.Dimensions, *location.Product.Dimensions)
+	assert.Equal(t, receptionIds[0], location.Reception.Id)
+	assert.Equal(t, pvzIds[0], location.Pvz.Id)
+
+	_, err = pool.Exec(ctx, "UPDATE receptions SET status = 'paused' WHERE id = $1", receptionIds[0])
+	require.NoError(t, err)
+
+	duplicate.Id = pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	_, err = driver.CreateProduct(ctx, duplicate, pvzIds[1], nil)
+	assert.Equal(t, custom_errors.ErrBarcodeExists, err)
+
+	location, err = driver.GetProductByBarcode(ctx, barcode)
+	require.NoError(t, err)
+	assert.Equal(t, receptionIds[0], location.Reception.Id)
+
+	_, err = driver.GetProductByBarcode(ctx, "unknown")
+	assert.Equal(t, custom_errors.ErrProductNotFound, err)
+}
+
+func TestCreateProductsIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := product_driver.NewProductDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, receptionIds, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+
+	barcode := "4600000000011"
+	newProduct := func(productType product_model.ProductType, barcode *string) *product_model.Product {
+		return &product_model.Product{
+			Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			AddingTime:  time.Now().UTC(),
+			ProductType: productType,
+			Barcode:     barcode,
+			Dimensions:  &product_model.Dimensions{LengthMm: 10, WidthMm: 20, HeightMm: 30},
+		}
+	}
+	products := []*product_model.Product{
+		newProduct(product_model.Clothes, &barcode),
+		newProduct(product_model.Clothes, &barcode),
+		newProduct(product_model.Electronics, nil),
+		newProduct(product_model.Electronics, nil),
+	}
+
+	result, itemErrors, err := driver.CreateProducts(ctx, products, pvzIds[0],
+		map[product_model.ProductType]int{product_model.Electronics: 1})
+	require.NoError(t, err)
+	assert.Equal(t, receptionIds[0], *result)
+	assert.Equal(t, []error{nil, custom_errors.ErrBarcodeExists, nil, custom_errors.ErrProductTypeLimit}, itemErrors)
+
+	location, err := driver.GetProductByBarcode(ctx, barcode)
+	require.NoError(t, err)
+	assert.Equal(t, products[0].Id, location.Product.Id)
+
+	_, err = driver.CreateProduct(ctx, newProduct(product_model.Shoes, &barcode), pvzIds[0], nil)
+	assert.Equal(t, custom_errors.ErrBarcodeExists, err)
+}
+
+func TestDeleteAndRestoreProductIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := product_driver.NewProductDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, _, userIds, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+	userId := userIds[0]
+
+	barcode := "4600000000011"
+	newProduct := func() *product_model.Product {
+		return &product_model.Product{
+			Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			AddingTime:  time.Now().UTC(),
+			ProductType: product_model.Shoes,
+			Barcode:     &barcode,
+		}
+	}
+
+	first := newProduct()
+	_, err = driver.CreateProduct(ctx, first, pvzIds[0], nil)
+	require.NoError(t, err)
+
+	_, err = driver.CreateProduct(ctx, newProduct(), pvzIds[0], nil)
+	assert.Equal(t, custom_errors.ErrBarcodeExists, err)
+
+	_, err = driver.DeleteProduct(ctx, pvzIds[0], first.Id, userId)
+	require.NoError(t, err)
+
+	var deletedAt *time.Time
+	var deletedBy pgtype.UUID
+	err = pool.QueryRow(ctx, queryGetProductDeletion, first.Id).Scan(&deletedAt, &deletedBy)
+	require.NoError(t, err)
+	assert.NotNil(t, deletedAt)
+	assert.Equal(t, userId, deletedBy)
+
+	_, err = driver.DeleteProduct(ctx, pvzIds[0], first.Id, userId)
+	assert.Equal(t, custom_errors.ErrProductNotFound, err)
+
+	_, err = driver.GetProductByBarcode(ctx, barcode)
+	assert.Equal(t, custom_errors.ErrProductNotFound, err)
+
+	restored, err := driver.RestoreProduct(ctx, pvzIds[0], first.Id)
+	require.NoError(t, err)
+	assert.Equal(t, barcode, *restored.Barcode)
+
+	_, err = driver.RestoreProduct(ctx, pvzIds[0], first.Id)
+	assert.Equal(t, custom_errors.ErrDeletedProduct, err)
+
+	_, err = driver.DeleteProduct(ctx, pvzIds[0], first.Id, userId)
+	require.NoError(t, err)
+
+	second := newProduct()
+	_, err = driver.CreateProduct(ctx, second, pvzIds[0], nil)
+	require.NoError(t, err)
+
+	_, err = driver.RestoreProduct(ctx, pvzIds[0], first.Id)
+	assert.Equal(t, custom_errors.ErrBarcodeExists, err)
+}
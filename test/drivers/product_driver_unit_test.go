@@ -2,9 +2,12 @@ package drivers
 
 import (
 	"context"
+	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/product_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
@@ -39,12 +42,16 @@ func TestCreateProduct(t *testing.T) {
 			*args.Get(0).(*pgtype.UUID) = receptionID
 		}).
 		Return(nil)
+	mockPvzOccupancy(ctx, mockTx, pvzID, nil, nil, nil)
 	mockTx.On("Exec", ctx, drivers.QueryCreateProduct, []interface{}{
 		product.Id, product.AddingTime, product.ProductType, receptionID,
+		product.Barcode, product.Sku, product.OrderId, product.WeightGrams, (*int)(nil), (*int)(nil), (*int)(nil),
 	}).Return(pgconn.CommandTag{}, nil)
+	mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil)
+	mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 
-	result, err := driver.CreateProduct(ctx, product, pvzID)
+	result, err := driver.CreateProduct(ctx, product, pvzID, nil)
 
 	require.NoError(t, err)
 	assert.Equal(t, receptionID, *result)
@@ -52,6 +59,213 @@ func TestCreateProduct(t *testing.T) {
 	mockTx.AssertExpectations(t)
 }
 
+func TestCreateProducts(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := product_driver.NewProductDriver(mockAdapter)
+
+	pvzID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	receptionID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	usedBarcode := "4600000000011"
+	newBarcode := "4600000000028"
+	newProduct := func(id byte, productType product_model.ProductType, barcode *string) *product_model.Product {
+		return &product_model.Product{
+			Id:          pgtype.UUID{Bytes: [16]byte{id}, Valid: true},
+			AddingTime:  time.Now(),
+			ProductType: productType,
+			Barcode:     barcode,
+		}
+	}
+	products := []*product_model.Product{
+		newProduct(4, "мебель", nil),
+		newProduct(5, "мебель", nil),
+		newProduct(6, "обувь", &usedBarcode),
+		newProduct(7, "обувь", &newBarcode),
+		newProduct(8, "обувь", &newBarcode),
+	}
+	maxPerReception := map[product_model.ProductType]int{"мебель": 3}
+
+	mockTx := new(MockTx)
+	mockRow := new(MockRow)
+	mockCountRow := new(MockRow)
+	mockRows := new(MockRows)
+
+	mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+	mockTx.On("QueryRow", ctx, drivers.QueryGetReceptionInProgressId, []interface{}{pvzID}).
+		Return(mockRow)
+	mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*pgtype.UUID) = receptionID
+		}).
+		Return(nil)
+	mockTx.On("QueryRow", ctx, drivers.QueryCountReceptionProductsByType, []interface{}{receptionID, product_model.ProductType("мебель")}).
+		Return(mockCountRow)
+	mockCountRow.On("Scan", mock.AnythingOfType("*int")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*int) = 2
+		}).
+		Return(nil)
+	barcodes := []string{usedBarcode, newBarcode, newBarcode}
+	mockTx.On("Exec", ctx, drivers.QueryLockBarcodes, []interface{}{barcodes}).Return(pgconn.CommandTag{}, nil)
+	mockTx.On("Query", ctx, drivers.QueryBarcodesInOpenReception, []interface{}{barcodes}).Return(mockRows, nil)
+	mockRows.On("Next").Return(true).Once()
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Scan", mock.AnythingOfType("*string")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*string) = usedBarcode
+		}).
+		Return(nil)
+	mockRows.On("Close").Return()
+	mockRows.On("Err").Return(nil)
+	mockPvzOccupancy(ctx, mockTx, pvzID, nil, nil, nil)
+	mockTx.On("CopyFrom", ctx, pgx.Identifier{"products"}, mock.Anything, mock.Anything).Return(int64(2), nil)
+	mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil).Twice()
+	mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, nil).Twice()
+	mockTx.On("Commit", ctx).Return(nil)
+
+	result, itemErrors, err := driver.CreateProducts(ctx, products, pvzID, maxPerReception)
+
+	require.NoError(t, err)
+	assert.Equal(t, receptionID, *result)
+	assert.Equal(t, []error{nil, custom_errors.ErrProductTypeLimit, custom_errors.ErrBarcodeExists, nil, custom_errors.ErrBarcodeExists}, itemErrors)
+	assert.Equal(t, receptionID, products[0].ReceptionId)
+	mockAdapter.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+func TestCreateProductsWithoutOpenReception(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := product_driver.NewProductDriver(mockAdapter)
+
+	pvzID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	mockTx := new(MockTx)
+	mockRow := new(MockRow)
+
+	mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+	mockTx.On("QueryRow", ctx, drivers.QueryGetReceptionInProgressId, []interface{}{pvzID}).
+		Return(mockRow)
+	mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID")).Return(pgx.ErrNoRows)
+
+	result, itemErrors, err := driver.CreateProducts(ctx, []*product_model.Product{{ProductType: "обувь"}}, pvzID, nil)
+
+	assert.Nil(t, result)
+	assert.Nil(t, itemErrors)
+	assert.Equal(t, custom_errors.ErrNoOpenReception, err)
+	mockTx.AssertNotCalled(t, "CopyFrom", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateProductWithDuplicateBarcode(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := product_driver.NewProductDriver(mockAdapter)
+
+	pvzID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	barcode := "4600000000011"
+	product := &product_model.Product{
+		Id:          pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		AddingTime:  time.Now(),
+		ProductType: "test",
+		Barcode:     &barcode,
+	}
+	receptionID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+
+	mockTx := new(MockTx)
+	mockRow := new(MockRow)
+	mockExistsRow := new(MockRow)
+
+	mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+	mockTx.On("QueryRow", ctx, drivers.QueryGetReceptionInProgressId, []interface{}{pvzID}).
+		Return(mockRow)
+	mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*pgtype.UUID) = receptionID
+		}).
+		Return(nil)
+	mockPvzOccupancy(ctx, mockTx, pvzID, nil, nil, nil)
+	mockTx.On("Exec", ctx, drivers.QueryLockBarcode, []interface{}{barcode}).Return(pgconn.CommandTag{}, nil)
+	mockTx.On("QueryRow", ctx, drivers.QueryBarcodeInOpenReception, []interface{}{barcode}).
+		Return(mockExistsRow)
+	mockExistsRow.On("Scan", mock.AnythingOfType("*bool")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*bool) = true
+		}).
+		Return(nil)
+
+	result, err := driver.CreateProduct(ctx, product, pvzID, nil)
+
+	assert.Nil(t, result)
+	assert.Equal(t, custom_errors.ErrBarcodeExists, err)
+	mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryCreateProduct, mock.Anything)
+	mockTx.AssertNotCalled(t, "Commit", ctx)
+}
+
+func TestGetProductByBarcodeNotFound(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := product_driver.NewProductDriver(mockAdapter)
+
+	mockRow := new(MockRow)
+	mockAdapter.On("QueryRow", ctx, drivers.QueryGetProductByBarcode, []interface{}{"unknown"}).Return(mockRow)
+	scanArgs := make([]interface{}, 16)
+	for i := range scanArgs {
+		scanArgs[i] = mock.Anything
+	}
+	mockRow.On("Scan", scanArgs...).Return(pgx.ErrNoRows)
+
+	result, err := driver.GetProductByBarcode(ctx, "unknown")
+
+	assert.Nil(t, result)
+	assert.Equal(t, custom_errors.ErrProductNotFound, err)
+	mockAdapter.AssertExpectations(t)
+}
+
+func TestCreateProductOverTypeLimit(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := product_driver.NewProductDriver(mockAdapter)
+
+	pvzID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	product := &product_model.Product{
+		Id:          pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		AddingTime:  time.Now(),
+		ProductType: "мебель",
+	}
+	receptionID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	maxPerReception := 2
+
+	mockTx := new(MockTx)
+	mockRow := new(MockRow)
+	mockCountRow := new(MockRow)
+
+	mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+	mockTx.On("QueryRow", ctx, drivers.QueryGetReceptionInProgressId, []interface{}{pvzID}).
+		Return(mockRow)
+	mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*pgtype.UUID) = receptionID
+		}).
+		Return(nil)
+	mockTx.On("QueryRow", ctx, drivers.QueryCountReceptionProductsByType, []interface{}{receptionID, product.ProductType}).
+		Return(mockCountRow)
+	mockCountRow.On("Scan", mock.AnythingOfType("*int")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*int) = maxPerReception
+		}).
+		Return(nil)
+
+	result, err := driver.CreateProduct(ctx, product, pvzID, &maxPerReception)
+
+	assert.Nil(t, result)
+	assert.Equal(t, custom_errors.ErrProductTypeLimit, err)
+	mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryCreateProduct, mock.Anything)
+	mockTx.AssertNotCalled(t, "Commit", ctx)
+}
+
 func TestDeleteLastProduct(t *testing.T) {
 	ctx := context.Background()
 	mockAdapter := new(MockAdapter)
@@ -59,6 +273,7 @@ func TestDeleteLastProduct(t *testing.T) {
 
 	pvzID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
 	receptionID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	userID := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
 
 	mockTx := new(MockTx)
 	mockRow := new(MockRow)
@@ -72,13 +287,354 @@ func TestDeleteLastProduct(t *testing.T) {
 			*args.Get(0).(*pgtype.UUID) = receptionID
 		}).
 		Return(nil)
-	mockTx.On("Exec", ctx, drivers.QueryDeleteLastProduct, []interface{}{receptionID}).
-		Return(pgconn.CommandTag{}, nil)
+	productID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	deleteRow := new(MockRow)
+	mockTx.On("QueryRow", ctx, drivers.QueryDeleteLastProduct, mock.MatchedBy(func(args []interface{}) bool {
+		return len(args) == 3 && args[0] == receptionID && args[2] == userID
+	})).
+		Return(deleteRow)
+	deleteRow.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"),
+		mock.AnythingOfType("*product_model.ProductType"), mock.AnythingOfType("*pgtype.UUID")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*pgtype.UUID) = productID
+			*args.Get(2).(*product_model.ProductType) = product_model.Shoes
+			*args.Get(3).(*pgtype.UUID) = receptionID
+		}).
+		Return(nil)
+	mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil)
+	mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 
-	err := driver.DeleteLastProduct(ctx, pvzID)
+	product, err := driver.DeleteLastProduct(ctx, pvzID, userID)
 
 	require.NoError(t, err)
+	assert.Equal(t, productID, product.Id)
+	assert.Equal(t, receptionID, product.ReceptionId)
+	assert.Equal(t, product_model.Shoes, product.ProductType)
+	mockAdapter.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+func TestDeleteLastProductWithoutProducts(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := product_driver.NewProductDriver(mockAdapter)
+
+	pvzID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	receptionID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	userID := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+
+	mockTx := new(MockTx)
+	mockRow := new(MockRow)
+	deleteRow := new(MockRow)
+
+	mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+	mockTx.On("QueryRow", ctx, drivers.QueryGetReceptionInProgressId, []interface{}{pvzID}).
+		Return(mockRow)
+	mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*pgtype.UUID) = receptionID
+		}).
+		Return(nil)
+	mockTx.On("QueryRow", ctx, drivers.QueryDeleteLastProduct, mock.MatchedBy(func(args []interface{}) bool {
+		return len(args) == 3 && args[0] == receptionID && args[2] == userID
+	})).
+		Return(deleteRow)
+	deleteRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(pgx.ErrNoRows)
+
+	product, err := driver.DeleteLastProduct(ctx, pvzID, userID)
+
+	assert.Nil(t, product)
+	assert.Equal(t, custom_errors.ErrNoProducts, err)
 	mockAdapter.AssertExpectations(t)
 	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Commit", ctx)
+}
+
+func TestDeleteProduct(t *testing.T) {
+	ctx := context.Background()
+
+	pvzID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	productID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	receptionID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	userID := pgtype.UUID{Bytes: [16]byte{9}, Valid: true}
+
+	setup := func() (*MockAdapter, *MockTx, *MockRow) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		mockRow := new(MockRow)
+		deleteRow := new(MockRow)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+		mockTx.On("QueryRow", ctx, drivers.QueryGetReceptionInProgressId, []interface{}{pvzID}).
+			Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*pgtype.UUID) = receptionID
+			}).
+			Return(nil)
+		mockTx.On("QueryRow", ctx, drivers.QueryDeleteProduct, mock.MatchedBy(func(args []interface{}) bool {
+			return len(args) == 4 && args[0] == productID && args[1] == receptionID && args[3] == userID
+		})).Return(deleteRow)
+
+		return mockAdapter, mockTx, deleteRow
+	}
+
+	t.Run("Delete product by id", func(t *testing.T) {
+		mockAdapter, mockTx, deleteRow := setup()
+		driver := product_driver.NewProductDriver(mockAdapter)
+
+		deleteRow.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("*product_model.ProductType"), mock.AnythingOfType("*pgtype.UUID")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*pgtype.UUID) = productID
+				*args.Get(3).(*pgtype.UUID) = receptionID
+			}).
+			Return(nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		product, err := driver.DeleteProduct(ctx, pvzID, productID, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, productID, product.Id)
+		assert.Equal(t, receptionID, product.ReceptionId)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("Delete product missing in reception", func(t *testing.T) {
+		mockAdapter, mockTx, deleteRow := setup()
+		driver := product_driver.NewProductDriver(mockAdapter)
+
+		deleteRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+
+		product, err := driver.DeleteProduct(ctx, pvzID, productID, userID)
+
+		assert.Nil(t, product)
+		assert.Equal(t, custom_errors.ErrProductNotFound, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestRestoreProduct(t *testing.T) {
+	ctx := context.Background()
+
+	pvzID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	productID := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	receptionID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	barcode := "4600000000011"
+
+	setup := func() (*MockAdapter, *MockTx, *MockRow) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		mockRow := new(MockRow)
+		deletedRow := new(MockRow)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+		mockTx.On("QueryRow", ctx, drivers.QueryGetReceptionInProgressId, []interface{}{pvzID}).
+			Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*pgtype.UUID) = receptionID
+			}).
+			Return(nil)
+		mockTx.On("QueryRow", ctx, drivers.QueryGetDeletedProduct, []interface{}{productID, receptionID}).
+			Return(deletedRow)
+
+		return mockAdapter, mockTx, deletedRow
+	}
+
+	t.Run("Restore deleted product", func(t *testing.T) {
+		mockAdapter, mockTx, deletedRow := setup()
+		driver := product_driver.NewProductDriver(mockAdapter)
+
+		deletedRow.On("Scan", mock.AnythingOfType("*product_model.ProductType"), mock.AnythingOfType("**string"),
+			mock.AnythingOfType("**int")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*product_model.ProductType) = product_model.Shoes
+				*args.Get(1).(**string) = &barcode
+			}).
+			Return(nil)
+		mockPvzOccupancy(ctx, mockTx, pvzID, nil, nil, nil)
+		existsRow := new(MockRow)
+		mockTx.On("Exec", ctx, drivers.QueryLockBarcode, []interface{}{barcode}).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("QueryRow", ctx, drivers.QueryBarcodeInOpenReception, []interface{}{barcode}).Return(existsRow)
+		existsRow.On("Scan", mock.AnythingOfType("*bool")).Return(nil)
+		restoreRow := new(MockRow)
+		mockTx.On("QueryRow", ctx, drivers.QueryRestoreProduct, []interface{}{productID}).Return(restoreRow)
+		scanArgs := make([]interface{}, 11)
+		for i := range scanArgs {
+			scanArgs[i] = mock.Anything
+		}
+		restoreRow.On("Scan", scanArgs...).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*pgtype.UUID) = productID
+				*args.Get(2).(*product_model.ProductType) = product_model.Shoes
+				*args.Get(3).(*pgtype.UUID) = receptionID
+				*args.Get(4).(**string) = &barcode
+			}).
+			Return(nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		product, err := driver.RestoreProduct(ctx, pvzID, productID)
+
+		require.NoError(t, err)
+		assert.Equal(t, productID, product.Id)
+		assert.Equal(t, barcode, *product.Barcode)
+		assert.Nil(t, product.Dimensions)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("Restore product that is not deleted", func(t *testing.T) {
+		mockAdapter, mockTx, deletedRow := setup()
+		driver := product_driver.NewProductDriver(mockAdapter)
+
+		deletedRow.On("Scan", mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+
+		product, err := driver.RestoreProduct(ctx, pvzID, productID)
+
+		assert.Nil(t, product)
+		assert.Equal(t, custom_errors.ErrDeletedProduct, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestCreateProductOutboxError(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := product_driver.NewProductDriver(mockAdapter)
+
+	pvzID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	product := &product_model.Product{
+		Id:          pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+		AddingTime:  time.Now(),
+		ProductType: "test",
+	}
+	receptionID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+
+	mockTx := new(MockTx)
+	mockRow := new(MockRow)
+
+	mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+	mockTx.On("QueryRow", ctx, drivers.QueryGetReceptionInProgressId, []interface{}{pvzID}).
+		Return(mockRow)
+	mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*pgtype.UUID) = receptionID
+		}).
+		Return(nil)
+	mockPvzOccupancy(ctx, mockTx, pvzID, nil, nil, nil)
+	mockTx.On("Exec", ctx, drivers.QueryCreateProduct, mock.Anything).Return(pgconn.CommandTag{}, nil)
+	mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).
+		Return(pgconn.CommandTag{}, errors.New("outbox error"))
+
+	result, err := driver.CreateProduct(ctx, product, pvzID, nil)
+
+	assert.Nil(t, result)
+	assert.Equal(t, custom_errors.ErrCreateOutboxEvent, err)
+	mockTx.AssertNotCalled(t, "Commit", ctx)
+}
+
+func TestCreateProductOverPvzCapacity(t *testing.T) {
+	ctx := context.Background()
+
+	pvzID := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	receptionID := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+	capacity := 10
+
+	tests := []struct {
+		name           string
+		capacity       *int
+		typeCapacities map[product_model.ProductType]int
+		typeOccupied   map[product_model.ProductType]int
+		expectedErr    error
+	}{
+		{
+			name:         "Total capacity is reached",
+			capacity:     &capacity,
+			typeOccupied: map[product_model.ProductType]int{product_model.Shoes: 4, product_model.Clothes: 6},
+			expectedErr:  custom_errors.ErrPvzFull,
+		},
+		{
+			name:           "Product type capacity is reached",
+			capacity:       &capacity,
+			typeCapacities: map[product_model.ProductType]int{product_model.Shoes: 4},
+			typeOccupied:   map[product_model.ProductType]int{product_model.Shoes: 4},
+			expectedErr:    custom_errors.ErrPvzProductTypeFull,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAdapter := new(MockAdapter)
+			driver := product_driver.NewProductDriver(mockAdapter)
+			product := &product_model.Product{
+				Id:          pgtype.UUID{Bytes: [16]byte{2}, Valid: true},
+				AddingTime:  time.Now(),
+				ProductType: product_model.Shoes,
+			}
+
+			mockTx := new(MockTx)
+			mockRow := new(MockRow)
+
+			mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+			mockTx.On("Rollback", ctx).Return(nil)
+			mockTx.On("QueryRow", ctx, drivers.QueryGetReceptionInProgressId, []interface{}{pvzID}).
+				Return(mockRow)
+			mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID")).
+				Run(func(args mock.Arguments) {
+					*args.Get(0).(*pgtype.UUID) = receptionID
+				}).
+				Return(nil)
+			mockPvzOccupancy(ctx, mockTx, pvzID, tt.capacity, tt.typeCapacities, tt.typeOccupied)
+
+			result, err := driver.CreateProduct(ctx, product, pvzID, nil)
+
+			assert.Nil(t, result)
+			assert.Equal(t, tt.expectedErr, err)
+			mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryCreateProduct, mock.Anything)
+			mockTx.AssertNotCalled(t, "Commit", ctx)
+		})
+	}
+}
+
+func mockPvzOccupancy(ctx context.Context, mockTx *MockTx, pvzID pgtype.UUID, capacity *int, typeCapacities, typeOccupied map[product_model.ProductType]int) {
+	capacityRow := new(MockRow)
+	mockTx.On("QueryRow", ctx, drivers.QueryGetPvzCapacity, []interface{}{pvzID}).Return(capacityRow)
+	capacityRow.On("Scan", mock.AnythingOfType("**int"), mock.AnythingOfType("*int")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(**int) = capacity
+			*args.Get(1).(*int) = 72
+		}).
+		Return(nil)
+	mockTx.On("Query", ctx, drivers.QueryGetPvzTypeCapacities, []interface{}{pvzID}).
+		Return(mockProductTypeCounts(typeCapacities), nil)
+	mockTx.On("Query", ctx, drivers.QueryCountPvzOccupancy, mock.Anything).
+		Return(mockProductTypeCounts(typeOccupied), nil)
+}
+
+func mockProductTypeCounts(counts map[product_model.ProductType]int) *MockRows {
+	mockRows := new(MockRows)
+	for productType, count := range counts {
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Scan", mock.AnythingOfType("*product_model.ProductType"), mock.AnythingOfType("*int")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*product_model.ProductType) = productType
+				*args.Get(1).(*int) = count
+			}).
+			Return(nil).Once()
+	}
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Close").Return()
+	mockRows.On("Err").Return(nil)
+
+	return mockRows
 }
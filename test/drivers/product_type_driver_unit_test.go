@@ -0,0 +1,114 @@
+package drivers
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/product_type_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_type_model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateProductType(t *testing.T) {
+	ctx := context.Background()
+	maxPerReception := 3
+	productType := &product_type_model.ProductType{
+		Code:            "мебель",
+		DisplayNameRu:   "Мебель",
+		DisplayNameEn:   "Furniture",
+		Oversize:        true,
+		MaxPerReception: &maxPerReception,
+		CreatedAt:       time.Now(),
+	}
+	params := []interface{}{productType.Code, productType.DisplayNameRu, productType.DisplayNameEn,
+		productType.Fragile, productType.Oversize, productType.MaxPerReception, productType.CreatedAt}
+
+	t.Run("Create product type", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := product_type_driver.NewProductTypeDriver(mockAdapter)
+
+		mockAdapter.On("Exec", ctx, drivers.QueryCreateProductType, params).Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+
+		err := driver.CreateProductType(ctx, productType)
+
+		assert.NoError(t, err)
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Create existing product type", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := product_type_driver.NewProductTypeDriver(mockAdapter)
+
+		mockAdapter.On("Exec", ctx, drivers.QueryCreateProductType, params).
+			Return(pgconn.CommandTag{}, &pgconn.PgError{Code: drivers.UniqueViolationCode})
+
+		err := driver.CreateProductType(ctx, productType)
+
+		assert.Equal(t, custom_errors.ErrProductTypeExists, err)
+		mockAdapter.AssertExpectations(t)
+	})
+}
+
+func TestGetProductTypeByCode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get product type", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		driver := product_type_driver.NewProductTypeDriver(mockAdapter)
+
+		maxPerReception := 5
+		mockAdapter.On("QueryRow", ctx, drivers.QueryGetProductTypeByCode, []interface{}{"электроника"}).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*string"), mock.AnythingOfType("*string"), mock.AnythingOfType("*string"),
+			mock.AnythingOfType("*bool"), mock.AnythingOfType("*bool"), mock.AnythingOfType("**int"), mock.AnythingOfType("*time.Time")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*string)) = "электроника"
+				*(args.Get(3).(*bool)) = true
+				*(args.Get(5).(**int)) = &maxPerReception
+			}).
+			Return(nil)
+
+		productType, err := driver.GetProductTypeByCode(ctx, "электроника")
+
+		require.NoError(t, err)
+		assert.Equal(t, "электроника", productType.Code)
+		assert.True(t, productType.Fragile)
+		assert.Equal(t, &maxPerReception, productType.MaxPerReception)
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Get missing product type", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		driver := product_type_driver.NewProductTypeDriver(mockAdapter)
+
+		mockAdapter.On("QueryRow", ctx, drivers.QueryGetProductTypeByCode, []interface{}{"мебель"}).Return(mockRow)
+		mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(pgx.ErrNoRows)
+
+		productType, err := driver.GetProductTypeByCode(ctx, "мебель")
+
+		assert.Nil(t, productType)
+		assert.Equal(t, custom_errors.ErrProductTypeNotFound, err)
+	})
+}
+
+func TestDeleteProductType(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := product_type_driver.NewProductTypeDriver(mockAdapter)
+
+	mockAdapter.On("Exec", ctx, drivers.QueryDeleteProductType, []interface{}{"обувь"}).
+		Return(pgconn.CommandTag{}, &pgconn.PgError{Code: drivers.ForeignKeyViolationCode})
+
+	err := driver.DeleteProductType(ctx, "обувь")
+
+	assert.Equal(t, custom_errors.ErrProductTypeInUse, err)
+	mockAdapter.AssertExpectations(t)
+}
@@ -6,8 +6,11 @@ import (
 	"time"
 
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/pvz_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/reception_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
@@ -30,6 +33,7 @@ func TestCreatePvzIntegration(t *testing.T) {
 		Id:               id,
 		RegistrationDate: registrationDate,
 		City:             city,
+		Status:           pvz_model.Active,
 	}
 
 	err := driver.CreatePvz(ctx, pvz)
@@ -44,6 +48,122 @@ func TestCreatePvzIntegration(t *testing.T) {
 	assert.Equal(t, string(city), dbCity)
 }
 
+func TestPvzLifecycleIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := pvz_driver.NewPvzDriver(pool)
+	receptionDriver := reception_driver.NewReceptionDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, receptionIds, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+
+	t.Run("Update pvz details", func(t *testing.T) {
+		address := "ул. Тверская, 1"
+		workingHours := "09:00-21:00"
+		capacity := 500
+		err := driver.UpdatePvz(ctx, &pvz_model.Pvz{Id: pvzIds[0], Address: &address, WorkingHours: &workingHours, Capacity: &capacity})
+		require.NoError(t, err)
+
+		pvz, err := driver.GetPvzById(ctx, pvzIds[0])
+		require.NoError(t, err)
+		assert.Equal(t, &address, pvz.Address)
+		assert.Equal(t, &workingHours, pvz.WorkingHours)
+		assert.Equal(t, &capacity, pvz.Capacity)
+		assert.Equal(t, pvz_model.Active, pvz.Status)
+	})
+
+	t.Run("Deactivate and activate pvz", func(t *testing.T) {
+		require.NoError(t, driver.UpdatePvzStatus(ctx, pvzIds[0], pvz_model.Active, pvz_model.Inactive))
+		assert.Equal(t, custom_errors.ErrPvzChanged, driver.UpdatePvzStatus(ctx, pvzIds[0], pvz_model.Active, pvz_model.Inactive))
+
+		pvz, err := driver.GetPvzById(ctx, pvzIds[0])
+		require.NoError(t, err)
+		assert.Equal(t, pvz_model.Inactive, pvz.Status)
+
+		require.NoError(t, driver.UpdatePvzStatus(ctx, pvzIds[0], pvz_model.Inactive, pvz_model.Active))
+	})
+
+	t.Run("Archive pvz with open reception", func(t *testing.T) {
+		err := driver.ArchivePvz(ctx, pvzIds[0], time.Now().UTC())
+
+		assert.Equal(t, custom_errors.ErrPvzOpenReception, err)
+	})
+
+	t.Run("Archive pvz keeps reception history", func(t *testing.T) {
+		_, err := receptionDriver.CloseReception(ctx, pvzIds[0])
+		require.NoError(t, err)
+
+		require.NoError(t, driver.ArchivePvz(ctx, pvzIds[0], time.Now().UTC()))
+
+		pvz, err := driver.GetPvzById(ctx, pvzIds[0])
+		require.NoError(t, err)
+		assert.Equal(t, pvz_model.Archived, pvz.Status)
+		assert.NotNil(t, pvz.ArchivedAt)
+
+		reception, err := receptionDriver.GetReception(ctx, receptionIds[2])
+		require.NoError(t, err)
+		assert.Equal(t, pvzIds[0], reception.PvzId)
+
+		assert.Equal(t, custom_errors.ErrPvzArchived, driver.ArchivePvz(ctx, pvzIds[0], time.Now().UTC()))
+		assert.Equal(t, custom_errors.ErrPvzArchived, driver.UpdatePvz(ctx, &pvz_model.Pvz{Id: pvzIds[0]}))
+	})
+}
+
+func TestGetNearbyPvzIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := pvz_driver.NewPvzDriver(pool)
+	ctx := context.Background()
+
+	createPvz := func(city pvz_model.City, status pvz_model.PvzStatus, latitude, longitude float64) pgtype.UUID {
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		err := driver.CreatePvz(ctx, &pvz_model.Pvz{
+			Id:               id,
+			RegistrationDate: time.Now().UTC(),
+			City:             city,
+			Status:           status,
+			Latitude:         &latitude,
+			Longitude:        &longitude,
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	nearId := createPvz(pvz_model.Moscow, pvz_model.Active, 55.7539, 37.6208)
+	fartherId := createPvz(pvz_model.Moscow, pvz_model.Active, 55.7651, 37.6056)
+	createPvz(pvz_model.Moscow, pvz_model.Inactive, 55.7560, 37.6175)
+	createPvz(pvz_model.SPb, pvz_model.Active, 59.9343, 30.3351)
+	require.NoError(t, driver.CreatePvz(ctx, &pvz_model.Pvz{
+		Id:               pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		RegistrationDate: time.Now().UTC(),
+		City:             pvz_model.Moscow,
+		Status:           pvz_model.Active,
+	}))
+
+	pvzList, err := driver.GetNearbyPvz(ctx, 55.7558, 37.6173, 5000, 10)
+
+	require.NoError(t, err)
+	require.Len(t, pvzList, 2)
+	assert.Equal(t, nearId, pvzList[0].Pvz.Id)
+	assert.InDelta(t, 300, pvzList[0].Distance, 50)
+	assert.Equal(t, fartherId, pvzList[1].Pvz.Id)
+	assert.InDelta(t, 1270, pvzList[1].Distance, 50)
+
+	pvzList, err = driver.GetNearbyPvz(ctx, 55.7558, 37.6173, 5000, 1)
+
+	require.NoError(t, err)
+	require.Len(t, pvzList, 1)
+	assert.Equal(t, nearId, pvzList[0].Pvz.Id)
+
+	pvzList, err = driver.GetNearbyPvz(ctx, 55.7558, 37.6173, 100, 10)
+
+	require.NoError(t, err)
+	assert.Empty(t, pvzList)
+}
+
 func TestGetPvzByIdIntegration(t *testing.T) {
 	pool, cleanup := SetupPostgresContainer(t)
 	defer cleanup()
@@ -82,27 +202,27 @@ func TestGetPvzFullInfoIntegration(t *testing.T) {
 	driver := pvz_driver.NewPvzDriver(pool)
 	ctx := context.Background()
 
-	_, _, _, err := createTestData(ctx, pool)
+	pvzIds, receptionIds, _, err := createTestData(ctx, pool)
 	require.NoError(t, err)
 
 	t.Run("Get pvz full info", func(t *testing.T) {
 		limit := uint32(10)
 		offset := uint32(0)
 
-		results, err := driver.GetPvzFullInfo(ctx, limit, offset, nil, nil)
+		results, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: limit, Offset: offset})
 
 		require.NoError(t, err)
 		assert.NotEmpty(t, results)
 		assert.LessOrEqual(t, len(results), 2)
 
-		if len(results) > 0 {
-			pvzData, ok := results[0]["pvz"]
-			assert.True(t, ok, "Result should contain 'pvz' key")
-			assert.NotNil(t, pvzData)
-
-			receptions, ok := results[0]["receptions"]
-			assert.True(t, ok, "Result should contain 'receptions' key")
-			assert.NotNil(t, receptions)
+		for i := 1; i < len(results); i++ {
+			assert.False(t, results[i].Pvz.RegistrationDate.Before(results[i-1].Pvz.RegistrationDate))
+		}
+		for _, pvz := range results {
+			assert.True(t, pvz.Pvz.Id.Valid)
+			for i := 1; i < len(pvz.Receptions); i++ {
+				assert.False(t, pvz.Receptions[i].Reception.ReceptionTime.After(pvz.Receptions[i-1].Reception.ReceptionTime))
+			}
 		}
 	})
 
@@ -112,13 +232,11 @@ func TestGetPvzFullInfoIntegration(t *testing.T) {
 		startTime := time.Now().Add(-36 * time.Hour)
 		endTime := time.Now()
 
-		results, err := driver.GetPvzFullInfo(ctx, limit, offset, &startTime, &endTime)
+		results, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: limit, Offset: offset, StartInterval: &startTime, EndInterval: &endTime})
 
 		require.NoError(t, err)
-		if len(results) > 0 {
-			pvzData, ok := results[0]["pvz"]
-			assert.True(t, ok, "Result should contain 'pvz' key")
-			assert.NotNil(t, pvzData)
+		for _, pvz := range results {
+			assert.True(t, pvz.Pvz.Id.Valid)
 		}
 	})
 
@@ -127,28 +245,175 @@ func TestGetPvzFullInfoIntegration(t *testing.T) {
 		firstOffset := uint32(0)
 		secondOffset := uint32(1)
 
-		firstPage, err := driver.GetPvzFullInfo(ctx, limit, firstOffset, nil, nil)
+		firstPage, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: limit, Offset: firstOffset})
 		require.NoError(t, err)
 
 		if len(firstPage) == 0 {
 			t.Skip("No data returned for pagination test")
 		}
 
-		secondPage, err := driver.GetPvzFullInfo(ctx, limit, secondOffset, nil, nil)
+		secondPage, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: limit, Offset: secondOffset})
 		require.NoError(t, err)
 
 		if len(firstPage) > 0 && len(secondPage) > 0 {
-			firstPvzMap, ok1 := firstPage[0]["pvz"].(map[string]interface{})
-			secondPvzMap, ok2 := secondPage[0]["pvz"].(map[string]interface{})
+			assert.NotEqual(t, firstPage[0].Pvz.Id, secondPage[0].Pvz.Id,
+				"Different pages should return different pvz")
+		}
+	})
 
-			if ok1 && ok2 && firstPvzMap["id"] != nil && secondPvzMap["id"] != nil {
-				assert.NotEqual(t, firstPvzMap["id"], secondPvzMap["id"],
-					"Different pages should return different pvz")
-			}
+	t.Run("Get pvz full info limits pvz, not rows", func(t *testing.T) {
+		results, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: 1, Offset: 0})
+		require.NoError(t, err)
+
+		require.Len(t, results, 1)
+		assert.Equal(t, pvzIds[1], results[0].Pvz.Id)
+		require.Len(t, results[0].Receptions, 1)
+		assert.Equal(t, receptionIds[1], results[0].Receptions[0].Reception.Id)
+		assert.Len(t, results[0].Receptions[0].Products, 2)
+
+		results, err = driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: 1, Offset: 1})
+		require.NoError(t, err)
+
+		require.Len(t, results, 1)
+		assert.Equal(t, pvzIds[0], results[0].Pvz.Id)
+		require.Len(t, results[0].Receptions, 2)
+		assert.Len(t, results[0].Receptions[0].Products, 2)
+		assert.Len(t, results[0].Receptions[1].Products, 1)
+	})
+
+	t.Run("Get pvz full info keeps pvz without receptions in range", func(t *testing.T) {
+		startTime := time.Now().Add(-6 * time.Hour)
+
+		results, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: 10, Offset: 0, StartInterval: &startTime})
+		require.NoError(t, err)
+
+		require.Len(t, results, 2)
+		for _, pvz := range results {
+			assert.Empty(t, pvz.Receptions)
 		}
 	})
 }
 
+func TestPvzKeysetPaginationIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := pvz_driver.NewPvzDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, _, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+
+	t.Run("Get pvz full info after cursor", func(t *testing.T) {
+		firstPage, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, firstPage, 1)
+		assert.Equal(t, pvzIds[1], firstPage[0].Pvz.Id)
+
+		cursor := &pvz_model.PvzCursor{SortValue: firstPage[0].Pvz.RegistrationDate, Id: firstPage[0].Pvz.Id}
+		secondPage, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: 1, After: cursor})
+		require.NoError(t, err)
+		require.Len(t, secondPage, 1)
+		assert.Equal(t, pvzIds[0], secondPage[0].Pvz.Id)
+		assert.NotEmpty(t, secondPage[0].Receptions)
+
+		cursor = &pvz_model.PvzCursor{SortValue: secondPage[0].Pvz.RegistrationDate, Id: secondPage[0].Pvz.Id}
+		lastPage, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: 1, After: cursor})
+		require.NoError(t, err)
+		assert.Empty(t, lastPage)
+	})
+
+	t.Run("Get pvz page after cursor", func(t *testing.T) {
+		firstPage, err := driver.GetPvzPage(ctx, 1, nil)
+		require.NoError(t, err)
+		require.Len(t, firstPage, 1)
+		assert.Equal(t, pvzIds[1], firstPage[0].Id)
+
+		cursor := &pvz_model.PvzCursor{SortValue: firstPage[0].RegistrationDate, Id: firstPage[0].Id}
+		secondPage, err := driver.GetPvzPage(ctx, 10, cursor)
+		require.NoError(t, err)
+		require.Len(t, secondPage, 1)
+		assert.Equal(t, pvzIds[0], secondPage[0].Id)
+	})
+}
+
+func TestGetPvzFullInfoFiltersIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := pvz_driver.NewPvzDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, _, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+
+	t.Run("Filter by city", func(t *testing.T) {
+		city := pvz_model.SPb
+		filter := pvz_model.PvzFilter{Limit: 10, City: &city}
+
+		results, err := driver.GetPvzFullInfo(ctx, filter)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, pvzIds[1], results[0].Pvz.Id)
+
+		total, err := driver.CountPvz(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+	})
+
+	t.Run("Filter by reception status and product type", func(t *testing.T) {
+		status := reception_model.Close
+		productType := product_model.Clothes
+		results, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: 10, ReceptionStatus: &status, ProductType: &productType})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, pvzIds[0], results[0].Pvz.Id)
+		assert.Len(t, results[0].Receptions, 2)
+	})
+
+	t.Run("Filter by min products", func(t *testing.T) {
+		minProducts := 3
+		results, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: 10, MinProducts: &minProducts})
+		require.NoError(t, err)
+		assert.Empty(t, results)
+
+		minProducts = 2
+		total, err := driver.CountPvz(ctx, pvz_model.PvzFilter{MinProducts: &minProducts})
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+	})
+
+	t.Run("Sort by last activity", func(t *testing.T) {
+		results, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: 1, SortBy: pvz_model.SortByLastActivity})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, pvzIds[0], results[0].Pvz.Id)
+
+		cursor := &pvz_model.PvzCursor{SortValue: results[0].LastActivity, Id: results[0].Pvz.Id}
+		results, err = driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: 1, SortBy: pvz_model.SortByLastActivity, After: cursor})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, pvzIds[1], results[0].Pvz.Id)
+		assert.False(t, results[0].LastActivity.After(cursor.SortValue))
+	})
+}
+
+func TestCountPvzIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := pvz_driver.NewPvzDriver(pool)
+	ctx := context.Background()
+
+	_, _, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+
+	total, err := driver.CountPvz(ctx, pvz_model.PvzFilter{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+}
+
 func TestGetAllPvzIntegration(t *testing.T) {
 	pool, cleanup := SetupPostgresContainer(t)
 	defer cleanup()
@@ -33,9 +33,10 @@ func TestCreatePvz(t *testing.T) {
 			Id:               id,
 			RegistrationDate: time.Now(),
 			City:             pvz_model.Moscow,
+			Status:           pvz_model.Active,
 		}
 
-		params := []interface{}{pvz.Id, pvz.RegistrationDate, pvz.City}
+		params := []interface{}{pvz.Id, pvz.RegistrationDate, pvz.City, pvz.Address, pvz.WorkingHours, pvz.Capacity, pvz.Status, pvz.Latitude, pvz.Longitude}
 		mockAdapter.On("Exec", ctx, drivers.QueryCreatePvz, params).Return(pgconn.CommandTag{}, nil)
 
 		err := driver.CreatePvz(ctx, pvz)
@@ -53,9 +54,10 @@ func TestCreatePvz(t *testing.T) {
 			Id:               id,
 			RegistrationDate: time.Now(),
 			City:             pvz_model.Moscow,
+			Status:           pvz_model.Active,
 		}
 
-		params := []interface{}{pvz.Id, pvz.RegistrationDate, pvz.City}
+		params := []interface{}{pvz.Id, pvz.RegistrationDate, pvz.City, pvz.Address, pvz.WorkingHours, pvz.Capacity, pvz.Status, pvz.Latitude, pvz.Longitude}
 		mockAdapter.On("Exec", ctx, drivers.QueryCreatePvz, params).
 			Return(pgconn.CommandTag{}, errors.New("db error"))
 
@@ -66,6 +68,273 @@ func TestCreatePvz(t *testing.T) {
 	})
 }
 
+func TestUpdatePvz(t *testing.T) {
+	ctx := context.Background()
+	address := "ул. Тверская, 1"
+	capacity := 500
+	pvz := &pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Address: &address, Capacity: &capacity}
+	params := []interface{}{pvz.Id, pvz.Address, pvz.WorkingHours, pvz.Capacity, pvz.Latitude, pvz.Longitude}
+
+	tests := []struct {
+		name        string
+		tag         pgconn.CommandTag
+		execErr     error
+		expectedErr error
+	}{
+		{"Update pvz", pgconn.NewCommandTag("UPDATE 1"), nil, nil},
+		{"Update archived pvz", pgconn.NewCommandTag("UPDATE 0"), nil, custom_errors.ErrPvzArchived},
+		{"Update pvz with error", pgconn.CommandTag{}, errors.New("db error"), custom_errors.ErrUpdatePvz},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAdapter := new(MockAdapter)
+			driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+			mockAdapter.On("Exec", ctx, drivers.QueryUpdatePvz, params).Return(tt.tag, tt.execErr)
+
+			err := driver.UpdatePvz(ctx, pvz)
+
+			assert.Equal(t, tt.expectedErr, err)
+			mockAdapter.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdatePvzStatus(t *testing.T) {
+	ctx := context.Background()
+	id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	params := []interface{}{id, pvz_model.Active, pvz_model.Inactive}
+
+	tests := []struct {
+		name        string
+		tag         pgconn.CommandTag
+		execErr     error
+		expectedErr error
+	}{
+		{"Update pvz status", pgconn.NewCommandTag("UPDATE 1"), nil, nil},
+		{"Update pvz status changed concurrently", pgconn.NewCommandTag("UPDATE 0"), nil, custom_errors.ErrPvzChanged},
+		{"Update pvz status with error", pgconn.CommandTag{}, errors.New("db error"), custom_errors.ErrUpdatePvz},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAdapter := new(MockAdapter)
+			driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+			require.NotContains(t, drivers.QueryUpdatePvzStatus, "RETURNING")
+			mockAdapter.On("Exec", ctx, drivers.QueryUpdatePvzStatus, params).Return(tt.tag, tt.execErr)
+
+			err := driver.UpdatePvzStatus(ctx, id, pvz_model.Active, pvz_model.Inactive)
+
+			assert.Equal(t, tt.expectedErr, err)
+			mockAdapter.AssertExpectations(t)
+		})
+	}
+}
+
+func TestArchivePvz(t *testing.T) {
+	ctx := context.Background()
+	id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	archivedAt := time.Now()
+
+	mockPvzStatus := func(mockTx *MockTx, status pvz_model.PvzStatus, err error) {
+		mockRow := new(MockRow)
+		mockTx.On("QueryRow", ctx, drivers.QueryGetPvzStatusForUpdate, []interface{}{id}).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*pvz_model.PvzStatus")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*pvz_model.PvzStatus)) = status
+			}).Return(err)
+	}
+
+	mockOpenReception := func(mockTx *MockTx, exists bool) {
+		mockRow := new(MockRow)
+		mockTx.On("QueryRow", ctx, drivers.QueryExistsOpenReception, []interface{}{id}).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*bool")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*bool)) = exists
+			}).Return(nil)
+	}
+
+	t.Run("Archive pvz", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockPvzStatus(mockTx, pvz_model.Inactive, nil)
+		mockOpenReception(mockTx, false)
+		mockTx.On("Exec", ctx, drivers.QueryArchivePvz, []interface{}{id, archivedAt}).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+		mockTx.On("Commit", ctx).Return(nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.ArchivePvz(ctx, id, archivedAt)
+
+		require.NoError(t, err)
+		mockAdapter.AssertExpectations(t)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("Archive missing pvz", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockPvzStatus(mockTx, "", pgx.ErrNoRows)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.ArchivePvz(ctx, id, archivedAt)
+
+		assert.Equal(t, custom_errors.ErrNoPvz, err)
+		mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryArchivePvz, mock.Anything)
+	})
+
+	t.Run("Archive archived pvz", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockPvzStatus(mockTx, pvz_model.Archived, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.ArchivePvz(ctx, id, archivedAt)
+
+		assert.Equal(t, custom_errors.ErrPvzArchived, err)
+		mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryArchivePvz, mock.Anything)
+	})
+
+	t.Run("Archive pvz with open reception", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockPvzStatus(mockTx, pvz_model.Active, nil)
+		mockOpenReception(mockTx, true)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.ArchivePvz(ctx, id, archivedAt)
+
+		assert.Equal(t, custom_errors.ErrPvzOpenReception, err)
+		mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryArchivePvz, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestUpdatePvzCapacity(t *testing.T) {
+	ctx := context.Background()
+	id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	typeCapacities := map[product_model.ProductType]int{product_model.Shoes: 20}
+
+	t.Run("Update pvz capacity", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("Exec", ctx, drivers.QueryUpdatePvzStorageHours, []interface{}{id, 48}).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+		mockTx.On("Exec", ctx, drivers.QueryDeletePvzTypeCapacities, []interface{}{id}).Return(pgconn.NewCommandTag("DELETE 2"), nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreatePvzTypeCapacity, []interface{}{id, product_model.Shoes, 20}).Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+		mockTx.On("Commit", ctx).Return(nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.UpdatePvzCapacity(ctx, id, 48, typeCapacities)
+
+		require.NoError(t, err)
+		mockAdapter.AssertExpectations(t)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("Update archived pvz capacity", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("Exec", ctx, drivers.QueryUpdatePvzStorageHours, []interface{}{id, 48}).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.UpdatePvzCapacity(ctx, id, 48, typeCapacities)
+
+		assert.Equal(t, custom_errors.ErrPvzArchived, err)
+		mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryDeletePvzTypeCapacities, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("Update capacity with unknown product type", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("Exec", ctx, drivers.QueryUpdatePvzStorageHours, []interface{}{id, 48}).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+		mockTx.On("Exec", ctx, drivers.QueryDeletePvzTypeCapacities, []interface{}{id}).Return(pgconn.NewCommandTag("DELETE 0"), nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreatePvzTypeCapacity, mock.Anything).
+			Return(pgconn.CommandTag{}, &pgconn.PgError{Code: drivers.ForeignKeyViolationCode})
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.UpdatePvzCapacity(ctx, id, 48, typeCapacities)
+
+		assert.Equal(t, custom_errors.ErrProductType, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestGetAllPvzOccupancy(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("Get all pvz occupancy", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRows := new(MockRows)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		capacity := 100
+
+		mockAdapter.On("Query", ctx, drivers.QueryCountAllPvzOccupancy, []interface{}{now}).Return(mockRows, nil)
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("**int"),
+			mock.AnythingOfType("*int"), mock.AnythingOfType("*int")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*pgtype.UUID) = id
+				*args.Get(1).(**int) = &capacity
+				*args.Get(2).(*int) = 72
+				*args.Get(3).(*int) = 37
+			}).
+			Return(nil)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(nil)
+
+		occupancyList, err := driver.GetAllPvzOccupancy(ctx, now)
+
+		require.NoError(t, err)
+		require.Len(t, occupancyList, 1)
+		assert.Equal(t, id, occupancyList[0].PvzId)
+		assert.Equal(t, capacity, *occupancyList[0].Capacity)
+		assert.Equal(t, 72, occupancyList[0].StorageHours)
+		assert.Equal(t, 37, occupancyList[0].Occupied)
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Get all pvz occupancy with db error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Query", ctx, drivers.QueryCountAllPvzOccupancy, []interface{}{now}).
+			Return((*MockRows)(nil), errors.New("db error"))
+
+		occupancyList, err := driver.GetAllPvzOccupancy(ctx, now)
+
+		assert.Nil(t, occupancyList)
+		assert.Equal(t, custom_errors.ErrGetPvzOccupancy, err)
+		mockAdapter.AssertExpectations(t)
+	})
+}
+
 func TestGetPvzById(t *testing.T) {
 	ctx := context.Background()
 
@@ -80,7 +349,10 @@ func TestGetPvzById(t *testing.T) {
 
 		params := []interface{}{id}
 		mockAdapter.On("QueryRow", ctx, drivers.QueryGetPvzById, params).Return(mockRow)
-		mockRow.On("Scan", mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City")).
+		mockRow.On("Scan", mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City"),
+			mock.AnythingOfType("**string"), mock.AnythingOfType("**string"), mock.AnythingOfType("**int"),
+			mock.AnythingOfType("*pvz_model.PvzStatus"), mock.AnythingOfType("**time.Time"),
+			mock.AnythingOfType("**float64"), mock.AnythingOfType("**float64")).
 			Run(func(args mock.Arguments) {
 				*(args.Get(0).(*time.Time)) = registrationDate
 				*(args.Get(1).(*pvz_model.City)) = city
@@ -106,7 +378,10 @@ func TestGetPvzById(t *testing.T) {
 
 		params := []interface{}{id}
 		mockAdapter.On("QueryRow", ctx, drivers.QueryGetPvzById, params).Return(mockRow)
-		mockRow.On("Scan", mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City")).
+		mockRow.On("Scan", mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City"),
+			mock.AnythingOfType("**string"), mock.AnythingOfType("**string"), mock.AnythingOfType("**int"),
+			mock.AnythingOfType("*pvz_model.PvzStatus"), mock.AnythingOfType("**time.Time"),
+			mock.AnythingOfType("**float64"), mock.AnythingOfType("**float64")).
 			Return(pgx.ErrNoRows)
 
 		pvz, err := driver.GetPvzById(ctx, id)
@@ -126,7 +401,10 @@ func TestGetPvzById(t *testing.T) {
 
 		params := []interface{}{id}
 		mockAdapter.On("QueryRow", ctx, drivers.QueryGetPvzById, params).Return(mockRow)
-		mockRow.On("Scan", mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City")).
+		mockRow.On("Scan", mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City"),
+			mock.AnythingOfType("**string"), mock.AnythingOfType("**string"), mock.AnythingOfType("**int"),
+			mock.AnythingOfType("*pvz_model.PvzStatus"), mock.AnythingOfType("**time.Time"),
+			mock.AnythingOfType("**float64"), mock.AnythingOfType("**float64")).
 			Return(errors.New("db error"))
 
 		pvz, err := driver.GetPvzById(ctx, id)
@@ -155,7 +433,10 @@ func TestGetAllPvz(t *testing.T) {
 
 		mockAdapter.On("Query", ctx, drivers.QueryGetAllPvz).Return(mockRows, nil)
 		mockRows.On("Next").Return(true).Once()
-		mockRows.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City")).
+		mockRows.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City"),
+			mock.AnythingOfType("**string"), mock.AnythingOfType("**string"), mock.AnythingOfType("**int"),
+			mock.AnythingOfType("*pvz_model.PvzStatus"), mock.AnythingOfType("**time.Time"),
+			mock.AnythingOfType("**float64"), mock.AnythingOfType("**float64")).
 			Run(func(args mock.Arguments) {
 				*(args.Get(0).(*pgtype.UUID)) = id1
 				*(args.Get(1).(*time.Time)) = date1
@@ -163,7 +444,10 @@ func TestGetAllPvz(t *testing.T) {
 			}).
 			Return(nil).Once()
 		mockRows.On("Next").Return(true).Once()
-		mockRows.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City")).
+		mockRows.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City"),
+			mock.AnythingOfType("**string"), mock.AnythingOfType("**string"), mock.AnythingOfType("**int"),
+			mock.AnythingOfType("*pvz_model.PvzStatus"), mock.AnythingOfType("**time.Time"),
+			mock.AnythingOfType("**float64"), mock.AnythingOfType("**float64")).
 			Run(func(args mock.Arguments) {
 				*(args.Get(0).(*pgtype.UUID)) = id2
 				*(args.Get(1).(*time.Time)) = date2
@@ -171,6 +455,7 @@ func TestGetAllPvz(t *testing.T) {
 			}).
 			Return(nil).Once()
 		mockRows.On("Next").Return(false)
+		mockRows.On("Err").Return(nil)
 		mockRows.On("Close").Return()
 
 		pvzList, err := driver.GetAllPvz(ctx)
@@ -187,6 +472,22 @@ func TestGetAllPvz(t *testing.T) {
 		mockRows.AssertExpectations(t)
 	})
 
+	t.Run("Get all pvz with rows error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRows := new(MockRows)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Query", ctx, drivers.QueryGetAllPvz).Return(mockRows, nil)
+		mockRows.On("Next").Return(false)
+		mockRows.On("Err").Return(errors.New("connection reset"))
+		mockRows.On("Close").Return()
+
+		pvzList, err := driver.GetAllPvz(ctx)
+
+		assert.Equal(t, custom_errors.ErrGetPvz, err)
+		assert.Nil(t, pvzList)
+	})
+
 	t.Run("Get all pvz with query error", func(t *testing.T) {
 		mockAdapter := new(MockAdapter)
 		driver := pvz_driver.NewPvzDriver(mockAdapter)
@@ -207,7 +508,10 @@ func TestGetAllPvz(t *testing.T) {
 
 		mockAdapter.On("Query", ctx, drivers.QueryGetAllPvz).Return(mockRows, nil)
 		mockRows.On("Next").Return(true)
-		mockRows.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City")).
+		mockRows.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City"),
+			mock.AnythingOfType("**string"), mock.AnythingOfType("**string"), mock.AnythingOfType("**int"),
+			mock.AnythingOfType("*pvz_model.PvzStatus"), mock.AnythingOfType("**time.Time"),
+			mock.AnythingOfType("**float64"), mock.AnythingOfType("**float64")).
 			Return(errors.New("scan error"))
 		mockRows.On("Close").Return()
 
@@ -233,15 +537,17 @@ func TestGetPvzFullInfo(t *testing.T) {
 		startTime := time.Now().Add(-7 * 24 * time.Hour)
 		endTime := time.Now()
 
-		query, params := getTestQueryGetPvz(limit, offset, &startTime, &endTime)
-
-		mockAdapter.On("Query", ctx, query, params).Return(mockRows, nil)
+		query := fmt.Sprintf(drivers.QueryGetPvz, drivers.QueryGetFilteredPvz,
+			"ORDER BY registration_date, id LIMIT $1 OFFSET $2",
+			" AND r.reception_time >= $3 AND r.reception_time <= $4",
+			"p.registration_date, p.id")
+		mockAdapter.On("Query", ctx, query, []interface{}{limit, offset, startTime, endTime}).Return(mockRows, nil)
 
 		setupMockRowsForGetPvzFullInfo(mockRows)
 
 		mockRows.On("Close").Return()
 
-		result, err := driver.GetPvzFullInfo(ctx, limit, offset, &startTime, &endTime)
+		result, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: limit, Offset: offset, StartInterval: &startTime, EndInterval: &endTime})
 
 		require.NoError(t, err)
 		assert.NotEmpty(t, result)
@@ -249,18 +555,103 @@ func TestGetPvzFullInfo(t *testing.T) {
 		mockRows.AssertExpectations(t)
 	})
 
-	t.Run("Get pvz full info with query error", func(t *testing.T) {
+	t.Run("Get pvz full info groups rows in order", func(t *testing.T) {
 		mockAdapter := new(MockAdapter)
+		mockRows := new(MockRows)
 		driver := pvz_driver.NewPvzDriver(mockAdapter)
 
 		limit := uint32(10)
 		offset := uint32(0)
 
-		query, params := getTestQueryGetPvz(limit, offset, nil, nil)
+		query := fmt.Sprintf(drivers.QueryGetPvz, drivers.QueryGetFilteredPvz,
+			"ORDER BY registration_date, id LIMIT $1 OFFSET $2", "", "p.registration_date, p.id")
+		mockAdapter.On("Query", ctx, query, []interface{}{limit, offset}).Return(mockRows, nil)
+
+		firstPvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		secondPvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		newReceptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		oldReceptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		firstProductId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		secondProductId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		registrationDate := time.Now().Add(-30 * 24 * time.Hour)
+		newReceptionTime := time.Now().Add(-24 * time.Hour)
+		oldReceptionTime := time.Now().Add(-48 * time.Hour)
+		closeStatus := reception_model.Close
+		productType := product_model.Shoes
+
+		type row struct {
+			pvzId, receptionId, productId pgtype.UUID
+			receptionTime                 *time.Time
+		}
+		rowsData := []row{
+			{firstPvzId, newReceptionId, firstProductId, &newReceptionTime},
+			{firstPvzId, newReceptionId, secondProductId, &newReceptionTime},
+			{firstPvzId, oldReceptionId, pgtype.UUID{}, &oldReceptionTime},
+			{secondPvzId, pgtype.UUID{}, pgtype.UUID{}, nil},
+		}
+
+		for _, r := range rowsData {
+			r := r
+			mockRows.On("Next").Return(true).Once()
+			mockRows.On("Scan",
+				mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything,
+			).Run(func(args mock.Arguments) {
+				*(args.Get(0).(*pgtype.UUID)) = r.pvzId
+				*(args.Get(1).(*time.Time)) = registrationDate
+				*(args.Get(2).(*pvz_model.City)) = pvz_model.Kazan
+				*(args.Get(6).(*pvz_model.PvzStatus)) = pvz_model.Active
+				*(args.Get(10).(*time.Time)) = registrationDate
+				*(args.Get(11).(*pgtype.UUID)) = r.receptionId
+				if r.receptionId.Valid {
+					*(args.Get(12).(**time.Time)) = r.receptionTime
+					*(args.Get(13).(**reception_model.ReceptionStatus)) = &closeStatus
+				}
+				*(args.Get(14).(*pgtype.UUID)) = r.productId
+				if r.productId.Valid {
+					*(args.Get(15).(**time.Time)) = r.receptionTime
+					*(args.Get(16).(**product_model.ProductType)) = &productType
+				}
+			}).Return(nil).Once()
+		}
+		mockRows.On("Next").Return(false)
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
 
-		mockAdapter.On("Query", ctx, query, params).Return((*MockRows)(nil), errors.New("db error"))
+		result, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: limit, Offset: offset})
 
-		result, err := driver.GetPvzFullInfo(ctx, limit, offset, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, firstPvzId, result[0].Pvz.Id)
+		require.Len(t, result[0].Receptions, 2)
+		assert.Equal(t, newReceptionId, result[0].Receptions[0].Reception.Id)
+		assert.Equal(t, newReceptionTime, result[0].Receptions[0].Reception.ReceptionTime)
+		assert.Equal(t, firstPvzId, result[0].Receptions[0].Reception.PvzId)
+		require.Len(t, result[0].Receptions[0].Products, 2)
+		assert.Equal(t, firstProductId, result[0].Receptions[0].Products[0].Id)
+		assert.Equal(t, secondProductId, result[0].Receptions[0].Products[1].Id)
+		assert.Equal(t, oldReceptionId, result[0].Receptions[1].Reception.Id)
+		assert.Empty(t, result[0].Receptions[1].Products)
+		assert.Equal(t, secondPvzId, result[1].Pvz.Id)
+		assert.Empty(t, result[1].Receptions)
+	})
+
+	t.Run("Get pvz full info with query error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		limit := uint32(10)
+		offset := uint32(0)
+
+		query := fmt.Sprintf(drivers.QueryGetPvz, drivers.QueryGetFilteredPvz,
+			"ORDER BY registration_date, id LIMIT $1 OFFSET $2", "", "p.registration_date, p.id")
+		mockAdapter.On("Query", ctx, query, []interface{}{limit, offset}).
+			Return((*MockRows)(nil), errors.New("db error"))
+
+		result, err := driver.GetPvzFullInfo(ctx, pvz_model.PvzFilter{Limit: limit, Offset: offset})
 
 		assert.Nil(t, result)
 		assert.Equal(t, custom_errors.ErrGetPvz, err)
@@ -268,39 +659,220 @@ func TestGetPvzFullInfo(t *testing.T) {
 	})
 }
 
-func getTestQueryGetPvz(limit, offset uint32, startInterval, endInterval *time.Time) (string, []interface{}) {
-	query := drivers.QueryGetPvz
-	var params []interface{}
-	paramCnt := 0
+func TestGetPvzFullInfoWithFilters(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	mockRows := new(MockRows)
+	driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+	startTime := time.Now().Add(-7 * 24 * time.Hour)
+	city := pvz_model.Kazan
+	status := reception_model.InProgress
+	productType := product_model.Shoes
+	minProducts := 3
+	cursor := &pvz_model.PvzCursor{SortValue: time.Now(), Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}}
+
+	filter := pvz_model.PvzFilter{
+		Limit:           uint32(11),
+		StartInterval:   &startTime,
+		City:            &city,
+		ReceptionStatus: &status,
+		ProductType:     &productType,
+		MinProducts:     &minProducts,
+		SortBy:          pvz_model.SortByLastActivity,
+		After:           cursor,
+	}
 
-	if startInterval != nil || endInterval != nil {
-		query += " WHERE "
+	filteredQuery := drivers.QueryGetFilteredPvz + " WHERE p.city = $1 AND EXISTS (SELECT 1 FROM receptions r WHERE " +
+		"r.pvz_id = p.id AND r.reception_time >= $2 AND r.status = $3 " +
+		"AND EXISTS (SELECT 1 FROM products pr WHERE pr.reception_id = r.id AND pr.deleted_at IS NULL AND pr.product_type = $4) " +
+		"AND (SELECT COUNT(*) FROM products pr WHERE pr.reception_id = r.id AND pr.deleted_at IS NULL) >= $5)"
+	query := fmt.Sprintf(drivers.QueryGetPvz, filteredQuery,
+		"WHERE (last_activity, id) < ($6, $7::uuid) ORDER BY last_activity DESC, id DESC LIMIT $8 OFFSET $9",
+		" AND r.reception_time >= $10",
+		"p.last_activity DESC, p.id DESC")
+	params := []interface{}{city, startTime, status, productType, minProducts,
+		cursor.SortValue, cursor.Id, uint32(11), uint32(0), startTime}
+
+	mockAdapter.On("Query", ctx, query, params).Return(mockRows, nil)
+	mockRows.On("Next").Return(false)
+	mockRows.On("Err").Return(nil)
+	mockRows.On("Close").Return()
 
-		if startInterval != nil {
-			paramCnt++
-			query += fmt.Sprintf("r.reception_time >= $%d", paramCnt)
-			params = append(params, *startInterval)
-		}
+	result, err := driver.GetPvzFullInfo(ctx, filter)
 
-		if startInterval != nil && endInterval != nil {
-			query += " AND "
-		}
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	mockAdapter.AssertExpectations(t)
+
+	countRow := new(MockRow)
+	mockAdapter.On("QueryRow", ctx, fmt.Sprintf(drivers.QueryCountPvz, filteredQuery), params[:5]).Return(countRow)
+	countRow.On("Scan", mock.AnythingOfType("*int")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*int) = 1
+		}).
+		Return(nil)
+
+	filter.After = nil
+	total, err := driver.CountPvz(ctx, filter)
 
-		if endInterval != nil {
-			paramCnt++
-			query += fmt.Sprintf("r.reception_time <= $%d", paramCnt)
-			params = append(params, *endInterval)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	mockAdapter.AssertExpectations(t)
+}
+
+func TestGetPvzPage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get pvz page after cursor", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRows := new(MockRows)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		cursor := &pvz_model.PvzCursor{
+			SortValue: time.Now().Add(-48 * time.Hour),
+			Id:        pgtype.UUID{Bytes: uuid.New(), Valid: true},
 		}
-	}
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		date := time.Now().Add(-24 * time.Hour)
 
-	paramCnt++
-	query += fmt.Sprintf(" ORDER BY p.id, r.reception_time DESC LIMIT $%d", paramCnt)
-	params = append(params, limit)
-	paramCnt++
-	query += fmt.Sprintf(" OFFSET $%d", paramCnt)
-	params = append(params, offset)
+		mockAdapter.On("Query", ctx, drivers.QueryGetPvzPage, []interface{}{uint32(5), &cursor.SortValue, &cursor.Id}).
+			Return(mockRows, nil)
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City"),
+			mock.AnythingOfType("**string"), mock.AnythingOfType("**string"), mock.AnythingOfType("**int"),
+			mock.AnythingOfType("*pvz_model.PvzStatus"), mock.AnythingOfType("**time.Time"),
+			mock.AnythingOfType("**float64"), mock.AnythingOfType("**float64")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*pgtype.UUID)) = id
+				*(args.Get(1).(*time.Time)) = date
+				*(args.Get(2).(*pvz_model.City)) = pvz_model.Kazan
+			}).
+			Return(nil).Once()
+		mockRows.On("Next").Return(false)
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
 
-	return query, params
+		pvzList, err := driver.GetPvzPage(ctx, 5, cursor)
+
+		require.NoError(t, err)
+		require.Len(t, pvzList, 1)
+		assert.Equal(t, id, pvzList[0].Id)
+		assert.Equal(t, pvz_model.Kazan, pvzList[0].City)
+		mockAdapter.AssertExpectations(t)
+		mockRows.AssertExpectations(t)
+	})
+
+	t.Run("Get first pvz page with query error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Query", ctx, drivers.QueryGetPvzPage, []interface{}{uint32(5), (*time.Time)(nil), (*pgtype.UUID)(nil)}).
+			Return((*MockRows)(nil), errors.New("db error"))
+
+		pvzList, err := driver.GetPvzPage(ctx, 5, nil)
+
+		assert.Nil(t, pvzList)
+		assert.Equal(t, custom_errors.ErrGetPvz, err)
+		mockAdapter.AssertExpectations(t)
+	})
+}
+
+func TestGetNearbyPvz(t *testing.T) {
+	ctx := context.Background()
+	params := []interface{}{55.75, 37.61, 5000.0, uint32(10)}
+
+	t.Run("Get nearby pvz", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRows := new(MockRows)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		latitude, longitude := 55.76, 37.62
+
+		mockAdapter.On("Query", ctx, drivers.QueryGetNearbyPvz, params).Return(mockRows, nil)
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"), mock.AnythingOfType("*pvz_model.City"),
+			mock.AnythingOfType("**string"), mock.AnythingOfType("**string"), mock.AnythingOfType("**int"),
+			mock.AnythingOfType("*pvz_model.PvzStatus"), mock.AnythingOfType("**time.Time"),
+			mock.AnythingOfType("**float64"), mock.AnythingOfType("**float64"), mock.AnythingOfType("*float64")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*pgtype.UUID)) = id
+				*(args.Get(2).(*pvz_model.City)) = pvz_model.Moscow
+				*(args.Get(6).(*pvz_model.PvzStatus)) = pvz_model.Active
+				*(args.Get(8).(**float64)) = &latitude
+				*(args.Get(9).(**float64)) = &longitude
+				*(args.Get(10).(*float64)) = 1287.5
+			}).
+			Return(nil).Once()
+		mockRows.On("Next").Return(false)
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
+
+		pvzList, err := driver.GetNearbyPvz(ctx, 55.75, 37.61, 5000, 10)
+
+		require.NoError(t, err)
+		require.Len(t, pvzList, 1)
+		assert.Equal(t, id, pvzList[0].Pvz.Id)
+		assert.Equal(t, &latitude, pvzList[0].Pvz.Latitude)
+		assert.Equal(t, &longitude, pvzList[0].Pvz.Longitude)
+		assert.Equal(t, 1287.5, pvzList[0].Distance)
+		mockAdapter.AssertExpectations(t)
+		mockRows.AssertExpectations(t)
+	})
+
+	t.Run("Get nearby pvz with query error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("Query", ctx, drivers.QueryGetNearbyPvz, params).
+			Return((*MockRows)(nil), errors.New("db error"))
+
+		pvzList, err := driver.GetNearbyPvz(ctx, 55.75, 37.61, 5000, 10)
+
+		assert.Nil(t, pvzList)
+		assert.Equal(t, custom_errors.ErrGetPvz, err)
+		mockAdapter.AssertExpectations(t)
+	})
+}
+
+func TestCountPvz(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Count pvz", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("QueryRow", ctx, fmt.Sprintf(drivers.QueryCountPvz, drivers.QueryGetFilteredPvz), []interface{}(nil)).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*int")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*int) = 42
+			}).
+			Return(nil)
+
+		total, err := driver.CountPvz(ctx, pvz_model.PvzFilter{})
+
+		require.NoError(t, err)
+		assert.Equal(t, 42, total)
+		mockAdapter.AssertExpectations(t)
+		mockRow.AssertExpectations(t)
+	})
+
+	t.Run("Count pvz with db error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		driver := pvz_driver.NewPvzDriver(mockAdapter)
+
+		mockAdapter.On("QueryRow", ctx, fmt.Sprintf(drivers.QueryCountPvz, drivers.QueryGetFilteredPvz), []interface{}(nil)).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*int")).Return(errors.New("db error"))
+
+		total, err := driver.CountPvz(ctx, pvz_model.PvzFilter{})
+
+		assert.Zero(t, total)
+		assert.Equal(t, custom_errors.ErrGetPvz, err)
+		mockAdapter.AssertExpectations(t)
+	})
 }
 
 func setupMockRowsForGetPvzFullInfo(mockRows *MockRows) {
@@ -319,8 +891,16 @@ func setupMockRowsForGetPvzFullInfo(mockRows *MockRows) {
 	mockRows.On("Next").Return(true).Once()
 	mockRows.On("Scan",
 		mock.AnythingOfType("*pgtype.UUID"),
-		mock.AnythingOfType("**time.Time"),
+		mock.AnythingOfType("*time.Time"),
 		mock.AnythingOfType("*pvz_model.City"),
+		mock.AnythingOfType("**string"),
+		mock.AnythingOfType("**string"),
+		mock.AnythingOfType("**int"),
+		mock.AnythingOfType("*pvz_model.PvzStatus"),
+		mock.AnythingOfType("**time.Time"),
+		mock.AnythingOfType("**float64"),
+		mock.AnythingOfType("**float64"),
+		mock.AnythingOfType("*time.Time"),
 		mock.AnythingOfType("*pgtype.UUID"),
 		mock.AnythingOfType("**time.Time"),
 		mock.AnythingOfType("**reception_model.ReceptionStatus"),
@@ -329,15 +909,18 @@ func setupMockRowsForGetPvzFullInfo(mockRows *MockRows) {
 		mock.AnythingOfType("**product_model.ProductType"),
 	).Run(func(args mock.Arguments) {
 		*(args.Get(0).(*pgtype.UUID)) = pvzId
-		*(args.Get(1).(**time.Time)) = &registrationDate
+		*(args.Get(1).(*time.Time)) = registrationDate
 		*(args.Get(2).(*pvz_model.City)) = pvzCity
-		*(args.Get(3).(*pgtype.UUID)) = receptionId
-		*(args.Get(4).(**time.Time)) = &receptionTime
-		*(args.Get(5).(**reception_model.ReceptionStatus)) = &receptionStatus
-		*(args.Get(6).(*pgtype.UUID)) = productId
-		*(args.Get(7).(**time.Time)) = &addingTime
-		*(args.Get(8).(**product_model.ProductType)) = &productType
+		*(args.Get(6).(*pvz_model.PvzStatus)) = pvz_model.Active
+		*(args.Get(10).(*time.Time)) = addingTime
+		*(args.Get(11).(*pgtype.UUID)) = receptionId
+		*(args.Get(12).(**time.Time)) = &receptionTime
+		*(args.Get(13).(**reception_model.ReceptionStatus)) = &receptionStatus
+		*(args.Get(14).(*pgtype.UUID)) = productId
+		*(args.Get(15).(**time.Time)) = &addingTime
+		*(args.Get(16).(**product_model.ProductType)) = &productType
 	}).Return(nil).Once()
 
 	mockRows.On("Next").Return(false)
+	mockRows.On("Err").Return(nil)
 }
@@ -2,33 +2,81 @@ package drivers
 
 const (
 	createSchema = `
-	CREATE TYPE city AS enum (
-		'Москва',
-		'Санкт-Петербург',
-		'Казань'
-		);
-	
 	CREATE TYPE reception_status AS enum (
 		'in_progress',
-		'close'
+		'close',
+		'auto_closed',
+		'paused',
+		'verified',
+		'cancelled'
 		);
 	
-	CREATE TYPE product_type AS enum (
-		'электроника',
-		'одежда',
-		'обувь'
+	CREATE TYPE pvz_status AS enum (
+		'active',
+		'inactive',
+		'archived'
 		);
-	
+
 	CREATE TYPE user_role AS enum (
 		'employee',
 		'moderator'
 		);
 
+	CREATE TABLE IF NOT EXISTS cities
+	(
+		name       VARCHAR(128) PRIMARY KEY,
+		region     VARCHAR(128) NOT NULL,
+		timezone   VARCHAR(64)  NOT NULL,
+		created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		reception_auto_close_hours INTEGER CHECK (reception_auto_close_hours > 0)
+	);
+
+	INSERT INTO cities (name, region, timezone)
+	VALUES ('Москва', 'Москва', 'Europe/Moscow'),
+		   ('Санкт-Петербург', 'Санкт-Петербург', 'Europe/Moscow'),
+		   ('Казань', 'Республика Татарстан', 'Europe/Moscow');
+
+	CREATE TABLE IF NOT EXISTS product_types
+	(
+		code              VARCHAR(64) PRIMARY KEY,
+		display_name_ru   VARCHAR(128) NOT NULL,
+		display_name_en   VARCHAR(128) NOT NULL,
+		is_fragile        BOOLEAN      NOT NULL DEFAULT FALSE,
+		is_oversize       BOOLEAN      NOT NULL DEFAULT FALSE,
+		max_per_reception INTEGER CHECK (max_per_reception > 0),
+		created_at        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+
+	INSERT INTO product_types (code, display_name_ru, display_name_en, is_fragile, is_oversize)
+	VALUES ('электроника', 'Электроника', 'Electronics', TRUE, FALSE),
+		   ('одежда', 'Одежда', 'Clothes', FALSE, FALSE),
+		   ('обувь', 'Обувь', 'Shoes', FALSE, FALSE);
+
 	CREATE TABLE IF NOT EXISTS pvz
 	(
 		id                UUID PRIMARY KEY,
-		registration_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-		city              city      NOT NULL
+		registration_date TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		city              VARCHAR(128) NOT NULL,
+		address           TEXT,
+		working_hours     TEXT,
+		capacity          INTEGER CHECK (capacity > 0),
+		status            pvz_status   NOT NULL DEFAULT 'active',
+		archived_at       TIMESTAMP,
+		latitude          DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+		longitude         DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+		storage_hours     INTEGER      NOT NULL DEFAULT 72 CHECK (storage_hours > 0),
+		CONSTRAINT pvz_location_check CHECK ((latitude IS NULL) = (longitude IS NULL)),
+		FOREIGN KEY (city) REFERENCES cities (name) ON UPDATE CASCADE
+	);
+
+	CREATE TABLE IF NOT EXISTS pvz_product_type_capacities
+	(
+		pvz_id       UUID        NOT NULL,
+		product_type VARCHAR(64) NOT NULL,
+		capacity     INTEGER     NOT NULL CHECK (capacity > 0),
+		PRIMARY KEY (pvz_id, product_type),
+		FOREIGN KEY (pvz_id) REFERENCES pvz (id) ON DELETE CASCADE,
+		FOREIGN KEY (product_type) REFERENCES product_types (code) ON UPDATE CASCADE ON DELETE CASCADE
 	);
 	
 	CREATE TABLE IF NOT EXISTS receptions
@@ -37,25 +85,146 @@ const (
 		reception_time TIMESTAMP        NOT NULL DEFAULT CURRENT_TIMESTAMP,
 		pvz_id         UUID             NOT NULL,
 		status         reception_status NOT NULL,
+		closed_at      TIMESTAMP,
+		close_reason   TEXT,
+		reopened_at    TIMESTAMP,
 		FOREIGN KEY (pvz_id) REFERENCES pvz (id) ON DELETE CASCADE
 	);
 	
+	CREATE TABLE IF NOT EXISTS  users
+	(
+		id UUID PRIMARY KEY,
+		email VARCHAR(254) UNIQUE NOT NULL,
+		password_hash TEXT NOT NULL,
+		role user_role NOT NULL
+	);
+
 	CREATE TABLE IF NOT EXISTS products
 	(
 		id           UUID PRIMARY KEY,
 		adding_time  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
-		product_type product_type NOT NULL,
+		product_type VARCHAR(64)  NOT NULL,
 		reception_id UUID         NOT NULL,
-		FOREIGN KEY (reception_id) REFERENCES receptions (id) ON DELETE CASCADE
+		barcode      VARCHAR(128),
+		sku          VARCHAR(128),
+		order_id     VARCHAR(128),
+		weight_grams INTEGER CHECK (weight_grams > 0),
+		length_mm    INTEGER CHECK (length_mm > 0),
+		width_mm     INTEGER CHECK (width_mm > 0),
+		height_mm    INTEGER CHECK (height_mm > 0),
+		deleted_at   TIMESTAMP,
+		deleted_by   UUID,
+		CONSTRAINT products_dimensions_check CHECK (
+			(length_mm IS NULL) = (width_mm IS NULL) AND (width_mm IS NULL) = (height_mm IS NULL)
+		),
+		FOREIGN KEY (reception_id) REFERENCES receptions (id) ON DELETE CASCADE,
+		FOREIGN KEY (product_type) REFERENCES product_types (code) ON UPDATE CASCADE,
+		FOREIGN KEY (deleted_by) REFERENCES users (id) ON DELETE SET NULL
 	);
 
-	CREATE TABLE IF NOT EXISTS  users
+	CREATE TABLE IF NOT EXISTS reception_corrections
 	(
-		id UUID PRIMARY KEY,
-		email VARCHAR(254) UNIQUE NOT NULL,
-		password_hash TEXT NOT NULL,
-		role user_role NOT NULL
+		id           UUID PRIMARY KEY,
+		reception_id UUID      NOT NULL,
+		closed_at    TIMESTAMP,
+		reopened_by  UUID,
+		reopened_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		reason       TEXT      NOT NULL,
+		FOREIGN KEY (reception_id) REFERENCES receptions (id) ON DELETE CASCADE,
+		FOREIGN KEY (reopened_by) REFERENCES users (id) ON DELETE SET NULL
 	);
+
+	CREATE TYPE outbox_status AS enum (
+		'pending',
+		'published',
+		'failed'
+	);
+
+	CREATE TABLE IF NOT EXISTS outbox
+	(
+		id              UUID PRIMARY KEY,
+		event_type      VARCHAR(64)   NOT NULL,
+		payload         JSONB         NOT NULL,
+		created_at      TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		status          outbox_status NOT NULL DEFAULT 'pending',
+		attempts        INTEGER       NOT NULL DEFAULT 0,
+		next_attempt_at TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		published_at    TIMESTAMP,
+		last_error      TEXT
+	);
+
+	CREATE INDEX idx_outbox_status_and_next_attempt_at ON outbox (status, next_attempt_at);
+
+	CREATE TYPE webhook_delivery_status AS enum (
+		'pending',
+		'delivered',
+		'dead'
+	);
+
+	CREATE TABLE IF NOT EXISTS webhook_subscriptions
+	(
+		id          UUID PRIMARY KEY,
+		url         TEXT          NOT NULL,
+		secret      TEXT          NOT NULL,
+		event_types VARCHAR(64)[] NOT NULL,
+		pvz_id      UUID,
+		created_at  TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (pvz_id) REFERENCES pvz (id) ON DELETE CASCADE
+	);
+
+	CREATE TABLE IF NOT EXISTS webhook_deliveries
+	(
+		id              UUID PRIMARY KEY,
+		subscription_id UUID                    NOT NULL,
+		event_id        UUID                    NOT NULL,
+		event_type      VARCHAR(64)             NOT NULL,
+		payload         JSONB                   NOT NULL,
+		status          webhook_delivery_status NOT NULL DEFAULT 'pending',
+		attempts        INTEGER                 NOT NULL DEFAULT 0,
+		next_attempt_at TIMESTAMP               NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		created_at      TIMESTAMP               NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		delivered_at    TIMESTAMP,
+		last_error      TEXT,
+		UNIQUE (subscription_id, event_id),
+		FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id) ON DELETE CASCADE
+	);
+
+	CREATE TABLE IF NOT EXISTS refresh_tokens
+	(
+		id                UUID PRIMARY KEY,
+		family_id         UUID      NOT NULL,
+		user_id           UUID      NOT NULL,
+		token_hash        BYTEA     NOT NULL UNIQUE,
+		access_jti        UUID      NOT NULL UNIQUE,
+		access_expires_at TIMESTAMP NOT NULL,
+		created_at        TIMESTAMP NOT NULL,
+		expires_at        TIMESTAMP NOT NULL,
+		revoked_at        TIMESTAMP,
+		FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+	);
+
+	CREATE TABLE IF NOT EXISTS revoked_tokens
+	(
+		jti        UUID PRIMARY KEY,
+		expires_at TIMESTAMP NOT NULL
+	);
+
+	CREATE INDEX idx_webhook_deliveries_status_and_next_attempt_at ON webhook_deliveries (status, next_attempt_at);
+	CREATE INDEX idx_webhook_deliveries_subscription_id_and_created_at ON webhook_deliveries (subscription_id, created_at);
+
+	CREATE INDEX idx_pvz_registration_date_and_id ON pvz (registration_date, id);
+	CREATE INDEX idx_pvz_city ON pvz (city);
+	CREATE INDEX idx_pvz_status ON pvz (status);
+	CREATE INDEX idx_pvz_latitude ON pvz (latitude) WHERE latitude IS NOT NULL AND status = 'active';
+	CREATE INDEX idx_products_reception_id_and_product_type ON products (reception_id, product_type);
+	CREATE INDEX idx_products_barcode ON products (barcode) WHERE barcode IS NOT NULL AND deleted_at IS NULL;
+	CREATE INDEX idx_reception_corrections_reception_id_and_reopened_at ON reception_corrections (reception_id, reopened_at);
+	CREATE INDEX idx_receptions_status_and_reception_time ON receptions (status, reception_time);
+	CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+	CREATE INDEX idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+	CREATE INDEX idx_refresh_tokens_expires_at ON refresh_tokens (expires_at);
+	CREATE INDEX idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
+	CREATE UNIQUE INDEX idx_receptions_single_open_per_pvz ON receptions (pvz_id) WHERE status IN ('in_progress', 'paused');
 `
 	queryCreatePvz = `
 	INSERT INTO pvz (id, registration_date, city) 
@@ -95,6 +264,13 @@ const (
 	    reception_id
 	FROM products
 	WHERE id = $1
+`
+	queryGetProductDeletion = `
+	SELECT 
+	    deleted_at,
+	    deleted_by
+	FROM products
+	WHERE id = $1
 `
 	queryGetUser = `
 	SELECT 
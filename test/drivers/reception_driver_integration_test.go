@@ -2,13 +2,16 @@ package drivers
 
 import (
 	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/reception_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"sync"
 	"testing"
 	"time"
 )
@@ -24,6 +27,9 @@ func TestCreateReceptionIntegration(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, pvzIds)
 
+	_, err = driver.CloseReception(ctx, pvzIds[0])
+	require.NoError(t, err)
+
 	idBytes := uuid.New()
 	id := pgtype.UUID{Bytes: idBytes, Valid: true}
 	receptionTime := time.Now().UTC()
@@ -48,6 +54,85 @@ func TestCreateReceptionIntegration(t *testing.T) {
 	assert.Equal(t, receptionTime, dbTime)
 	assert.Equal(t, pvzIds[0], dbPvzId)
 	assert.Equal(t, string(status), dbStatus)
+
+	t.Run("Create second open reception", func(t *testing.T) {
+		err := driver.CreateReception(ctx, &reception_model.Reception{
+			Id:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			ReceptionTime: time.Now().UTC(),
+			PvzId:         pvzIds[0],
+			Status:        reception_model.InProgress,
+		})
+
+		assert.Equal(t, custom_errors.ErrInProgressReception, err)
+	})
+
+	t.Run("Create reception in inactive pvz", func(t *testing.T) {
+		_, err := driver.CloseReception(ctx, pvzIds[1])
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, "UPDATE pvz SET status = 'inactive' WHERE id = $1", pvzIds[1])
+		require.NoError(t, err)
+
+		err = driver.CreateReception(ctx, &reception_model.Reception{
+			Id:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			ReceptionTime: time.Now().UTC(),
+			PvzId:         pvzIds[1],
+			Status:        reception_model.InProgress,
+		})
+
+		assert.Equal(t, custom_errors.ErrPvzInactive, err)
+	})
+}
+
+func TestCreateReceptionConcurrentIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := reception_driver.NewReceptionDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, _, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+
+	_, err = driver.CloseReception(ctx, pvzIds[0])
+	require.NoError(t, err)
+
+	const workers = 50
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make(chan error, workers)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			errs <- driver.CreateReception(ctx, &reception_model.Reception{
+				Id:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				ReceptionTime: time.Now().UTC(),
+				PvzId:         pvzIds[0],
+				Status:        reception_model.InProgress,
+			})
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	created := 0
+	for err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.Equal(t, custom_errors.ErrInProgressReception, err)
+	}
+	assert.Equal(t, 1, created)
+
+	var openCount int
+	err = pool.QueryRow(ctx, "SELECT COUNT(*) FROM receptions WHERE pvz_id = $1 AND status IN ('in_progress', 'paused')", pvzIds[0]).Scan(&openCount)
+	require.NoError(t, err)
+	assert.Equal(t, 1, openCount)
 }
 
 func TestCloseReceptionIntegration(t *testing.T) {
@@ -122,3 +207,306 @@ func TestGetLastReceptionStatusIntegration(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+func TestReopenReceptionIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := reception_driver.NewReceptionDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, receptionIds, userIds, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+	require.NotEmpty(t, pvzIds)
+	require.NotEmpty(t, receptionIds)
+
+	newCorrection := func(receptionId pgtype.UUID) *reception_model.ReceptionCorrection {
+		return &reception_model.ReceptionCorrection{
+			Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			ReceptionId: receptionId,
+			ReopenedBy:  userIds[1],
+			ReopenedAt:  time.Now().UTC(),
+			Reason:      "miscounted",
+		}
+	}
+
+	t.Run("Reopen closed last reception", func(t *testing.T) {
+		closed, err := driver.CloseReception(ctx, pvzIds[1])
+		require.NoError(t, err)
+		require.Equal(t, receptionIds[1], closed.Id)
+
+		correction := newCorrection(receptionIds[1])
+		result, err := driver.ReopenReception(ctx, correction, reception_model.Close)
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionIds[1], result.Id)
+		assert.Equal(t, pvzIds[1], result.PvzId)
+		assert.Equal(t, reception_model.InProgress, result.Status)
+		require.NotNil(t, correction.ClosedAt)
+
+		status, err := driver.GetLastReceptionStatus(ctx, pvzIds[1])
+		require.NoError(t, err)
+		assert.Equal(t, reception_model.InProgress, *status)
+
+		corrections, err := driver.GetReceptionCorrections(ctx, receptionIds[1])
+		require.NoError(t, err)
+		require.Len(t, corrections, 1)
+		assert.Equal(t, correction.Id, corrections[0].Id)
+		assert.Equal(t, userIds[1], corrections[0].ReopenedBy)
+		assert.Equal(t, "miscounted", corrections[0].Reason)
+		require.NotNil(t, corrections[0].ClosedAt)
+		assert.WithinDuration(t, *correction.ClosedAt, *corrections[0].ClosedAt, time.Millisecond)
+	})
+
+	t.Run("Reopen reception changed concurrently", func(t *testing.T) {
+		result, err := driver.ReopenReception(ctx, newCorrection(receptionIds[1]), reception_model.Close)
+
+		assert.Nil(t, result)
+		assert.Equal(t, custom_errors.ErrReceptionChanged, err)
+	})
+
+	t.Run("Reopen reception with newer reception", func(t *testing.T) {
+		result, err := driver.ReopenReception(ctx, newCorrection(receptionIds[2]), reception_model.Close)
+
+		assert.Nil(t, result)
+		assert.Equal(t, custom_errors.ErrNewerReception, err)
+
+		corrections, err := driver.GetReceptionCorrections(ctx, receptionIds[2])
+		require.NoError(t, err)
+		assert.Empty(t, corrections)
+	})
+
+	t.Run("Reopen not existing reception", func(t *testing.T) {
+		result, err := driver.ReopenReception(ctx, newCorrection(pgtype.UUID{Bytes: uuid.New(), Valid: true}), reception_model.Close)
+
+		assert.Nil(t, result)
+		assert.Equal(t, custom_errors.ErrReceptionNotFound, err)
+	})
+}
+
+func TestUpdateReceptionStatusIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := reception_driver.NewReceptionDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, receptionIds, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+	require.NotEmpty(t, pvzIds)
+	require.NotEmpty(t, receptionIds)
+
+	t.Run("Pause and resume reception", func(t *testing.T) {
+		err := driver.UpdateReceptionStatus(ctx, receptionIds[0], reception_model.InProgress, reception_model.Paused, event_model.ReceptionPaused)
+		require.NoError(t, err)
+
+		status, err := driver.GetLastReceptionStatus(ctx, pvzIds[0])
+		require.NoError(t, err)
+		assert.Equal(t, reception_model.Paused, *status)
+
+		err = driver.UpdateReceptionStatus(ctx, receptionIds[0], reception_model.Paused, reception_model.InProgress, event_model.ReceptionResumed)
+		require.NoError(t, err)
+
+		reception, err := driver.GetReception(ctx, receptionIds[0])
+		require.NoError(t, err)
+		assert.Equal(t, reception_model.InProgress, reception.Status)
+		var events int
+		err = pool.QueryRow(ctx, "SELECT COUNT(*) FROM outbox WHERE event_type IN ('reception_paused', 'reception_resumed')").Scan(&events)
+		require.NoError(t, err)
+		assert.Equal(t, 2, events)
+	})
+
+	t.Run("Update reception with stale status", func(t *testing.T) {
+		err := driver.UpdateReceptionStatus(ctx, receptionIds[2], reception_model.InProgress, reception_model.Cancelled, event_model.ReceptionCancelled)
+
+		assert.Equal(t, custom_errors.ErrReceptionChanged, err)
+	})
+
+	t.Run("Verify closed reception", func(t *testing.T) {
+		err := driver.UpdateReceptionStatus(ctx, receptionIds[2], reception_model.Close, reception_model.Verified, event_model.ReceptionVerified)
+		require.NoError(t, err)
+
+		reception, err := driver.GetReception(ctx, receptionIds[2])
+		require.NoError(t, err)
+		assert.Equal(t, reception_model.Verified, reception.Status)
+	})
+}
+
+func TestAutoCloseStaleReceptionsIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := reception_driver.NewReceptionDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, receptionIds, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+	require.NotEmpty(t, pvzIds)
+	require.NotEmpty(t, receptionIds)
+
+	_, err = pool.Exec(ctx, "UPDATE cities SET reception_auto_close_hours = 48 WHERE name = 'Санкт-Петербург'")
+	require.NoError(t, err)
+
+	statuses := []reception_model.ReceptionStatus{reception_model.InProgress, reception_model.Paused}
+
+	t.Run("Skip auto close when locked by another instance", func(t *testing.T) {
+		tx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback(ctx)
+
+		_, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", drivers.ReceptionAutoCloseLockKey)
+		require.NoError(t, err)
+
+		receptions, err := driver.AutoCloseStaleReceptions(ctx, time.Now(), 6*time.Hour, "stale", statuses)
+
+		require.NoError(t, err)
+		assert.Empty(t, receptions)
+	})
+
+	t.Run("Auto close stale receptions using city threshold", func(t *testing.T) {
+		receptions, err := driver.AutoCloseStaleReceptions(ctx, time.Now(), 6*time.Hour, "stale", statuses)
+
+		require.NoError(t, err)
+		require.Len(t, receptions, 1)
+		assert.Equal(t, receptionIds[0], receptions[0].Id)
+		assert.Equal(t, pvzIds[0], receptions[0].PvzId)
+
+		reception, err := driver.GetReceptionWithProducts(ctx, receptionIds[0])
+		require.NoError(t, err)
+		assert.Equal(t, reception_model.AutoClosed, reception.Reception.Status)
+		require.NotNil(t, reception.Reception.CloseReason)
+		assert.Equal(t, "stale", *reception.Reception.CloseReason)
+
+		status, err := driver.GetLastReceptionStatus(ctx, pvzIds[1])
+		require.NoError(t, err)
+		assert.Equal(t, reception_model.InProgress, *status)
+	})
+
+	t.Run("Auto close without stale receptions", func(t *testing.T) {
+		receptions, err := driver.AutoCloseStaleReceptions(ctx, time.Now(), 6*time.Hour, "stale", statuses)
+
+		require.NoError(t, err)
+		assert.Empty(t, receptions)
+	})
+
+	t.Run("Reopen auto closed reception", func(t *testing.T) {
+		result, err := driver.ReopenReception(ctx, &reception_model.ReceptionCorrection{
+			Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			ReceptionId: receptionIds[0],
+			ReopenedAt:  time.Now().UTC(),
+			Reason:      "closed too early",
+		}, reception_model.AutoClosed)
+
+		require.NoError(t, err)
+		assert.Equal(t, reception_model.InProgress, result.Status)
+
+		reception, err := driver.GetReceptionWithProducts(ctx, receptionIds[0])
+		require.NoError(t, err)
+		assert.Nil(t, reception.Reception.CloseReason)
+	})
+
+	t.Run("Keep reopened reception open until threshold passes since reopening", func(t *testing.T) {
+		receptions, err := driver.AutoCloseStaleReceptions(ctx, time.Now(), 6*time.Hour, "stale", statuses)
+
+		require.NoError(t, err)
+		assert.Empty(t, receptions)
+
+		status, err := driver.GetLastReceptionStatus(ctx, pvzIds[0])
+		require.NoError(t, err)
+		assert.Equal(t, reception_model.InProgress, *status)
+
+		receptions, err = driver.AutoCloseStaleReceptions(ctx, time.Now().Add(7*time.Hour), 6*time.Hour, "stale", statuses)
+
+		require.NoError(t, err)
+		require.Len(t, receptions, 1)
+		assert.Equal(t, receptionIds[0], receptions[0].Id)
+	})
+
+	t.Run("Auto close stale paused reception", func(t *testing.T) {
+		receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		_, err := pool.Exec(ctx, queryCreateReception, receptionId, time.Now().Add(-72*time.Hour), pvzIds[0], string(reception_model.Paused))
+		require.NoError(t, err)
+
+		receptions, err := driver.AutoCloseStaleReceptions(ctx, time.Now(), 6*time.Hour, "stale", statuses)
+
+		require.NoError(t, err)
+		require.Len(t, receptions, 1)
+		assert.Equal(t, receptionId, receptions[0].Id)
+
+		reception, err := driver.GetReceptionWithProducts(ctx, receptionId)
+		require.NoError(t, err)
+		assert.Equal(t, reception_model.AutoClosed, reception.Reception.Status)
+	})
+}
+
+func TestGetReceptionsIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := reception_driver.NewReceptionDriver(pool)
+	ctx := context.Background()
+
+	pvzIds, receptionIds, _, err := createTestData(ctx, pool)
+	require.NoError(t, err)
+	require.NotEmpty(t, pvzIds)
+	require.NotEmpty(t, receptionIds)
+
+	t.Run("Get reception with products", func(t *testing.T) {
+		result, err := driver.GetReceptionWithProducts(ctx, receptionIds[0])
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionIds[0], result.Reception.Id)
+		assert.Equal(t, pvzIds[0], result.Reception.PvzId)
+		assert.Len(t, result.Products, 2)
+	})
+
+	t.Run("Get not existing reception", func(t *testing.T) {
+		result, err := driver.GetReceptionWithProducts(ctx, pgtype.UUID{Bytes: uuid.New(), Valid: true})
+
+		assert.Nil(t, result)
+		assert.Equal(t, custom_errors.ErrReceptionNotFound, err)
+	})
+
+	t.Run("Get pvz receptions newest first", func(t *testing.T) {
+		filter := reception_model.ReceptionFilter{PvzId: pvzIds[0], Limit: 10}
+		result, err := driver.GetReceptions(ctx, filter)
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, receptionIds[0], result[0].Reception.Id)
+		assert.Equal(t, receptionIds[2], result[1].Reception.Id)
+		assert.Len(t, result[0].Products, 2)
+		assert.Len(t, result[1].Products, 1)
+
+		total, err := driver.CountReceptions(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+	})
+
+	t.Run("Get pvz receptions with status and page", func(t *testing.T) {
+		status := reception_model.Close
+		filter := reception_model.ReceptionFilter{PvzId: pvzIds[0], Status: &status, Limit: 10}
+		result, err := driver.GetReceptions(ctx, filter)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, receptionIds[2], result[0].Reception.Id)
+
+		filter = reception_model.ReceptionFilter{PvzId: pvzIds[0], Limit: 1, Offset: 1}
+		result, err = driver.GetReceptions(ctx, filter)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, receptionIds[2], result[0].Reception.Id)
+	})
+
+	t.Run("Get pvz receptions with date range", func(t *testing.T) {
+		startDate := time.Now().Add(-24 * time.Hour)
+		filter := reception_model.ReceptionFilter{PvzId: pvzIds[0], StartInterval: &startDate, Limit: 10}
+		result, err := driver.GetReceptions(ctx, filter)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, receptionIds[0], result[0].Reception.Id)
+	})
+}
@@ -3,6 +3,7 @@ package drivers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5/pgconn"
 	"testing"
 	"time"
@@ -10,6 +11,9 @@ import (
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/reception_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -21,10 +25,21 @@ import (
 
 func TestCreateReception(t *testing.T) {
 	ctx := context.Background()
-	mockAdapter := new(MockAdapter)
-	driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+	mockPvzStatus := func(mockTx *MockTx, pvzId pgtype.UUID, status pvz_model.PvzStatus, err error) {
+		mockRow := new(MockRow)
+		mockTx.On("QueryRow", ctx, drivers.QueryGetPvzStatusForShare, []interface{}{pvzId}).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*pvz_model.PvzStatus")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*pvz_model.PvzStatus)) = status
+			}).Return(err)
+	}
 
 	t.Run("Create reception", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
 		reception := &reception_model.Reception{
 			Id:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
 			ReceptionTime: time.Now(),
@@ -33,15 +48,26 @@ func TestCreateReception(t *testing.T) {
 		}
 
 		params := []interface{}{reception.Id, reception.ReceptionTime, reception.PvzId, reception.Status}
-		mockAdapter.On("Exec", ctx, drivers.QueryCreateReception, params).Return(pgconn.CommandTag{}, nil).Once()
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+		mockPvzStatus(mockTx, reception.PvzId, pvz_model.Active, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateReception, params).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Commit", ctx).Return(nil)
 
 		err := driver.CreateReception(ctx, reception)
 
 		require.NoError(t, err)
 		mockAdapter.AssertExpectations(t)
+		mockTx.AssertExpectations(t)
 	})
 
 	t.Run("Create reception with error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
 		reception := &reception_model.Reception{
 			Id:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
 			ReceptionTime: time.Now(),
@@ -50,13 +76,79 @@ func TestCreateReception(t *testing.T) {
 		}
 
 		params := []interface{}{reception.Id, reception.ReceptionTime, reception.PvzId, reception.Status}
-		mockAdapter.On("Exec", ctx, drivers.QueryCreateReception, params).Return(pgconn.CommandTag{}, errors.New("database error")).Once()
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+		mockPvzStatus(mockTx, reception.PvzId, pvz_model.Active, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateReception, params).Return(pgconn.CommandTag{}, errors.New("database error"))
 
 		err := driver.CreateReception(ctx, reception)
 
 		assert.Equal(t, custom_errors.ErrCreateReception, err)
 		mockAdapter.AssertExpectations(t)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("Create reception when open reception exists", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		reception := &reception_model.Reception{
+			Id:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			ReceptionTime: time.Now(),
+			PvzId:         pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			Status:        reception_model.InProgress,
+		}
+
+		params := []interface{}{reception.Id, reception.ReceptionTime, reception.PvzId, reception.Status}
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+		mockPvzStatus(mockTx, reception.PvzId, pvz_model.Active, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateReception, params).
+			Return(pgconn.CommandTag{}, &pgconn.PgError{Code: drivers.UniqueViolationCode})
+
+		err := driver.CreateReception(ctx, reception)
+
+		assert.Equal(t, custom_errors.ErrInProgressReception, err)
+		mockAdapter.AssertExpectations(t)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
 	})
+
+	pvzStatusTests := []struct {
+		name        string
+		status      pvz_model.PvzStatus
+		scanErr     error
+		expectedErr error
+	}{
+		{"Create reception in inactive pvz", pvz_model.Inactive, nil, custom_errors.ErrPvzInactive},
+		{"Create reception in archived pvz", pvz_model.Archived, nil, custom_errors.ErrPvzArchived},
+		{"Create reception in missing pvz", "", pgx.ErrNoRows, custom_errors.ErrNoPvz},
+	}
+
+	for _, tt := range pvzStatusTests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAdapter := new(MockAdapter)
+			mockTx := new(MockTx)
+			driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+			reception := &reception_model.Reception{
+				Id:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				ReceptionTime: time.Now(),
+				PvzId:         pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				Status:        reception_model.InProgress,
+			}
+
+			mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+			mockTx.On("Rollback", ctx).Return(nil)
+			mockPvzStatus(mockTx, reception.PvzId, tt.status, tt.scanErr)
+
+			err := driver.CreateReception(ctx, reception)
+
+			assert.Equal(t, tt.expectedErr, err)
+			mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryCreateReception, mock.Anything)
+			mockTx.AssertNotCalled(t, "Commit", ctx)
+		})
+	}
 }
 
 func TestGetLastReceptionStatus(t *testing.T) {
@@ -142,18 +234,23 @@ func TestCloseReception(t *testing.T) {
 			*(args.Get(0).(*pgtype.UUID)) = receptionId
 		}).Return(nil)
 
-	params = []interface{}{receptionId}
-	mockTx.On("Exec", ctx, drivers.QueryCloseReception, params).Return(pgconn.CommandTag{}, nil)
+	mockTx.On("Exec", ctx, drivers.QueryCloseReception, mock.MatchedBy(func(args []interface{}) bool {
+		return len(args) == 2 && args[0] == receptionId
+	})).Return(pgconn.CommandTag{}, nil)
+	mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil)
+	mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, nil)
 
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 
 	mockRowReception := new(MockRow)
+	params = []interface{}{receptionId}
 	mockAdapter.On("QueryRow", ctx, drivers.QueryGetReception, params).Return(mockRowReception)
 	mockRowReception.On("Scan",
 		mock.AnythingOfType("*time.Time"),
 		mock.AnythingOfType("*pgtype.UUID"),
 		mock.AnythingOfType("*reception_model.ReceptionStatus"),
+		mock.AnythingOfType("**string"),
 	).Run(func(args mock.Arguments) {
 		*(args.Get(0).(*time.Time)) = receptionTime
 		*(args.Get(1).(*pgtype.UUID)) = pvzId
@@ -174,3 +271,567 @@ func TestCloseReception(t *testing.T) {
 	mockRowReceptionId.AssertExpectations(t)
 	mockRowReception.AssertExpectations(t)
 }
+
+func TestReopenReception(t *testing.T) {
+	ctx := context.Background()
+
+	receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	receptionTime := time.Now().Add(-2 * time.Hour)
+	closedAt := time.Now().Add(-time.Hour)
+
+	newCorrection := func() *reception_model.ReceptionCorrection {
+		return &reception_model.ReceptionCorrection{
+			Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			ReceptionId: receptionId,
+			ReopenedBy:  pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			ReopenedAt:  time.Now(),
+			Reason:      "miscounted",
+		}
+	}
+
+	mockGetReception := func(mockTx *MockTx, status reception_model.ReceptionStatus, err error) *MockRow {
+		mockRow := new(MockRow)
+		mockTx.On("QueryRow", ctx, drivers.QueryGetReceptionForUpdate, []interface{}{receptionId}).Return(mockRow)
+		mockRow.On("Scan",
+			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("*pgtype.UUID"),
+			mock.AnythingOfType("*reception_model.ReceptionStatus"),
+			mock.AnythingOfType("**time.Time"),
+		).Run(func(args mock.Arguments) {
+			*(args.Get(0).(*time.Time)) = receptionTime
+			*(args.Get(1).(*pgtype.UUID)) = pvzId
+			*(args.Get(2).(*reception_model.ReceptionStatus)) = status
+			*(args.Get(3).(**time.Time)) = &closedAt
+		}).Return(err)
+		return mockRow
+	}
+
+	mockNewerReception := func(mockTx *MockTx, exists bool) *MockRow {
+		mockRow := new(MockRow)
+		mockTx.On("QueryRow", ctx, drivers.QueryExistsNewerReception, []interface{}{pvzId, receptionId, receptionTime}).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*bool")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*bool)) = exists
+			}).Return(nil)
+		return mockRow
+	}
+
+	t.Run("Reopen closed reception", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+		correction := newCorrection()
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockRowReception := mockGetReception(mockTx, reception_model.Close, nil)
+		mockRowNewer := mockNewerReception(mockTx, false)
+		mockTx.On("Exec", ctx, drivers.QueryReopenReception, []interface{}{receptionId, correction.ReopenedAt}).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateReceptionCorrection, mock.MatchedBy(func(args []interface{}) bool {
+			return len(args) == 6 && args[0] == correction.Id && args[1] == receptionId &&
+				args[2] == &closedAt && args[3] == correction.ReopenedBy && args[5] == "miscounted"
+		})).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Commit", ctx).Return(nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		reception, err := driver.ReopenReception(ctx, correction, reception_model.Close)
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionId, reception.Id)
+		assert.Equal(t, pvzId, reception.PvzId)
+		assert.Equal(t, receptionTime, reception.ReceptionTime)
+		assert.Equal(t, reception_model.InProgress, reception.Status)
+		assert.Equal(t, &closedAt, correction.ClosedAt)
+		mockAdapter.AssertExpectations(t)
+		mockTx.AssertExpectations(t)
+		mockRowReception.AssertExpectations(t)
+		mockRowNewer.AssertExpectations(t)
+	})
+
+	t.Run("Reopen missing reception", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockGetReception(mockTx, reception_model.Close, pgx.ErrNoRows)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		reception, err := driver.ReopenReception(ctx, newCorrection(), reception_model.Close)
+
+		assert.Nil(t, reception)
+		assert.Equal(t, custom_errors.ErrReceptionNotFound, err)
+		mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryReopenReception, mock.Anything)
+	})
+
+	t.Run("Reopen reception changed concurrently", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockGetReception(mockTx, reception_model.InProgress, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		reception, err := driver.ReopenReception(ctx, newCorrection(), reception_model.Close)
+
+		assert.Nil(t, reception)
+		assert.Equal(t, custom_errors.ErrReceptionChanged, err)
+		mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryReopenReception, mock.Anything)
+	})
+
+	t.Run("Reopen reception with newer reception", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockGetReception(mockTx, reception_model.Close, nil)
+		mockNewerReception(mockTx, true)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		reception, err := driver.ReopenReception(ctx, newCorrection(), reception_model.Close)
+
+		assert.Nil(t, reception)
+		assert.Equal(t, custom_errors.ErrNewerReception, err)
+		mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryReopenReception, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("Reopen reception when open reception exists", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+		correction := newCorrection()
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockGetReception(mockTx, reception_model.Close, nil)
+		mockNewerReception(mockTx, false)
+		mockTx.On("Exec", ctx, drivers.QueryReopenReception, []interface{}{receptionId, correction.ReopenedAt}).
+			Return(pgconn.CommandTag{}, &pgconn.PgError{Code: drivers.UniqueViolationCode})
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		reception, err := driver.ReopenReception(ctx, correction, reception_model.Close)
+
+		assert.Nil(t, reception)
+		assert.Equal(t, custom_errors.ErrInProgressReception, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestUpdateReceptionStatus(t *testing.T) {
+	ctx := context.Background()
+	receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	params := []interface{}{receptionId, reception_model.InProgress, reception_model.Paused}
+
+	mockUpdate := func(mockTx *MockTx, params []interface{}, err error) {
+		require.Contains(t, drivers.QueryUpdateReceptionStatus, "RETURNING pvz_id")
+		mockRow := new(MockRow)
+		mockTx.On("QueryRow", ctx, drivers.QueryUpdateReceptionStatus, params).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*pgtype.UUID)) = pvzId
+			}).Return(err)
+	}
+
+	t.Run("Update reception status", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockUpdate(mockTx, params, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Commit", ctx).Return(nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.UpdateReceptionStatus(ctx, receptionId, reception_model.InProgress, reception_model.Paused, event_model.ReceptionPaused)
+
+		assert.NoError(t, err)
+		mockAdapter.AssertExpectations(t)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("Update reception status changed concurrently", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockUpdate(mockTx, params, pgx.ErrNoRows)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.UpdateReceptionStatus(ctx, receptionId, reception_model.InProgress, reception_model.Paused, event_model.ReceptionPaused)
+
+		assert.Equal(t, custom_errors.ErrReceptionChanged, err)
+		mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("Update reception status with error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockUpdate(mockTx, params, errors.New("db error"))
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.UpdateReceptionStatus(ctx, receptionId, reception_model.InProgress, reception_model.Paused, event_model.ReceptionPaused)
+
+		assert.Equal(t, custom_errors.ErrUpdateReceptionStatus, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("Update reception status when open reception exists", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		resumeParams := []interface{}{receptionId, reception_model.Paused, reception_model.InProgress}
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockUpdate(mockTx, resumeParams, &pgconn.PgError{Code: drivers.UniqueViolationCode})
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.UpdateReceptionStatus(ctx, receptionId, reception_model.Paused, reception_model.InProgress, event_model.ReceptionResumed)
+
+		assert.Equal(t, custom_errors.ErrInProgressReception, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("Update reception status with outbox error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockUpdate(mockTx, params, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.UpdateReceptionStatus(ctx, receptionId, reception_model.InProgress, reception_model.Paused, event_model.ReceptionPaused)
+
+		assert.Equal(t, custom_errors.ErrCreateOutboxEvent, err)
+		mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("Update reception status with webhook deliveries error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockUpdate(mockTx, params, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.UpdateReceptionStatus(ctx, receptionId, reception_model.InProgress, reception_model.Paused, event_model.ReceptionPaused)
+
+		assert.Equal(t, custom_errors.ErrCreateWebhookDeliveries, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestAutoCloseStaleReceptions(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	reason := "stale"
+	statuses := []reception_model.ReceptionStatus{reception_model.InProgress, reception_model.Paused}
+
+	mockLock := func(mockTx *MockTx, locked bool) *MockRow {
+		mockRow := new(MockRow)
+		mockTx.On("QueryRow", ctx, drivers.QueryTryAdvisoryLock, []interface{}{drivers.ReceptionAutoCloseLockKey}).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*bool")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*bool)) = locked
+			}).Return(nil)
+		return mockRow
+	}
+
+	t.Run("Auto close stale receptions", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		mockRows := new(MockRows)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		receptionTime := now.Add(-30 * time.Hour)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockRowLock := mockLock(mockTx, true)
+		mockTx.On("Query", ctx, drivers.QueryAutoCloseReceptions, []interface{}{now, reason, 24 * time.Hour, []string{"in_progress", "paused"}}).Return(mockRows, nil)
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Scan",
+			mock.AnythingOfType("*pgtype.UUID"),
+			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("*pgtype.UUID"),
+		).Run(func(args mock.Arguments) {
+			*(args.Get(0).(*pgtype.UUID)) = receptionId
+			*(args.Get(1).(*time.Time)) = receptionTime
+			*(args.Get(2).(*pgtype.UUID)) = pvzId
+		}).Return(nil)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateOutboxEvent, mock.Anything).Return(pgconn.CommandTag{}, nil).Once()
+		mockTx.On("Exec", ctx, drivers.QueryCreateWebhookDeliveries, mock.Anything).Return(pgconn.CommandTag{}, nil).Once()
+		mockTx.On("Commit", ctx).Return(nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		receptions, err := driver.AutoCloseStaleReceptions(ctx, now, 24*time.Hour, reason, statuses)
+
+		require.NoError(t, err)
+		require.Len(t, receptions, 1)
+		assert.Equal(t, receptionId, receptions[0].Id)
+		assert.Equal(t, pvzId, receptions[0].PvzId)
+		assert.Equal(t, receptionTime, receptions[0].ReceptionTime)
+		assert.Equal(t, reception_model.AutoClosed, receptions[0].Status)
+		assert.Equal(t, reason, *receptions[0].CloseReason)
+		mockAdapter.AssertExpectations(t)
+		mockTx.AssertExpectations(t)
+		mockRows.AssertExpectations(t)
+		mockRowLock.AssertExpectations(t)
+	})
+
+	t.Run("Auto close locked by another instance", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockLock(mockTx, false)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		receptions, err := driver.AutoCloseStaleReceptions(ctx, now, 24*time.Hour, reason, statuses)
+
+		require.NoError(t, err)
+		assert.Empty(t, receptions)
+		mockTx.AssertNotCalled(t, "Query", ctx, drivers.QueryAutoCloseReceptions, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("Auto close with query error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockLock(mockTx, true)
+		mockTx.On("Query", ctx, drivers.QueryAutoCloseReceptions, mock.Anything).Return((*MockRows)(nil), errors.New("db error"))
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		receptions, err := driver.AutoCloseStaleReceptions(ctx, now, 24*time.Hour, reason, statuses)
+
+		assert.Nil(t, receptions)
+		assert.Equal(t, custom_errors.ErrAutoCloseReceptions, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestGetReceptionCorrections(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	mockRows := new(MockRows)
+	driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+	receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	correctionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	reopenedBy := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	closedAt := time.Now().Add(-time.Hour)
+	reopenedAt := time.Now()
+
+	mockAdapter.On("Query", ctx, drivers.QueryGetReceptionCorrections, []interface{}{receptionId}).Return(mockRows, nil)
+	mockRows.On("Next").Return(true).Once()
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Scan",
+		mock.AnythingOfType("*pgtype.UUID"),
+		mock.AnythingOfType("**time.Time"),
+		mock.AnythingOfType("*pgtype.UUID"),
+		mock.AnythingOfType("*time.Time"),
+		mock.AnythingOfType("*string"),
+	).Run(func(args mock.Arguments) {
+		*(args.Get(0).(*pgtype.UUID)) = correctionId
+		*(args.Get(1).(**time.Time)) = &closedAt
+		*(args.Get(2).(*pgtype.UUID)) = reopenedBy
+		*(args.Get(3).(*time.Time)) = reopenedAt
+		*(args.Get(4).(*string)) = "miscounted"
+	}).Return(nil)
+	mockRows.On("Close").Return()
+	mockRows.On("Err").Return(nil)
+
+	corrections, err := driver.GetReceptionCorrections(ctx, receptionId)
+
+	require.NoError(t, err)
+	assert.Equal(t, []reception_model.ReceptionCorrection{{
+		Id:          correctionId,
+		ReceptionId: receptionId,
+		ClosedAt:    &closedAt,
+		ReopenedBy:  reopenedBy,
+		ReopenedAt:  reopenedAt,
+		Reason:      "miscounted",
+	}}, corrections)
+	mockAdapter.AssertExpectations(t)
+	mockRows.AssertExpectations(t)
+}
+
+func TestGetReceptionWithProducts(t *testing.T) {
+	ctx := context.Background()
+
+	receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	receptionTime := time.Now()
+
+	t.Run("Get reception with products", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		mockRows := new(MockRows)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("QueryRow", ctx, drivers.QueryGetReception, []interface{}{receptionId}).Return(mockRow)
+		mockRow.On("Scan",
+			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("*pgtype.UUID"),
+			mock.AnythingOfType("*reception_model.ReceptionStatus"),
+			mock.AnythingOfType("**string"),
+		).Run(func(args mock.Arguments) {
+			*(args.Get(0).(*time.Time)) = receptionTime
+			*(args.Get(1).(*pgtype.UUID)) = pvzId
+			*(args.Get(2).(*reception_model.ReceptionStatus)) = reception_model.Close
+		}).Return(nil)
+
+		productId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		length, width, height := 300, 200, 100
+		mockAdapter.On("Query", ctx, drivers.QueryGetReceptionsProducts, []interface{}{[]pgtype.UUID{receptionId}}).Return(mockRows, nil)
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Next").Return(false).Once()
+		scanArgs := make([]interface{}, 11)
+		for i := range scanArgs {
+			scanArgs[i] = mock.Anything
+		}
+		mockRows.On("Scan", scanArgs...).Run(func(args mock.Arguments) {
+			*(args.Get(0).(*pgtype.UUID)) = productId
+			*(args.Get(2).(*product_model.ProductType)) = product_model.Shoes
+			*(args.Get(3).(*pgtype.UUID)) = receptionId
+			*(args.Get(8).(**int)) = &length
+			*(args.Get(9).(**int)) = &width
+			*(args.Get(10).(**int)) = &height
+		}).Return(nil)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(nil)
+
+		reception, err := driver.GetReceptionWithProducts(ctx, receptionId)
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionId, reception.Reception.Id)
+		assert.Equal(t, pvzId, reception.Reception.PvzId)
+		assert.Equal(t, reception_model.Close, reception.Reception.Status)
+		require.Len(t, reception.Products, 1)
+		assert.Equal(t, productId, reception.Products[0].Id)
+		assert.Equal(t, &product_model.Dimensions{LengthMm: 300, WidthMm: 200, HeightMm: 100}, reception.Products[0].Dimensions)
+		mockAdapter.AssertExpectations(t)
+		mockRows.AssertExpectations(t)
+	})
+
+	t.Run("Get not existing reception", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("QueryRow", ctx, drivers.QueryGetReception, []interface{}{receptionId}).Return(mockRow)
+		mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+
+		reception, err := driver.GetReceptionWithProducts(ctx, receptionId)
+
+		assert.Nil(t, reception)
+		assert.Equal(t, custom_errors.ErrReceptionNotFound, err)
+		mockAdapter.AssertNotCalled(t, "Query", mock.Anything, drivers.QueryGetReceptionsProducts, mock.Anything)
+	})
+}
+
+func TestGetReceptions(t *testing.T) {
+	ctx := context.Background()
+
+	pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	status := reception_model.InProgress
+	startDate := time.Now().Add(-time.Hour)
+	filter := reception_model.ReceptionFilter{PvzId: pvzId, Status: &status, StartInterval: &startDate, Limit: 10, Offset: 20}
+
+	t.Run("Get receptions with filters", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRows := new(MockRows)
+		mockProductRows := new(MockRows)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		expectedQuery := fmt.Sprintf(drivers.QueryGetReceptions, " AND status = $2 AND reception_time >= $3", "$4", "$5")
+		mockAdapter.On("Query", ctx, expectedQuery, []interface{}{pvzId, status, startDate, uint32(10), uint32(20)}).Return(mockRows, nil)
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Scan",
+			mock.AnythingOfType("*pgtype.UUID"),
+			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("*reception_model.ReceptionStatus"),
+			mock.AnythingOfType("**string"),
+		).Run(func(args mock.Arguments) {
+			*(args.Get(0).(*pgtype.UUID)) = receptionId
+			*(args.Get(2).(*reception_model.ReceptionStatus)) = reception_model.InProgress
+		}).Return(nil)
+		mockRows.On("Close").Return()
+		mockRows.On("Err").Return(nil)
+
+		mockAdapter.On("Query", ctx, drivers.QueryGetReceptionsProducts, []interface{}{[]pgtype.UUID{receptionId}}).Return(mockProductRows, nil)
+		mockProductRows.On("Next").Return(false).Once()
+		mockProductRows.On("Close").Return()
+		mockProductRows.On("Err").Return(nil)
+
+		receptions, err := driver.GetReceptions(ctx, filter)
+
+		require.NoError(t, err)
+		require.Len(t, receptions, 1)
+		assert.Equal(t, receptionId, receptions[0].Reception.Id)
+		assert.Equal(t, pvzId, receptions[0].Reception.PvzId)
+		assert.NotNil(t, receptions[0].Products)
+		assert.Empty(t, receptions[0].Products)
+		mockAdapter.AssertExpectations(t)
+		mockRows.AssertExpectations(t)
+		mockProductRows.AssertExpectations(t)
+	})
+
+	t.Run("Count receptions with filters", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		expectedQuery := fmt.Sprintf(drivers.QueryCountReceptions, " AND status = $2 AND reception_time >= $3")
+		mockAdapter.On("QueryRow", ctx, expectedQuery, []interface{}{pvzId, status, startDate}).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*int")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*int)) = 3
+			}).Return(nil)
+
+		total, err := driver.CountReceptions(ctx, filter)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Get receptions with error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := reception_driver.NewReceptionDriver(mockAdapter)
+
+		mockAdapter.On("Query", ctx, mock.Anything, mock.Anything).Return((*MockRows)(nil), errors.New("db error"))
+
+		receptions, err := driver.GetReceptions(ctx, filter)
+
+		assert.Nil(t, receptions)
+		assert.Equal(t, custom_errors.ErrGetReceptions, err)
+	})
+}
@@ -0,0 +1,81 @@
+package drivers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/sink_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/outbox_model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestOutboxEvent() *outbox_model.OutboxEvent {
+	return &outbox_model.OutboxEvent{
+		Id:        pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		EventType: "reception_closed",
+		Payload:   json.RawMessage(`{"type":"reception_closed"}`),
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestFileSinkDriverSend(t *testing.T) {
+	var buf bytes.Buffer
+	driver := sink_driver.NewFileSinkDriver(&buf)
+	event := newTestOutboxEvent()
+
+	require.NoError(t, driver.Send(context.Background(), event))
+	require.NoError(t, driver.Send(context.Background(), event))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var decoded outbox_model.OutboxEvent
+	require.NoError(t, json.Unmarshal(lines[0], &decoded))
+	assert.Equal(t, event.Id, decoded.Id)
+	assert.Equal(t, event.EventType, decoded.EventType)
+	assert.JSONEq(t, string(event.Payload), string(decoded.Payload))
+}
+
+func TestWebhookSinkDriverSend(t *testing.T) {
+	t.Run("Send event", func(t *testing.T) {
+		event := newTestOutboxEvent()
+
+		var received outbox_model.OutboxEvent
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, event.Id.String(), r.Header.Get("X-Event-Id"))
+			assert.Equal(t, event.EventType, r.Header.Get("X-Event-Type"))
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &received)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		driver := sink_driver.NewWebhookSinkDriver(server.URL)
+		err := driver.Send(context.Background(), event)
+
+		require.NoError(t, err)
+		assert.Equal(t, event.Id, received.Id)
+	})
+
+	t.Run("Send event with unexpected status code", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		driver := sink_driver.NewWebhookSinkDriver(server.URL)
+		err := driver.Send(context.Background(), newTestOutboxEvent())
+
+		assert.Equal(t, custom_errors.ErrUnexpectedStatusCode, err)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestCreateUserIntegration(t *testing.T) {
@@ -105,3 +106,66 @@ func TestGetUserByIdIntegration(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+func TestRefreshTokenLifecycleIntegration(t *testing.T) {
+	pool, cleanup := SetupPostgresContainer(t)
+	defer cleanup()
+
+	driver := user_driver.NewUserDriver(pool)
+	ctx := context.Background()
+
+	userId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	err := driver.CreateUser(ctx, &user_model.User{Id: userId, Email: "session@example.com", PasswordHash: []byte("hash"), Role: user_model.Employee})
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	newToken := func(hash string) *user_model.RefreshToken {
+		return &user_model.RefreshToken{
+			Id:              pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			FamilyId:        pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			UserId:          userId,
+			TokenHash:       []byte(hash),
+			AccessJti:       pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			AccessExpiresAt: now.Add(user_model.JwtExpiry),
+			CreatedAt:       now,
+			ExpiresAt:       now.Add(user_model.RefreshTokenExpiry),
+		}
+	}
+
+	first := newToken("first")
+	require.NoError(t, driver.CreateRefreshToken(ctx, first))
+
+	second := newToken("second")
+	require.NoError(t, driver.RotateRefreshToken(ctx, []byte("first"), second, now))
+	assert.Equal(t, first.FamilyId, second.FamilyId)
+
+	revoked, err := driver.IsTokenRevoked(ctx, second.AccessJti)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	err = driver.RotateRefreshToken(ctx, []byte("first"), newToken("third"), now)
+	assert.Equal(t, custom_errors.ErrRefreshTokenReused, err)
+
+	for _, jti := range []pgtype.UUID{first.AccessJti, second.AccessJti} {
+		revoked, err = driver.IsTokenRevoked(ctx, jti)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	}
+
+	err = driver.RotateRefreshToken(ctx, []byte("second"), newToken("fourth"), now)
+	assert.Equal(t, custom_errors.ErrRefreshTokenReused, err)
+
+	other := newToken("other")
+	require.NoError(t, driver.CreateRefreshToken(ctx, other))
+	require.NoError(t, driver.RevokeUserSessions(ctx, userId, now))
+
+	revoked, err = driver.IsTokenRevoked(ctx, other.AccessJti)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	require.NoError(t, driver.DeleteExpiredTokens(ctx, now.Add(user_model.RefreshTokenExpiry)))
+
+	revoked, err = driver.IsTokenRevoked(ctx, other.AccessJti)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/user_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestCreateUser(t *testing.T) {
@@ -102,3 +105,154 @@ func TestGetUserById(t *testing.T) {
 	assert.Equal(t, expectedUser, user)
 	mockAdapter.AssertExpectations(t)
 }
+
+func TestRotateRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	tokenHash := []byte("old-hash")
+	tokenId := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	familyId := pgtype.UUID{Bytes: [16]byte{2}, Valid: true}
+	userId := pgtype.UUID{Bytes: [16]byte{3}, Valid: true}
+
+	mockStoredToken := func(mockTx *MockTx, expiresAt time.Time, revokedAt *time.Time, err error) {
+		mockRow := new(MockRow)
+		mockTx.On("QueryRow", ctx, drivers.QueryGetRefreshTokenForUpdate, []interface{}{tokenHash}).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*pgtype.UUID"),
+			mock.AnythingOfType("*pgtype.UUID"), mock.AnythingOfType("*time.Time"), mock.AnythingOfType("**time.Time")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*pgtype.UUID) = tokenId
+				*args.Get(1).(*pgtype.UUID) = familyId
+				*args.Get(2).(*pgtype.UUID) = userId
+				*args.Get(3).(*time.Time) = expiresAt
+				*args.Get(4).(**time.Time) = revokedAt
+			}).
+			Return(err)
+	}
+
+	newToken := func() *user_model.RefreshToken {
+		return &user_model.RefreshToken{
+			Id:              pgtype.UUID{Bytes: [16]byte{4}, Valid: true},
+			TokenHash:       []byte("new-hash"),
+			AccessJti:       pgtype.UUID{Bytes: [16]byte{5}, Valid: true},
+			AccessExpiresAt: now.Add(user_model.JwtExpiry),
+			CreatedAt:       now,
+			ExpiresAt:       now.Add(user_model.RefreshTokenExpiry),
+		}
+	}
+
+	t.Run("Rotate refresh token", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := user_driver.NewUserDriver(mockAdapter)
+		token := newToken()
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockStoredToken(mockTx, now.Add(time.Hour), nil, nil)
+		mockTx.On("Exec", ctx, drivers.QueryRevokeRefreshToken, []interface{}{tokenId, now}).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+		mockTx.On("Exec", ctx, drivers.QueryCreateRefreshToken, []interface{}{
+			token.Id, familyId, userId, token.TokenHash, token.AccessJti, token.AccessExpiresAt, token.CreatedAt, token.ExpiresAt,
+		}).Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+		mockTx.On("Commit", ctx).Return(nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.RotateRefreshToken(ctx, tokenHash, token, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, familyId, token.FamilyId)
+		assert.Equal(t, userId, token.UserId)
+		mockTx.AssertExpectations(t)
+	})
+
+	t.Run("Rotate reused refresh token", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := user_driver.NewUserDriver(mockAdapter)
+		revokedAt := now.Add(-time.Minute)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockStoredToken(mockTx, now.Add(time.Hour), &revokedAt, nil)
+		mockTx.On("Exec", ctx, drivers.QueryRevokeTokenFamily, []interface{}{familyId, now}).Return(pgconn.NewCommandTag("INSERT 0 2"), nil)
+		mockTx.On("Commit", ctx).Return(nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.RotateRefreshToken(ctx, tokenHash, newToken(), now)
+
+		assert.Equal(t, custom_errors.ErrRefreshTokenReused, err)
+		mockTx.AssertExpectations(t)
+		mockTx.AssertNotCalled(t, "Exec", ctx, drivers.QueryCreateRefreshToken, mock.Anything)
+	})
+
+	t.Run("Rotate expired refresh token", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := user_driver.NewUserDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockStoredToken(mockTx, now.Add(-time.Hour), nil, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.RotateRefreshToken(ctx, tokenHash, newToken(), now)
+
+		assert.Equal(t, custom_errors.ErrRefreshTokenExpired, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("Rotate unknown refresh token", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockTx := new(MockTx)
+		driver := user_driver.NewUserDriver(mockAdapter)
+
+		mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+		mockStoredToken(mockTx, time.Time{}, nil, pgx.ErrNoRows)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := driver.RotateRefreshToken(ctx, tokenHash, newToken(), now)
+
+		assert.Equal(t, custom_errors.ErrRefreshToken, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestRevokeSession(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	mockTx := new(MockTx)
+	driver := user_driver.NewUserDriver(mockAdapter)
+
+	jti := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+	now := time.Now()
+	expiresAt := now.Add(user_model.JwtExpiry)
+
+	mockAdapter.On("Begin", ctx).Return(mockTx, nil)
+	mockTx.On("Exec", ctx, drivers.QueryRevokeToken, []interface{}{jti, expiresAt}).Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+	mockTx.On("Exec", ctx, drivers.QueryRevokeSession, []interface{}{jti, now}).Return(pgconn.NewCommandTag("INSERT 0 0"), nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	err := driver.RevokeSession(ctx, jti, expiresAt, now)
+
+	require.NoError(t, err)
+	mockTx.AssertExpectations(t)
+}
+
+func TestIsTokenRevoked(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	mockRow := new(MockRow)
+	driver := user_driver.NewUserDriver(mockAdapter)
+
+	jti := pgtype.UUID{Bytes: [16]byte{1}, Valid: true}
+
+	mockAdapter.On("QueryRow", ctx, drivers.QueryIsTokenRevoked, []interface{}{jti}).Return(mockRow)
+	mockRow.On("Scan", mock.AnythingOfType("*bool")).
+		Run(func(args mock.Arguments) {
+			*args.Get(0).(*bool) = true
+		}).
+		Return(nil)
+
+	revoked, err := driver.IsTokenRevoked(ctx, jti)
+
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	mockAdapter.AssertExpectations(t)
+}
@@ -0,0 +1,217 @@
+package drivers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/webhook_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/webhook_model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateWebhookSubscription(t *testing.T) {
+	ctx := context.Background()
+	mockAdapter := new(MockAdapter)
+	driver := webhook_driver.NewWebhookDriver(mockAdapter)
+
+	subscription := &webhook_model.Subscription{
+		Id:         pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		Url:        "https://partner.example.com/hooks",
+		Secret:     "secret",
+		EventTypes: []event_model.EventType{event_model.ReceptionClosed, event_model.ProductAdded},
+		CreatedAt:  time.Now(),
+	}
+
+	params := []interface{}{
+		subscription.Id, subscription.Url, subscription.Secret,
+		[]string{"reception_closed", "product_added"}, subscription.PvzId, subscription.CreatedAt,
+	}
+	mockAdapter.On("Exec", ctx, drivers.QueryCreateWebhookSubscription, params).Return(pgconn.CommandTag{}, nil)
+
+	err := driver.CreateSubscription(ctx, subscription)
+
+	assert.NoError(t, err)
+	mockAdapter.AssertExpectations(t)
+}
+
+func TestDeleteWebhookSubscription(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Delete subscription", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := webhook_driver.NewWebhookDriver(mockAdapter)
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		mockAdapter.On("Exec", ctx, drivers.QueryDeleteWebhookSubscription, []interface{}{id}).
+			Return(pgconn.NewCommandTag("DELETE 1"), nil)
+
+		err := driver.DeleteSubscription(ctx, id)
+
+		assert.NoError(t, err)
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Delete missing subscription", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := webhook_driver.NewWebhookDriver(mockAdapter)
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		mockAdapter.On("Exec", ctx, drivers.QueryDeleteWebhookSubscription, []interface{}{id}).
+			Return(pgconn.NewCommandTag("DELETE 0"), nil)
+
+		err := driver.DeleteSubscription(ctx, id)
+
+		assert.Equal(t, custom_errors.ErrWebhookNotFound, err)
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Delete subscription with error", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		driver := webhook_driver.NewWebhookDriver(mockAdapter)
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		mockAdapter.On("Exec", ctx, drivers.QueryDeleteWebhookSubscription, []interface{}{id}).
+			Return(pgconn.CommandTag{}, errors.New("db error"))
+
+		err := driver.DeleteSubscription(ctx, id)
+
+		assert.Equal(t, custom_errors.ErrDeleteWebhookSubscription, err)
+	})
+}
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	ctx := context.Background()
+
+	mockExists := func(mockAdapter *MockAdapter, id pgtype.UUID, exists bool) {
+		mockRow := new(MockRow)
+		mockAdapter.On("QueryRow", ctx, drivers.QueryExistsWebhookDelivery, []interface{}{id}).Return(mockRow)
+		mockRow.On("Scan", mock.AnythingOfType("*bool")).
+			Run(func(args mock.Arguments) {
+				*(args.Get(0).(*bool)) = exists
+			}).Return(nil)
+	}
+
+	t.Run("Replay dead delivery", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		driver := webhook_driver.NewWebhookDriver(mockAdapter)
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		now := time.Now()
+
+		mockAdapter.On("QueryRow", ctx, drivers.QueryReplayWebhookDelivery, []interface{}{id, now}).Return(mockRow)
+		mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*pgtype.UUID) = id
+				*args.Get(5).(*webhook_model.DeliveryStatus) = webhook_model.Pending
+				*args.Get(7).(*time.Time) = now
+			}).Return(nil)
+
+		delivery, err := driver.ReplayDelivery(ctx, id, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, id, delivery.Id)
+		assert.Equal(t, webhook_model.Pending, delivery.Status)
+		assert.Equal(t, 0, delivery.Attempts)
+		mockAdapter.AssertExpectations(t)
+	})
+
+	t.Run("Replay delivery not in dead state", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		driver := webhook_driver.NewWebhookDriver(mockAdapter)
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		now := time.Now()
+
+		mockAdapter.On("QueryRow", ctx, drivers.QueryReplayWebhookDelivery, []interface{}{id, now}).Return(mockRow)
+		mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+		mockExists(mockAdapter, id, true)
+
+		delivery, err := driver.ReplayDelivery(ctx, id, now)
+
+		assert.Nil(t, delivery)
+		assert.Equal(t, custom_errors.ErrWebhookDeliveryDead, err)
+	})
+
+	t.Run("Replay missing delivery", func(t *testing.T) {
+		mockAdapter := new(MockAdapter)
+		mockRow := new(MockRow)
+		driver := webhook_driver.NewWebhookDriver(mockAdapter)
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		now := time.Now()
+
+		mockAdapter.On("QueryRow", ctx, drivers.QueryReplayWebhookDelivery, []interface{}{id, now}).Return(mockRow)
+		mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+		mockExists(mockAdapter, id, false)
+
+		delivery, err := driver.ReplayDelivery(ctx, id, now)
+
+		assert.Nil(t, delivery)
+		assert.Equal(t, custom_errors.ErrWebhookDeliveryNotFound, err)
+		mockAdapter.AssertExpectations(t)
+	})
+}
+
+func TestWebhookSenderDriverSend(t *testing.T) {
+	t.Run("Send signed payload", func(t *testing.T) {
+		delivery := &webhook_model.Delivery{
+			Id:        pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			EventId:   pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			EventType: event_model.ReceptionClosed,
+			Payload:   json.RawMessage(`{"type":"reception_closed"}`),
+			Secret:    "partner-secret",
+		}
+
+		var body []byte
+		var signature string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, delivery.Id.String(), r.Header.Get("X-Delivery-Id"))
+			assert.Equal(t, "reception_closed", r.Header.Get("X-Event-Type"))
+			signature = r.Header.Get(webhook_driver.SignatureHeader)
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		delivery.Url = server.URL
+
+		err := webhook_driver.NewWebhookSenderDriver().Send(context.Background(), delivery)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, string(delivery.Payload), string(body))
+		assert.Equal(t, webhook_driver.Sign("partner-secret", body), signature)
+	})
+
+	t.Run("Send to failing endpoint", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		delivery := &webhook_model.Delivery{Url: server.URL, Payload: json.RawMessage(`{}`)}
+		err := webhook_driver.NewWebhookSenderDriver().Send(context.Background(), delivery)
+
+		assert.Equal(t, custom_errors.ErrUnexpectedStatusCode, err)
+	})
+}
+
+func TestSign(t *testing.T) {
+	signature := webhook_driver.Sign("key", []byte("The quick brown fox jumps over the lazy dog"))
+
+	assert.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", signature)
+}
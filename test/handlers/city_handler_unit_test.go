@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/api"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/city_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type MockCityService struct {
+	mock.Mock
+}
+
+func (m *MockCityService) CreateCity(ctx context.Context, cityDto generated.City) (*generated.City, error) {
+	args := m.Called(ctx, cityDto)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.City), args.Error(1)
+}
+
+func (m *MockCityService) GetCities(ctx context.Context) ([]generated.City, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]generated.City), args.Error(1)
+}
+
+func (m *MockCityService) GetCity(ctx context.Context, name string) (*city_model.City, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*city_model.City), args.Error(1)
+}
+
+func (m *MockCityService) UpdateCity(ctx context.Context, name string, cityReq generated.PutCitiesNameJSONRequestBody) (*generated.City, error) {
+	args := m.Called(ctx, name, cityReq)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.City), args.Error(1)
+}
+
+func (m *MockCityService) DeleteCity(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func setupCityTestEnv() (*gin.Engine, *MockCityService, *api.HttpHandler) {
+	router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+	mockCityService := new(MockCityService)
+	handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, mockCityService, nil)
+	return router, mockCityService, handler
+}
+
+func TestPostCities(t *testing.T) {
+	cityReq := generated.PostCitiesJSONRequestBody{Name: "Новосибирск", Region: "Новосибирская область", Timezone: "Asia/Novosibirsk"}
+
+	t.Run("Create city", func(t *testing.T) {
+		router, mockCityService, handler := setupCityTestEnv()
+
+		mockCityService.On("CreateCity", mock.Anything, cityReq).Return(&cityReq, nil).Once()
+
+		jsonData, _ := json.Marshal(cityReq)
+		req, _ := http.NewRequest("POST", "/cities", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.POST("/cities", handler.PostCities)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockCityService.AssertExpectations(t)
+
+		var response generated.City
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, cityReq.Name, response.Name)
+	})
+
+	t.Run("Create existing city", func(t *testing.T) {
+		router, mockCityService, handler := setupCityTestEnv()
+
+		mockCityService.On("CreateCity", mock.Anything, cityReq).Return(nil, custom_errors.ErrCityExists).Once()
+
+		jsonData, _ := json.Marshal(cityReq)
+		req, _ := http.NewRequest("POST", "/cities", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.POST("/cities", handler.PostCities)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockCityService.AssertExpectations(t)
+	})
+}
+
+func TestGetCities(t *testing.T) {
+	router, mockCityService, handler := setupCityTestEnv()
+
+	mockCityService.On("GetCities", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+	req, _ := http.NewRequest("GET", "/cities", nil)
+	w := httptest.NewRecorder()
+
+	router.GET("/cities", handler.GetCities)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	mockCityService.AssertExpectations(t)
+}
+
+func TestPutCitiesName(t *testing.T) {
+	router, mockCityService, handler := setupCityTestEnv()
+	cityReq := generated.PutCitiesNameJSONRequestBody{Region: "Республика Татарстан", Timezone: "Europe/Moscow"}
+
+	mockCityService.On("UpdateCity", mock.Anything, "Казань", cityReq).
+		Return(&generated.City{Name: "Казань", Region: cityReq.Region, Timezone: cityReq.Timezone}, nil).Once()
+
+	jsonData, _ := json.Marshal(cityReq)
+	req, _ := http.NewRequest("PUT", "/cities/Казань", bytes.NewBuffer(jsonData))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.PUT("/cities/:name", func(c *gin.Context) {
+		handler.PutCitiesName(c, c.Param("name"))
+	})
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockCityService.AssertExpectations(t)
+}
+
+func TestDeleteCitiesName(t *testing.T) {
+	router, mockCityService, handler := setupCityTestEnv()
+
+	mockCityService.On("DeleteCity", mock.Anything, "Казань").Return(custom_errors.ErrCityInUse).Once()
+
+	req, _ := http.NewRequest("DELETE", "/cities/Казань", nil)
+	w := httptest.NewRecorder()
+
+	router.DELETE("/cities/:name", func(c *gin.Context) {
+		handler.DeleteCitiesName(c, c.Param("name"))
+	})
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockCityService.AssertExpectations(t)
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/middlewares"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
+	pvz_v1 "github.com/Dmitrii-Dmitrii/pvz/proto/generated/pvz/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestGrpcAuthUnaryInterceptor(t *testing.T) {
+	okHandler := func(ctx context.Context, req any) (any, error) {
+		user, ok := middlewares.UserFromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Internal, "no user in context")
+		}
+		return user, nil
+	}
+
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	t.Run("Authorized employee calls employee method", func(t *testing.T) {
+		mockUserService := new(MockUserService)
+		interceptor := middlewares.NewGrpcAuthInterceptor(mockUserService)
+
+		ctx := withToken("valid_token")
+		user := &user_model.User{Email: "employee@example.com", Role: user_model.Employee}
+		mockUserService.On("ValidateToken", ctx, "valid_token").Return(user, nil)
+
+		info := &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_AddProduct_FullMethodName}
+		resp, err := interceptor.UnaryInterceptor(ctx, nil, info, okHandler)
+
+		require.NoError(t, err)
+		assert.Equal(t, user, resp)
+		mockUserService.AssertExpectations(t)
+	})
+
+	t.Run("Any role calls read method", func(t *testing.T) {
+		mockUserService := new(MockUserService)
+		interceptor := middlewares.NewGrpcAuthInterceptor(mockUserService)
+
+		ctx := withToken("valid_token")
+		user := &user_model.User{Email: "moderator@example.com", Role: user_model.Moderator}
+		mockUserService.On("ValidateToken", ctx, "valid_token").Return(user, nil)
+
+		info := &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_GetPVZList_FullMethodName}
+		_, err := interceptor.UnaryInterceptor(ctx, nil, info, okHandler)
+
+		assert.NoError(t, err)
+		mockUserService.AssertExpectations(t)
+	})
+
+	t.Run("Employee calls moderator method", func(t *testing.T) {
+		mockUserService := new(MockUserService)
+		interceptor := middlewares.NewGrpcAuthInterceptor(mockUserService)
+
+		ctx := withToken("valid_token")
+		user := &user_model.User{Email: "employee@example.com", Role: user_model.Employee}
+		mockUserService.On("ValidateToken", ctx, "valid_token").Return(user, nil)
+
+		info := &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_CreatePVZ_FullMethodName}
+		resp, err := interceptor.UnaryInterceptor(ctx, nil, info, okHandler)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockUserService.AssertExpectations(t)
+	})
+
+	t.Run("Employee reopens reception", func(t *testing.T) {
+		mockUserService := new(MockUserService)
+		interceptor := middlewares.NewGrpcAuthInterceptor(mockUserService)
+
+		ctx := withToken("valid_token")
+		user := &user_model.User{Email: "employee@example.com", Role: user_model.Employee}
+		mockUserService.On("ValidateToken", ctx, "valid_token").Return(user, nil)
+
+		info := &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_ReopenReception_FullMethodName}
+		resp, err := interceptor.UnaryInterceptor(ctx, nil, info, okHandler)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockUserService.AssertExpectations(t)
+	})
+
+	t.Run("Request without token", func(t *testing.T) {
+		mockUserService := new(MockUserService)
+		interceptor := middlewares.NewGrpcAuthInterceptor(mockUserService)
+
+		info := &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_GetPVZList_FullMethodName}
+		resp, err := interceptor.UnaryInterceptor(context.Background(), nil, info, okHandler)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		mockUserService.AssertNotCalled(t, "ValidateToken")
+	})
+
+	t.Run("Request with invalid token", func(t *testing.T) {
+		mockUserService := new(MockUserService)
+		interceptor := middlewares.NewGrpcAuthInterceptor(mockUserService)
+
+		ctx := withToken("invalid_token")
+		mockUserService.On("ValidateToken", ctx, "invalid_token").Return(nil, custom_errors.ErrUserNotFound)
+
+		info := &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_GetPVZList_FullMethodName}
+		resp, err := interceptor.UnaryInterceptor(ctx, nil, info, okHandler)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		mockUserService.AssertExpectations(t)
+	})
+}
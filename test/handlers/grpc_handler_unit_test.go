@@ -4,12 +4,24 @@ import (
 	"context"
 	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/api"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
 	pvz_v1 "github.com/Dmitrii-Dmitrii/pvz/proto/generated/pvz/v1"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"testing"
 	"time"
 )
@@ -19,7 +31,7 @@ func TestGetPVZList(t *testing.T) {
 
 	t.Run("Get PVZ List", func(t *testing.T) {
 		mockService := new(MockPvzService)
-		handler := api.NewGrpcHandler(mockService)
+		handler := api.NewGrpcHandler(mockService, nil, nil, nil)
 
 		now := time.Now()
 		id1 := pgtype.UUID{Bytes: uuid.New(), Valid: true}
@@ -38,7 +50,7 @@ func TestGetPVZList(t *testing.T) {
 			},
 		}
 
-		mockService.On("GetAllPvz", ctx).Return(mockPvzList, nil)
+		mockService.On("GetPvzList", ctx, (*int)(nil), "").Return(mockPvzList, "", nil)
 
 		expectedResponse := &pvz_v1.GetPVZListResponse{
 			Pvzs: []*pvz_v1.PVZ{
@@ -72,10 +84,10 @@ func TestGetPVZList(t *testing.T) {
 
 	t.Run("Get PVZ List with empty result", func(t *testing.T) {
 		mockService := new(MockPvzService)
-		handler := api.NewGrpcHandler(mockService)
+		handler := api.NewGrpcHandler(mockService, nil, nil, nil)
 
 		var emptyPvzList []pvz_model.Pvz
-		mockService.On("GetAllPvz", ctx).Return(emptyPvzList, nil)
+		mockService.On("GetPvzList", ctx, (*int)(nil), "").Return(emptyPvzList, "", nil)
 
 		response, err := handler.GetPVZList(ctx, &pvz_v1.GetPVZListRequest{})
 
@@ -87,10 +99,10 @@ func TestGetPVZList(t *testing.T) {
 
 	t.Run("Get PVZ List with error", func(t *testing.T) {
 		mockService := new(MockPvzService)
-		handler := api.NewGrpcHandler(mockService)
+		handler := api.NewGrpcHandler(mockService, nil, nil, nil)
 
 		expectedError := errors.New("database connection error")
-		mockService.On("GetAllPvz", ctx).Return([]pvz_model.Pvz{}, expectedError)
+		mockService.On("GetPvzList", ctx, (*int)(nil), "").Return([]pvz_model.Pvz{}, "", expectedError)
 
 		response, err := handler.GetPVZList(ctx, &pvz_v1.GetPVZListRequest{})
 
@@ -100,4 +112,892 @@ func TestGetPVZList(t *testing.T) {
 		assert.Empty(t, response.Pvzs)
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("Get PVZ List page with cursor", func(t *testing.T) {
+		mockService := new(MockPvzService)
+		handler := api.NewGrpcHandler(mockService, nil, nil, nil)
+
+		limit := 1
+		id := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		mockPvzList := []pvz_model.Pvz{{Id: id, RegistrationDate: time.Now(), City: pvz_model.Kazan}}
+
+		mockService.On("GetPvzList", ctx, &limit, "current").Return(mockPvzList, "next", nil)
+
+		limitReq := int32(limit)
+		response, err := handler.GetPVZList(ctx, &pvz_v1.GetPVZListRequest{Limit: &limitReq, Cursor: "current"})
+
+		require.NoError(t, err)
+		require.Len(t, response.Pvzs, 1)
+		assert.Equal(t, id.String(), response.Pvzs[0].Id)
+		assert.Equal(t, "next", response.NextCursor)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Get PVZ List with invalid cursor", func(t *testing.T) {
+		mockService := new(MockPvzService)
+		handler := api.NewGrpcHandler(mockService, nil, nil, nil)
+
+		mockService.On("GetPvzList", ctx, (*int)(nil), "broken").Return(nil, "", custom_errors.ErrCursorValue)
+
+		response, err := handler.GetPVZList(ctx, &pvz_v1.GetPVZListRequest{Cursor: "broken"})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestGetPVZFullInfo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get PVZ full info", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		now := time.Now()
+		pvzId := uuid.New()
+		receptionId := uuid.New()
+		productId := uuid.New()
+		limit := 5
+
+		mockResult := []pvz_model.PvzWithReceptions{
+			{
+				Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: pvzId, Valid: true}, RegistrationDate: now, City: pvz_model.Moscow},
+				Receptions: []pvz_model.ReceptionWithProducts{
+					{
+						Reception: reception_model.Reception{
+							Id:            pgtype.UUID{Bytes: receptionId, Valid: true},
+							ReceptionTime: now,
+							PvzId:         pgtype.UUID{Bytes: pvzId, Valid: true},
+							Status:        reception_model.Close,
+						},
+						Products: []product_model.Product{
+							{
+								Id:          pgtype.UUID{Bytes: productId, Valid: true},
+								AddingTime:  now,
+								ProductType: product_model.Shoes,
+								ReceptionId: pgtype.UUID{Bytes: receptionId, Valid: true},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		cursor := "current"
+		mockPvzService.On("GetPvzFullInfo", ctx, generated.GetPvzParams{Limit: &limit, Cursor: &cursor}).
+			Return(&pvz_model.PvzPage{Pvzs: mockResult, Page: 1, Limit: limit, Total: 1, NextCursor: "next"}, nil)
+
+		limitReq := int32(limit)
+		response, err := handler.GetPVZFullInfo(ctx, &pvz_v1.GetPVZFullInfoRequest{Limit: &limitReq, Cursor: cursor})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), response.Page)
+		assert.Equal(t, int32(limit), response.Limit)
+		assert.Equal(t, int32(1), response.Total)
+		assert.Equal(t, int32(1), response.TotalPages)
+		assert.Equal(t, "next", response.NextCursor)
+		require.Len(t, response.Pvzs, 1)
+		assert.Equal(t, pvzId.String(), response.Pvzs[0].Pvz.Id)
+		require.Len(t, response.Pvzs[0].Receptions, 1)
+		assert.Equal(t, receptionId.String(), response.Pvzs[0].Receptions[0].Reception.Id)
+		assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED, response.Pvzs[0].Receptions[0].Reception.Status)
+		require.Len(t, response.Pvzs[0].Receptions[0].Products, 1)
+		assert.Equal(t, productId.String(), response.Pvzs[0].Receptions[0].Products[0].Id)
+		mockPvzService.AssertExpectations(t)
+	})
+
+	t.Run("Get PVZ full info with filters", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		city := "Казань"
+		receptionStatus := "close"
+		productType := "обувь"
+		minProducts := 2
+		sortBy := generated.LastActivity
+		params := generated.GetPvzParams{
+			City:            &city,
+			ReceptionStatus: &receptionStatus,
+			ProductType:     &productType,
+			MinProducts:     &minProducts,
+			SortBy:          &sortBy,
+		}
+
+		lastActivity := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		mockPvzService.On("GetPvzFullInfo", ctx, params).Return(&pvz_model.PvzPage{
+			Pvzs: []pvz_model.PvzWithReceptions{
+				{Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, City: pvz_model.Kazan}, LastActivity: lastActivity},
+			},
+			Page:  1,
+			Limit: 10,
+			Total: 1,
+		}, nil)
+
+		minProductsReq := int32(minProducts)
+		receptionStatusReq := pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED
+		response, err := handler.GetPVZFullInfo(ctx, &pvz_v1.GetPVZFullInfoRequest{
+			City:            city,
+			ReceptionStatus: &receptionStatusReq,
+			ProductType:     productType,
+			MinProducts:     &minProductsReq,
+			SortBy:          pvz_v1.PVZSortBy_PVZ_SORT_BY_LAST_ACTIVITY,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Pvzs, 1)
+		assert.Equal(t, lastActivity, response.Pvzs[0].LastActivity.AsTime())
+		mockPvzService.AssertExpectations(t)
+	})
+
+	t.Run("Get PVZ full info with invalid params", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		mockPvzService.On("GetPvzFullInfo", ctx, mock.Anything).Return(nil, custom_errors.ErrLimitValue)
+
+		limitReq := int32(100)
+		response, err := handler.GetPVZFullInfo(ctx, &pvz_v1.GetPVZFullInfoRequest{Limit: &limitReq})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockPvzService.AssertExpectations(t)
+	})
+}
+
+func TestCreatePVZ(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Create PVZ", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		id := uuid.New()
+		now := time.Now()
+
+		mockPvzService.On("CreatePvz", ctx, generated.PVZ{City: "Казань"}).
+			Return(&generated.PVZ{Id: &id, RegistrationDate: &now, City: "Казань"}, nil)
+
+		response, err := handler.CreatePVZ(ctx, &pvz_v1.CreatePVZRequest{City: "Казань"})
+
+		require.NoError(t, err)
+		assert.Equal(t, id.String(), response.Pvz.Id)
+		assert.Equal(t, "Казань", response.Pvz.City)
+		mockPvzService.AssertExpectations(t)
+	})
+
+	t.Run("Create PVZ with invalid id", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		response, err := handler.CreatePVZ(ctx, &pvz_v1.CreatePVZRequest{Id: "invalid", City: "Казань"})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockPvzService.AssertNotCalled(t, "CreatePvz")
+	})
+
+	t.Run("Create existing PVZ", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		mockPvzService.On("CreatePvz", ctx, mock.Anything).Return(nil, custom_errors.ErrPvzExists)
+
+		response, err := handler.CreatePVZ(ctx, &pvz_v1.CreatePVZRequest{Id: uuid.New().String(), City: "Казань"})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		mockPvzService.AssertExpectations(t)
+	})
+}
+
+func TestCreateReceptionGrpc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Create reception", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		receptionId := uuid.New()
+
+		mockReceptionService.On("CreateReception", ctx, pvzId).Return(&generated.Reception{
+			Id:       &receptionId,
+			DateTime: time.Now(),
+			PvzId:    pvzId,
+			Status:   generated.InProgress,
+		}, nil)
+
+		response, err := handler.CreateReception(ctx, &pvz_v1.CreateReceptionRequest{PvzId: pvzId.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionId.String(), response.Reception.Id)
+		assert.Equal(t, pvzId.String(), response.Reception.PvzId)
+		assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS, response.Reception.Status)
+		mockReceptionService.AssertExpectations(t)
+	})
+
+	t.Run("Create reception with in progress reception", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		mockReceptionService.On("CreateReception", ctx, pvzId).Return(nil, custom_errors.ErrInProgressReception)
+
+		response, err := handler.CreateReception(ctx, &pvz_v1.CreateReceptionRequest{PvzId: pvzId.String()})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		mockReceptionService.AssertExpectations(t)
+	})
+
+	t.Run("Create reception with internal error", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		mockReceptionService.On("CreateReception", ctx, pvzId).Return(nil, custom_errors.ErrCreateReception)
+
+		response, err := handler.CreateReception(ctx, &pvz_v1.CreateReceptionRequest{PvzId: pvzId.String()})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		mockReceptionService.AssertExpectations(t)
+	})
+}
+
+func TestCloseLastReceptionGrpc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Close last reception", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		receptionId := uuid.New()
+
+		mockReceptionService.On("CloseReception", ctx, pvzId).Return(&generated.Reception{
+			Id:       &receptionId,
+			DateTime: time.Now(),
+			PvzId:    pvzId,
+			Status:   generated.Close,
+		}, nil)
+
+		response, err := handler.CloseLastReception(ctx, &pvz_v1.CloseLastReceptionRequest{PvzId: pvzId.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionId.String(), response.Reception.Id)
+		assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED, response.Reception.Status)
+		mockReceptionService.AssertExpectations(t)
+	})
+
+	t.Run("Close last reception without open reception", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		mockReceptionService.On("CloseReception", ctx, pvzId).Return(nil, custom_errors.ErrNoOpenReception)
+
+		response, err := handler.CloseLastReception(ctx, &pvz_v1.CloseLastReceptionRequest{PvzId: pvzId.String()})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		mockReceptionService.AssertExpectations(t)
+	})
+}
+
+func TestAddProduct(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Add product", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		productId := uuid.New()
+		receptionId := uuid.New()
+		now := time.Now()
+
+		mockProductService.On("CreateProduct", ctx, generated.PostProductsJSONRequestBody{PvzId: pvzId, Type: "одежда"}).Return(&generated.Product{
+			Id:          &productId,
+			DateTime:    &now,
+			Type:        "одежда",
+			ReceptionId: receptionId,
+		}, nil)
+
+		response, err := handler.AddProduct(ctx, &pvz_v1.AddProductRequest{PvzId: pvzId.String(), Type: "одежда"})
+
+		require.NoError(t, err)
+		assert.Equal(t, productId.String(), response.Product.Id)
+		assert.Equal(t, receptionId.String(), response.Product.ReceptionId)
+		assert.Equal(t, "одежда", response.Product.Type)
+		mockProductService.AssertExpectations(t)
+	})
+
+	t.Run("Add product with invalid type", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		mockProductService.On("CreateProduct", ctx, generated.PostProductsJSONRequestBody{PvzId: pvzId, Type: "мебель"}).
+			Return(nil, custom_errors.ErrProductType)
+
+		response, err := handler.AddProduct(ctx, &pvz_v1.AddProductRequest{PvzId: pvzId.String(), Type: "мебель"})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockProductService.AssertExpectations(t)
+	})
+}
+
+type mockAddProductsStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*pvz_v1.AddProductRequest
+	response *pvz_v1.AddProductsResponse
+}
+
+func (s *mockAddProductsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockAddProductsStream) Recv() (*pvz_v1.AddProductRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *mockAddProductsStream) SendAndClose(response *pvz_v1.AddProductsResponse) error {
+	s.response = response
+	return nil
+}
+
+func TestAddProductsGrpc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Add products stream", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		receptionId := uuid.New()
+		productId := uuid.New()
+		barcode := "4600000000011"
+		errMessage := custom_errors.ErrBarcodeExists.Message
+
+		mockProductService.On("CreateProducts", ctx, generated.PostProductsBatchJSONRequestBody{
+			PvzId: pvzId,
+			Items: []generated.ProductBatchItem{{Type: "одежда", Barcode: &barcode}, {Type: "одежда", Barcode: &barcode}},
+		}).Return(&generated.ProductBatchResult{
+			ReceptionId: receptionId,
+			Created:     1,
+			Failed:      1,
+			Items: []generated.ProductBatchItemResult{
+				{Index: 0, Product: &generated.Product{Id: &productId, ReceptionId: receptionId, Type: "одежда", Barcode: &barcode}},
+				{Index: 1, Error: &errMessage},
+			},
+		}, nil)
+
+		stream := &mockAddProductsStream{ctx: ctx, requests: []*pvz_v1.AddProductRequest{
+			{PvzId: pvzId.String(), Type: "одежда", Barcode: &barcode},
+			{PvzId: pvzId.String(), Type: "одежда", Barcode: &barcode},
+		}}
+
+		err := handler.AddProducts(stream)
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionId.String(), stream.response.ReceptionId)
+		assert.Equal(t, int32(1), stream.response.Created)
+		assert.Equal(t, productId.String(), stream.response.Items[0].Product.Id)
+		assert.Equal(t, errMessage, stream.response.Items[1].Error)
+		mockProductService.AssertExpectations(t)
+	})
+
+	t.Run("Add products stream with different pvz", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		stream := &mockAddProductsStream{ctx: ctx, requests: []*pvz_v1.AddProductRequest{
+			{PvzId: uuid.New().String(), Type: "одежда"},
+			{PvzId: uuid.New().String(), Type: "одежда"},
+		}}
+
+		err := handler.AddProducts(stream)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockProductService.AssertNotCalled(t, "CreateProducts")
+	})
+}
+
+func TestGetProductByBarcodeGrpc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get product by barcode", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		barcode := "4600000000011"
+		pvzId := uuid.New()
+		weight := 500
+		mockProductService.On("GetProductByBarcode", ctx, barcode).Return(&generated.ProductLocation{
+			Product:   generated.Product{Type: "обувь", Barcode: &barcode, WeightGrams: &weight},
+			Reception: generated.Reception{PvzId: pvzId, Status: generated.InProgress},
+			Pvz:       generated.PVZ{Id: &pvzId, City: "Москва"},
+		}, nil)
+
+		response, err := handler.GetProductByBarcode(ctx, &pvz_v1.GetProductByBarcodeRequest{Barcode: barcode})
+
+		require.NoError(t, err)
+		assert.Equal(t, barcode, response.Product.GetBarcode())
+		assert.Equal(t, int32(500), response.Product.GetWeightGrams())
+		assert.Equal(t, pvzId.String(), response.Pvz.Id)
+		mockProductService.AssertExpectations(t)
+	})
+
+	t.Run("Get product by unknown barcode", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		mockProductService.On("GetProductByBarcode", ctx, "unknown").Return(nil, custom_errors.ErrProductNotFound)
+
+		response, err := handler.GetProductByBarcode(ctx, &pvz_v1.GetProductByBarcodeRequest{Barcode: "unknown"})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestDeleteLastProductGrpc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Delete last product", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		mockProductService.On("DeleteLastProduct", ctx, pvzId, pgtype.UUID{}).Return(nil)
+
+		response, err := handler.DeleteLastProduct(ctx, &pvz_v1.DeleteLastProductRequest{PvzId: pvzId.String()})
+
+		require.NoError(t, err)
+		assert.NotNil(t, response)
+		mockProductService.AssertExpectations(t)
+	})
+
+	t.Run("Delete last product with invalid pvz id", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		response, err := handler.DeleteLastProduct(ctx, &pvz_v1.DeleteLastProductRequest{PvzId: "invalid"})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockProductService.AssertNotCalled(t, "DeleteLastProduct")
+	})
+}
+
+func TestDeleteAndRestoreProductGrpc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Delete product", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		productId := uuid.New()
+		mockProductService.On("DeleteProduct", ctx, pvzId, productId, pgtype.UUID{}).Return(nil)
+
+		response, err := handler.DeleteProduct(ctx, &pvz_v1.DeleteProductRequest{PvzId: pvzId.String(), ProductId: productId.String()})
+
+		require.NoError(t, err)
+		assert.NotNil(t, response)
+		mockProductService.AssertExpectations(t)
+	})
+
+	t.Run("Restore product that is not deleted", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		pvzId := uuid.New()
+		productId := uuid.New()
+		mockProductService.On("RestoreProduct", ctx, pvzId, productId).Return(nil, custom_errors.ErrDeletedProduct)
+
+		response, err := handler.RestoreProduct(ctx, &pvz_v1.RestoreProductRequest{PvzId: pvzId.String(), ProductId: productId.String()})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestGetReceptionGrpc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get reception", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		receptionId := uuid.New()
+		productId := uuid.New()
+		receptionResp := &generated.ReceptionWithProducts{
+			Reception: generated.Reception{Id: &receptionId, PvzId: uuid.New(), Status: generated.Close},
+			Products:  []generated.Product{{Id: &productId, ReceptionId: receptionId, Type: "обувь"}},
+		}
+		mockReceptionService.On("GetReception", ctx, receptionId).Return(receptionResp, nil)
+
+		response, err := handler.GetReception(ctx, &pvz_v1.GetReceptionRequest{ReceptionId: receptionId.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionId.String(), response.Reception.Reception.Id)
+		assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED, response.Reception.Reception.Status)
+		require.Len(t, response.Reception.Products, 1)
+		assert.Equal(t, productId.String(), response.Reception.Products[0].Id)
+	})
+
+	t.Run("Get not existing reception", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		receptionId := uuid.New()
+		mockReceptionService.On("GetReception", ctx, receptionId).Return(nil, custom_errors.ErrReceptionNotFound)
+
+		response, err := handler.GetReception(ctx, &pvz_v1.GetReceptionRequest{ReceptionId: receptionId.String()})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Get reception with invalid id", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		response, err := handler.GetReception(ctx, &pvz_v1.GetReceptionRequest{ReceptionId: "invalid"})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetPVZReceptionsGrpc(t *testing.T) {
+	ctx := context.Background()
+	_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+	handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+	pvzId := uuid.New()
+	receptionId := uuid.New()
+	startDate := time.Now().Add(-time.Hour).UTC()
+	page := 2
+	limit := 5
+	closeStatus := string(generated.Close)
+	params := generated.GetPvzPvzIdReceptionsParams{Status: &closeStatus, Page: &page, Limit: &limit}
+	receptionPage := &generated.ReceptionPage{
+		Receptions: []generated.ReceptionWithProducts{{
+			Reception: generated.Reception{Id: &receptionId, PvzId: pvzId, Status: generated.Close},
+			Products:  []generated.Product{},
+		}},
+		Page:       2,
+		Limit:      5,
+		Total:      6,
+		TotalPages: 2,
+	}
+	mockReceptionService.On("GetPvzReceptions", ctx, pvzId, mock.MatchedBy(func(p generated.GetPvzPvzIdReceptionsParams) bool {
+		return *p.Status == *params.Status && *p.Page == page && *p.Limit == limit && p.StartDate.Equal(startDate) && p.EndDate == nil
+	})).Return(receptionPage, nil)
+
+	closedStatus := pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED
+	pageProto := int32(page)
+	limitProto := int32(limit)
+	response, err := handler.GetPVZReceptions(ctx, &pvz_v1.GetPVZReceptionsRequest{
+		PvzId:     pvzId.String(),
+		Status:    &closedStatus,
+		StartDate: timestamppb.New(startDate),
+		Page:      &pageProto,
+		Limit:     &limitProto,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, response.Receptions, 1)
+	assert.Equal(t, receptionId.String(), response.Receptions[0].Reception.Id)
+	assert.Equal(t, int32(6), response.Total)
+	assert.Equal(t, int32(2), response.TotalPages)
+	mockReceptionService.AssertExpectations(t)
+}
+
+func TestReopenReceptionGrpc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Reopen reception", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		receptionId := uuid.New()
+		pvzId := uuid.New()
+		mockReceptionService.On("ReopenReception", ctx, receptionId, "miscounted", pgtype.UUID{}).
+			Return(&generated.Reception{Id: &receptionId, PvzId: pvzId, Status: generated.InProgress}, nil)
+
+		response, err := handler.ReopenReception(ctx, &pvz_v1.ReopenReceptionRequest{ReceptionId: receptionId.String(), Reason: "miscounted"})
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionId.String(), response.Reception.Id)
+		assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS, response.Reception.Status)
+		mockReceptionService.AssertExpectations(t)
+	})
+
+	t.Run("Reopen reception with newer reception", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		receptionId := uuid.New()
+		mockReceptionService.On("ReopenReception", ctx, receptionId, "miscounted", pgtype.UUID{}).Return(nil, custom_errors.ErrNewerReception)
+
+		response, err := handler.ReopenReception(ctx, &pvz_v1.ReopenReceptionRequest{ReceptionId: receptionId.String(), Reason: "miscounted"})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Reopen not existing reception", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		receptionId := uuid.New()
+		mockReceptionService.On("ReopenReception", ctx, receptionId, "miscounted", pgtype.UUID{}).Return(nil, custom_errors.ErrReceptionNotFound)
+
+		response, err := handler.ReopenReception(ctx, &pvz_v1.ReopenReceptionRequest{ReceptionId: receptionId.String(), Reason: "miscounted"})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestChangeReceptionStatusGrpc(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Verify reception", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+		receptionId := uuid.New()
+		pvzId := uuid.New()
+		statusReq := generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: generated.Verified}
+		mockReceptionService.On("ChangeReceptionStatus", ctx, receptionId, statusReq, pgtype.UUID{}, user_model.UserRole("")).
+			Return(&generated.Reception{Id: &receptionId, PvzId: pvzId, Status: generated.Verified}, nil)
+
+		response, err := handler.ChangeReceptionStatus(ctx, &pvz_v1.ChangeReceptionStatusRequest{
+			ReceptionId: receptionId.String(),
+			Status:      pvz_v1.ReceptionStatus_RECEPTION_STATUS_VERIFIED,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionId.String(), response.Reception.Id)
+		assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_VERIFIED, response.Reception.Status)
+		mockReceptionService.AssertExpectations(t)
+	})
+
+	t.Run("Change reception status with errors", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			err      error
+			expected codes.Code
+		}{
+			{"paused reception", custom_errors.ErrReceptionPaused, codes.FailedPrecondition},
+			{"finalized reception", custom_errors.ErrReceptionFinalized, codes.FailedPrecondition},
+			{"invalid transition", custom_errors.ErrReceptionTransition, codes.FailedPrecondition},
+			{"wrong role", custom_errors.ErrTransitionRole, codes.PermissionDenied},
+			{"concurrent change", custom_errors.ErrReceptionChanged, codes.Aborted},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+				handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+				receptionId := uuid.New()
+				mockReceptionService.On("ChangeReceptionStatus", ctx, receptionId, mock.Anything, pgtype.UUID{}, user_model.UserRole("")).
+					Return(nil, tc.err)
+
+				response, err := handler.ChangeReceptionStatus(ctx, &pvz_v1.ChangeReceptionStatusRequest{
+					ReceptionId: receptionId.String(),
+					Status:      pvz_v1.ReceptionStatus_RECEPTION_STATUS_PAUSED,
+				})
+
+				assert.Nil(t, response)
+				assert.Equal(t, tc.expected, status.Code(err))
+			})
+		}
+	})
+}
+
+func TestGetReceptionCorrectionsGrpc(t *testing.T) {
+	ctx := context.Background()
+	_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+	handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, nil)
+
+	receptionId := uuid.New()
+	reopenedBy := uuid.New()
+	closedAt := time.Now().Add(-time.Hour)
+	corrections := []generated.ReceptionCorrection{{
+		Id:          uuid.New(),
+		ReceptionId: receptionId,
+		ClosedAt:    &closedAt,
+		ReopenedBy:  &reopenedBy,
+		ReopenedAt:  time.Now(),
+		Reason:      "miscounted",
+	}}
+	mockReceptionService.On("GetReceptionCorrections", ctx, receptionId).Return(corrections, nil)
+
+	response, err := handler.GetReceptionCorrections(ctx, &pvz_v1.GetReceptionCorrectionsRequest{ReceptionId: receptionId.String()})
+
+	require.NoError(t, err)
+	require.Len(t, response.Corrections, 1)
+	assert.Equal(t, reopenedBy.String(), response.Corrections[0].ReopenedBy)
+	assert.Equal(t, closedAt.Unix(), response.Corrections[0].ClosedAt.AsTime().Unix())
+	assert.Equal(t, "miscounted", response.Corrections[0].Reason)
+	mockReceptionService.AssertExpectations(t)
+}
+
+type MockEventService struct {
+	mock.Mock
+}
+
+func (m *MockEventService) Publish(ctx context.Context, event *event_model.Event) {
+	m.Called(ctx, event)
+}
+
+func (m *MockEventService) Subscribe() (<-chan event_model.Event, func()) {
+	args := m.Called()
+	return args.Get(0).(chan event_model.Event), args.Get(1).(func())
+}
+
+type mockPVZEventStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*pvz_v1.PVZEvent
+}
+
+func (s *mockPVZEventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockPVZEventStream) Send(event *pvz_v1.PVZEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestWatchPVZEvents(t *testing.T) {
+	t.Run("Watch events filtered by pvz id", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		mockEventService := new(MockEventService)
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, mockEventService)
+
+		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		otherPvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		events := make(chan event_model.Event, 3)
+		events <- event_model.Event{Type: event_model.ReceptionOpened, PvzId: otherPvzId}
+		events <- event_model.Event{Type: event_model.ReceptionOpened, PvzId: pvzId, ReceptionId: receptionId}
+		close(events)
+
+		unsubscribed := false
+		mockEventService.On("Subscribe").Return(events, func() { unsubscribed = true })
+
+		stream := &mockPVZEventStream{ctx: context.Background()}
+		err := handler.WatchPVZEvents(&pvz_v1.WatchPVZEventsRequest{PvzId: pvzId.String()}, stream)
+
+		require.NoError(t, err)
+		require.Len(t, stream.events, 1)
+		assert.Equal(t, pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_OPENED, stream.events[0].Type)
+		assert.Equal(t, pvzId.String(), stream.events[0].PvzId)
+		assert.Equal(t, receptionId.String(), stream.events[0].ReceptionId)
+		assert.Empty(t, stream.events[0].ProductId)
+		assert.True(t, unsubscribed)
+		mockEventService.AssertExpectations(t)
+	})
+
+	t.Run("Watch events filtered by city", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		mockEventService := new(MockEventService)
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, mockEventService)
+
+		moscowPvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		kazanPvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		events := make(chan event_model.Event, 3)
+		events <- event_model.Event{Type: event_model.ProductAdded, PvzId: moscowPvzId}
+		events <- event_model.Event{Type: event_model.ProductAdded, PvzId: kazanPvzId}
+		events <- event_model.Event{Type: event_model.ProductDeleted, PvzId: kazanPvzId}
+		close(events)
+
+		mockEventService.On("Subscribe").Return(events, func() {})
+		mockPvzService.On("GetPvzById", mock.Anything, moscowPvzId).Return(&pvz_model.Pvz{Id: moscowPvzId, City: pvz_model.Moscow}, nil).Once()
+		mockPvzService.On("GetPvzById", mock.Anything, kazanPvzId).Return(&pvz_model.Pvz{Id: kazanPvzId, City: pvz_model.Kazan}, nil).Once()
+
+		stream := &mockPVZEventStream{ctx: context.Background()}
+		err := handler.WatchPVZEvents(&pvz_v1.WatchPVZEventsRequest{City: string(pvz_model.Kazan)}, stream)
+
+		require.NoError(t, err)
+		require.Len(t, stream.events, 2)
+		assert.Equal(t, pvz_v1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED, stream.events[0].Type)
+		assert.Equal(t, pvz_v1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_DELETED, stream.events[1].Type)
+		mockPvzService.AssertExpectations(t)
+	})
+
+	t.Run("Watch events skips events when city lookup fails", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		mockEventService := new(MockEventService)
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, mockEventService)
+
+		deletedPvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		kazanPvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		events := make(chan event_model.Event, 2)
+		events <- event_model.Event{Type: event_model.ReceptionClosed, PvzId: deletedPvzId}
+		events <- event_model.Event{Type: event_model.ProductAdded, PvzId: kazanPvzId}
+		close(events)
+
+		mockEventService.On("Subscribe").Return(events, func() {})
+		mockPvzService.On("GetPvzById", mock.Anything, deletedPvzId).Return(nil, custom_errors.ErrGetPvz).Once()
+		mockPvzService.On("GetPvzById", mock.Anything, kazanPvzId).Return(&pvz_model.Pvz{Id: kazanPvzId, City: pvz_model.Kazan}, nil).Once()
+
+		stream := &mockPVZEventStream{ctx: context.Background()}
+		err := handler.WatchPVZEvents(&pvz_v1.WatchPVZEventsRequest{City: string(pvz_model.Kazan)}, stream)
+
+		require.NoError(t, err)
+		require.Len(t, stream.events, 1)
+		assert.Equal(t, pvz_v1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED, stream.events[0].Type)
+		mockPvzService.AssertExpectations(t)
+	})
+
+	t.Run("Watch events stops when client disconnects", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		mockEventService := new(MockEventService)
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, mockEventService)
+
+		mockEventService.On("Subscribe").Return(make(chan event_model.Event), func() {})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		stream := &mockPVZEventStream{ctx: ctx}
+		err := handler.WatchPVZEvents(&pvz_v1.WatchPVZEventsRequest{}, stream)
+
+		assert.NoError(t, err)
+		assert.Empty(t, stream.events)
+	})
+
+	t.Run("Watch events with invalid pvz id", func(t *testing.T) {
+		_, _, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		mockEventService := new(MockEventService)
+		handler := api.NewGrpcHandler(mockPvzService, mockReceptionService, mockProductService, mockEventService)
+
+		stream := &mockPVZEventStream{ctx: context.Background()}
+		err := handler.WatchPVZEvents(&pvz_v1.WatchPVZEventsRequest{PvzId: "invalid"}, stream)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockEventService.AssertNotCalled(t, "Subscribe")
+	})
 }
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/api"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/middlewares"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
@@ -21,6 +23,7 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 )
@@ -29,28 +32,46 @@ type MockUserService struct {
 	mock.Mock
 }
 
-func (m *MockUserService) DummyLogin(ctx context.Context, roleDto generated.UserRole) (string, error) {
+func (m *MockUserService) DummyLogin(ctx context.Context, roleDto generated.UserRole) (*user_model.Tokens, error) {
 	args := m.Called(ctx, roleDto)
 	if args.Get(0) == nil {
-		return "", args.Error(1)
+		return nil, args.Error(1)
 	}
-	return args.String(0), args.Error(1)
+	return args.Get(0).(*user_model.Tokens), args.Error(1)
 }
 
-func (m *MockUserService) Register(ctx context.Context, emailDto openapi_types.Email, password string, roleDto generated.UserRole) (*generated.User, string, error) {
+func (m *MockUserService) Register(ctx context.Context, emailDto openapi_types.Email, password string, roleDto generated.UserRole) (*generated.User, *user_model.Tokens, error) {
 	args := m.Called(ctx, emailDto, password, roleDto)
 	if args.Get(0) == nil {
-		return nil, "", args.Error(2)
+		return nil, nil, args.Error(2)
 	}
-	return args.Get(0).(*generated.User), args.String(1), args.Error(2)
+	return args.Get(0).(*generated.User), args.Get(1).(*user_model.Tokens), args.Error(2)
 }
 
-func (m *MockUserService) Login(ctx context.Context, emailDto openapi_types.Email, password string) (string, error) {
+func (m *MockUserService) Login(ctx context.Context, emailDto openapi_types.Email, password string) (*user_model.Tokens, error) {
 	args := m.Called(ctx, emailDto, password)
 	if args.Get(0) == nil {
-		return "", args.Error(1)
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user_model.Tokens), args.Error(1)
+}
+
+func (m *MockUserService) Refresh(ctx context.Context, refreshToken string) (*user_model.Tokens, error) {
+	args := m.Called(ctx, refreshToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
 	}
-	return args.String(0), args.Error(1)
+	return args.Get(0).(*user_model.Tokens), args.Error(1)
+}
+
+func (m *MockUserService) Logout(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockUserService) LogoutAll(ctx context.Context, userId pgtype.UUID) error {
+	args := m.Called(ctx, userId)
+	return args.Error(0)
 }
 
 func (m *MockUserService) ValidateToken(ctx context.Context, token string) (*user_model.User, error) {
@@ -61,6 +82,11 @@ func (m *MockUserService) ValidateToken(ctx context.Context, token string) (*use
 	return args.Get(0).(*user_model.User), args.Error(1)
 }
 
+func (m *MockUserService) GetJwks() *generated.Jwks {
+	args := m.Called()
+	return args.Get(0).(*generated.Jwks)
+}
+
 type MockPvzService struct {
 	mock.Mock
 }
@@ -73,12 +99,44 @@ func (m *MockPvzService) CreatePvz(ctx context.Context, pvzDto generated.PVZ) (*
 	return args.Get(0).(*generated.PVZ), args.Error(1)
 }
 
-func (m *MockPvzService) GetPvzFullInfo(ctx context.Context, params generated.GetPvzParams) ([]map[string]interface{}, error) {
+func (m *MockPvzService) UpdatePvz(ctx context.Context, pvzIdDto openapi_types.UUID, pvzReq generated.PutPvzPvzIdJSONRequestBody) (*generated.PVZ, error) {
+	args := m.Called(ctx, pvzIdDto, pvzReq)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.PVZ), args.Error(1)
+}
+
+func (m *MockPvzService) DeactivatePvz(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZ, error) {
+	args := m.Called(ctx, pvzIdDto)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.PVZ), args.Error(1)
+}
+
+func (m *MockPvzService) ActivatePvz(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZ, error) {
+	args := m.Called(ctx, pvzIdDto)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.PVZ), args.Error(1)
+}
+
+func (m *MockPvzService) ArchivePvz(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZ, error) {
+	args := m.Called(ctx, pvzIdDto)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.PVZ), args.Error(1)
+}
+
+func (m *MockPvzService) GetPvzFullInfo(ctx context.Context, params generated.GetPvzParams) (*pvz_model.PvzPage, error) {
 	args := m.Called(ctx, params)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]map[string]interface{}), args.Error(1)
+	return args.Get(0).(*pvz_model.PvzPage), args.Error(1)
 }
 
 func (m *MockPvzService) GetAllPvz(ctx context.Context) ([]pvz_model.Pvz, error) {
@@ -86,6 +144,46 @@ func (m *MockPvzService) GetAllPvz(ctx context.Context) ([]pvz_model.Pvz, error)
 	return args.Get(0).([]pvz_model.Pvz), args.Error(1)
 }
 
+func (m *MockPvzService) GetPvzList(ctx context.Context, limit *int, cursor string) ([]pvz_model.Pvz, string, error) {
+	args := m.Called(ctx, limit, cursor)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).([]pvz_model.Pvz), args.String(1), args.Error(2)
+}
+
+func (m *MockPvzService) GetNearbyPvz(ctx context.Context, latitude, longitude float64, radius *float64, limit *int) ([]pvz_model.NearbyPvz, error) {
+	args := m.Called(ctx, latitude, longitude, radius, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]pvz_model.NearbyPvz), args.Error(1)
+}
+
+func (m *MockPvzService) GetPvzOccupancy(ctx context.Context, pvzIdDto openapi_types.UUID) (*generated.PVZOccupancy, error) {
+	args := m.Called(ctx, pvzIdDto)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.PVZOccupancy), args.Error(1)
+}
+
+func (m *MockPvzService) UpdatePvzCapacity(ctx context.Context, pvzIdDto openapi_types.UUID, capacityReq generated.PutPvzPvzIdCapacityJSONRequestBody) (*generated.PVZOccupancy, error) {
+	args := m.Called(ctx, pvzIdDto, capacityReq)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.PVZOccupancy), args.Error(1)
+}
+
+func (m *MockPvzService) GetPvzById(ctx context.Context, id pgtype.UUID) (*pvz_model.Pvz, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pvz_model.Pvz), args.Error(1)
+}
+
 type MockReceptionService struct {
 	mock.Mock
 }
@@ -106,6 +204,46 @@ func (m *MockReceptionService) CloseReception(ctx context.Context, pvzIdDto open
 	return args.Get(0).(*generated.Reception), args.Error(1)
 }
 
+func (m *MockReceptionService) GetReception(ctx context.Context, receptionId uuid.UUID) (*generated.ReceptionWithProducts, error) {
+	args := m.Called(ctx, receptionId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.ReceptionWithProducts), args.Error(1)
+}
+
+func (m *MockReceptionService) GetPvzReceptions(ctx context.Context, pvzId uuid.UUID, params generated.GetPvzPvzIdReceptionsParams) (*generated.ReceptionPage, error) {
+	args := m.Called(ctx, pvzId, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.ReceptionPage), args.Error(1)
+}
+
+func (m *MockReceptionService) ReopenReception(ctx context.Context, receptionId uuid.UUID, reason string, reopenedBy pgtype.UUID) (*generated.Reception, error) {
+	args := m.Called(ctx, receptionId, reason, reopenedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.Reception), args.Error(1)
+}
+
+func (m *MockReceptionService) ChangeReceptionStatus(ctx context.Context, receptionId uuid.UUID, statusReq generated.PostReceptionsReceptionIdStatusJSONRequestBody, userId pgtype.UUID, role user_model.UserRole) (*generated.Reception, error) {
+	args := m.Called(ctx, receptionId, statusReq, userId, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.Reception), args.Error(1)
+}
+
+func (m *MockReceptionService) GetReceptionCorrections(ctx context.Context, receptionId uuid.UUID) ([]generated.ReceptionCorrection, error) {
+	args := m.Called(ctx, receptionId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]generated.ReceptionCorrection), args.Error(1)
+}
+
 func (m *MockReceptionService) GetLastReceptionStatus(ctx context.Context, pvzId pgtype.UUID) (*reception_model.ReceptionStatus, error) {
 	args := m.Called(ctx, pvzId)
 	if args.Get(0) == nil {
@@ -118,22 +256,51 @@ type MockProductService struct {
 	mock.Mock
 }
 
-func (m *MockProductService) CreateProduct(ctx context.Context, pvzIdDto openapi_types.UUID, productTypeJson generated.PostProductsJSONBodyType) (*generated.Product, error) {
-	args := m.Called(ctx, pvzIdDto, productTypeJson)
+func (m *MockProductService) CreateProduct(ctx context.Context, productReq generated.PostProductsJSONRequestBody) (*generated.Product, error) {
+	args := m.Called(ctx, productReq)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*generated.Product), args.Error(1)
 }
 
-func (m *MockProductService) DeleteLastProduct(ctx context.Context, pvzIdDto openapi_types.UUID) error {
-	args := m.Called(ctx, pvzIdDto)
+func (m *MockProductService) DeleteLastProduct(ctx context.Context, pvzIdDto openapi_types.UUID, deletedBy pgtype.UUID) error {
+	args := m.Called(ctx, pvzIdDto, deletedBy)
 	if args.Get(0) == nil {
 		return args.Error(0)
 	}
 	return args.Error(0)
 }
 
+func (m *MockProductService) CreateProducts(ctx context.Context, batchReq generated.PostProductsBatchJSONRequestBody) (*generated.ProductBatchResult, error) {
+	args := m.Called(ctx, batchReq)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.ProductBatchResult), args.Error(1)
+}
+
+func (m *MockProductService) DeleteProduct(ctx context.Context, pvzIdDto openapi_types.UUID, productIdDto openapi_types.UUID, deletedBy pgtype.UUID) error {
+	args := m.Called(ctx, pvzIdDto, productIdDto, deletedBy)
+	return args.Error(0)
+}
+
+func (m *MockProductService) RestoreProduct(ctx context.Context, pvzIdDto openapi_types.UUID, productIdDto openapi_types.UUID) (*generated.Product, error) {
+	args := m.Called(ctx, pvzIdDto, productIdDto)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.Product), args.Error(1)
+}
+
+func (m *MockProductService) GetProductByBarcode(ctx context.Context, barcode string) (*generated.ProductLocation, error) {
+	args := m.Called(ctx, barcode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.ProductLocation), args.Error(1)
+}
+
 func setupTestEnv() (*gin.Engine, *MockUserService, *MockPvzService, *MockProductService, *MockReceptionService) {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
@@ -149,14 +316,14 @@ func setupTestEnv() (*gin.Engine, *MockUserService, *MockPvzService, *MockProduc
 func TestPostDummyLogin(t *testing.T) {
 	t.Run("Dummy login", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		loginReq := generated.PostDummyLoginJSONRequestBody{
 			Role: "employee",
 		}
 		jsonData, _ := json.Marshal(loginReq)
 
-		mockUserService.On("DummyLogin", mock.Anything, generated.UserRoleEmployee).Return("valid_token", nil).Once()
+		mockUserService.On("DummyLogin", mock.Anything, generated.UserRoleEmployee).Return(&user_model.Tokens{AccessToken: "valid_token", RefreshToken: "valid_refresh_token"}, nil).Once()
 
 		req, _ := http.NewRequest("POST", "/dummyLogin", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -168,6 +335,11 @@ func TestPostDummyLogin(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		mockUserService.AssertExpectations(t)
 
+		var tokens generated.TokenPair
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &tokens))
+		assert.Equal(t, "valid_token", tokens.AccessToken)
+		assert.Equal(t, "valid_refresh_token", tokens.RefreshToken)
+
 		cookies := w.Result().Cookies()
 		found := false
 		for _, cookie := range cookies {
@@ -183,7 +355,7 @@ func TestPostDummyLogin(t *testing.T) {
 
 	t.Run("Dummy login with invalid role", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		loginReq := generated.PostDummyLoginJSONRequestBody{
 			Role: "invalid role",
@@ -205,7 +377,7 @@ func TestPostDummyLogin(t *testing.T) {
 
 	t.Run("Dummy login with user error", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		loginReq := generated.PostDummyLoginJSONRequestBody{
 			Role: "employee",
@@ -213,7 +385,7 @@ func TestPostDummyLogin(t *testing.T) {
 		jsonData, _ := json.Marshal(loginReq)
 
 		userError := custom_errors.UserError{Message: "user error"}
-		mockUserService.On("DummyLogin", mock.Anything, generated.UserRoleEmployee).Return("", &userError).Once()
+		mockUserService.On("DummyLogin", mock.Anything, generated.UserRoleEmployee).Return(nil, &userError).Once()
 
 		req, _ := http.NewRequest("POST", "/dummyLogin", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -230,14 +402,14 @@ func TestPostDummyLogin(t *testing.T) {
 
 	t.Run("Dummy login with internal error", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		loginReq := generated.PostDummyLoginJSONRequestBody{
 			Role: "employee",
 		}
 		jsonData, _ := json.Marshal(loginReq)
 
-		mockUserService.On("DummyLogin", mock.Anything, generated.UserRoleEmployee).Return("", errors.New("internal error")).Once()
+		mockUserService.On("DummyLogin", mock.Anything, generated.UserRoleEmployee).Return(nil, errors.New("internal error")).Once()
 
 		req, _ := http.NewRequest("POST", "/dummyLogin", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -251,12 +423,32 @@ func TestPostDummyLogin(t *testing.T) {
 		json.Unmarshal(w.Body.Bytes(), &response)
 		assert.Contains(t, response.Message, "Dummy login error")
 	})
+
+	t.Run("Dummy login disabled", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		jsonData, _ := json.Marshal(generated.PostDummyLoginJSONRequestBody{Role: "moderator"})
+
+		mockUserService.On("DummyLogin", mock.Anything, generated.UserRoleModerator).Return(nil, custom_errors.ErrDummyLoginDisabled).Once()
+
+		req, _ := http.NewRequest("POST", "/dummyLogin", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.POST("/dummyLogin", handler.PostDummyLogin)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Empty(t, w.Result().Cookies())
+		mockUserService.AssertExpectations(t)
+	})
 }
 
 func TestPostLogin(t *testing.T) {
 	t.Run("Login", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		loginReq := generated.PostLoginJSONRequestBody{
 			Email:    "test@example.com",
@@ -264,7 +456,7 @@ func TestPostLogin(t *testing.T) {
 		}
 		jsonData, _ := json.Marshal(loginReq)
 
-		mockUserService.On("Login", mock.Anything, openapi_types.Email("test@example.com"), "password123").Return("valid_token", nil).Once()
+		mockUserService.On("Login", mock.Anything, openapi_types.Email("test@example.com"), "password123").Return(&user_model.Tokens{AccessToken: "valid_token", RefreshToken: "valid_refresh_token"}, nil).Once()
 
 		req, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -278,6 +470,11 @@ func TestPostLogin(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		mockUserService.AssertExpectations(t)
 
+		var tokens generated.TokenPair
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &tokens))
+		assert.Equal(t, "valid_token", tokens.AccessToken)
+		assert.Equal(t, "valid_refresh_token", tokens.RefreshToken)
+
 		cookies := w.Result().Cookies()
 		found := false
 		for _, cookie := range cookies {
@@ -285,13 +482,17 @@ func TestPostLogin(t *testing.T) {
 				found = true
 				assert.Equal(t, "valid_token", cookie.Value)
 			}
+			if cookie.Name == "refresh_token" {
+				assert.Equal(t, "valid_refresh_token", cookie.Value)
+				assert.True(t, cookie.HttpOnly)
+			}
 		}
 		assert.True(t, found)
 	})
 
 	t.Run("Login with wrong password", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		loginReq := generated.PostLoginJSONRequestBody{
 			Email:    "test@example.com",
@@ -300,7 +501,7 @@ func TestPostLogin(t *testing.T) {
 		jsonData, _ := json.Marshal(loginReq)
 
 		userError := custom_errors.UserError{Message: "invalid credentials"}
-		mockUserService.On("Login", mock.Anything, openapi_types.Email("test@example.com"), "wrong_password").Return("", &userError).Once()
+		mockUserService.On("Login", mock.Anything, openapi_types.Email("test@example.com"), "wrong_password").Return(nil, &userError).Once()
 
 		req, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -319,7 +520,7 @@ func TestPostLogin(t *testing.T) {
 
 	t.Run("Post Login with invalid email", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		loginReq := generated.PostLoginJSONRequestBody{
 			Email:    "testexample.com",
@@ -328,7 +529,7 @@ func TestPostLogin(t *testing.T) {
 		jsonData, _ := json.Marshal(loginReq)
 
 		userError := custom_errors.UserError{Message: "invalid credentials"}
-		mockUserService.On("Login", mock.Anything, openapi_types.Email("testexample.com"), "wrong_password").Return("", &userError).Once()
+		mockUserService.On("Login", mock.Anything, openapi_types.Email("testexample.com"), "wrong_password").Return(nil, &userError).Once()
 
 		req, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -347,7 +548,7 @@ func TestPostLogin(t *testing.T) {
 
 	t.Run("Post Login with internal err0r", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		loginReq := generated.PostLoginJSONRequestBody{
 			Email:    "test@example.com",
@@ -356,7 +557,7 @@ func TestPostLogin(t *testing.T) {
 		jsonData, _ := json.Marshal(loginReq)
 
 		internalError := errors.New("internal error")
-		mockUserService.On("Login", mock.Anything, openapi_types.Email("test@example.com"), "password123").Return("", internalError).Once()
+		mockUserService.On("Login", mock.Anything, openapi_types.Email("test@example.com"), "password123").Return(nil, internalError).Once()
 
 		req, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -374,14 +575,189 @@ func TestPostLogin(t *testing.T) {
 	})
 }
 
+func TestPostRefresh(t *testing.T) {
+	t.Run("Refresh with cookie", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		mockUserService.On("Refresh", mock.Anything, "old_refresh_token").
+			Return(&user_model.Tokens{AccessToken: "new_token", RefreshToken: "new_refresh_token"}, nil).Once()
+
+		req, _ := http.NewRequest("POST", "/refresh", nil)
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "old_refresh_token"})
+		w := httptest.NewRecorder()
+
+		router.POST("/refresh", handler.PostRefresh)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUserService.AssertExpectations(t)
+
+		var tokens generated.TokenPair
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &tokens))
+		assert.Equal(t, "new_token", tokens.AccessToken)
+		assert.Equal(t, "new_refresh_token", tokens.RefreshToken)
+
+		cookies := make(map[string]string)
+		for _, cookie := range w.Result().Cookies() {
+			cookies[cookie.Name] = cookie.Value
+		}
+		assert.Equal(t, "new_token", cookies["auth_token"])
+		assert.Equal(t, "new_refresh_token", cookies["refresh_token"])
+	})
+
+	t.Run("Refresh with body", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		refreshToken := "body_refresh_token"
+		jsonData, _ := json.Marshal(generated.PostRefreshJSONRequestBody{RefreshToken: &refreshToken})
+
+		mockUserService.On("Refresh", mock.Anything, refreshToken).
+			Return(&user_model.Tokens{AccessToken: "new_token", RefreshToken: "new_refresh_token"}, nil).Once()
+
+		req, _ := http.NewRequest("POST", "/refresh", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "cookie_refresh_token"})
+		w := httptest.NewRecorder()
+
+		router.POST("/refresh", handler.PostRefresh)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUserService.AssertExpectations(t)
+
+		var tokens generated.TokenPair
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &tokens))
+		assert.Equal(t, "new_token", tokens.AccessToken)
+		assert.Equal(t, "new_refresh_token", tokens.RefreshToken)
+	})
+
+	t.Run("Refresh with reused token", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		mockUserService.On("Refresh", mock.Anything, "used_refresh_token").Return(nil, custom_errors.ErrRefreshTokenReused).Once()
+
+		req, _ := http.NewRequest("POST", "/refresh", nil)
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "used_refresh_token"})
+		w := httptest.NewRecorder()
+
+		router.POST("/refresh", handler.PostRefresh)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, custom_errors.ErrRefreshTokenReused.Message)
+	})
+}
+
+func TestPostLogout(t *testing.T) {
+	t.Run("Logout current session", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		mockUserService.On("Logout", mock.Anything, "valid_token").Return(nil).Once()
+
+		router.POST("/logout", func(c *gin.Context) {
+			c.Set(middlewares.AuthTokenKey, "valid_token")
+			handler.PostLogout(c, generated.PostLogoutParams{})
+		})
+
+		req, _ := http.NewRequest("POST", "/logout", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUserService.AssertExpectations(t)
+		mockUserService.AssertNotCalled(t, "LogoutAll", mock.Anything, mock.Anything)
+
+		for _, cookie := range w.Result().Cookies() {
+			assert.Empty(t, cookie.Value)
+			assert.Negative(t, cookie.MaxAge)
+		}
+	})
+
+	t.Run("Logout all sessions", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		user := &user_model.User{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Role: user_model.Employee}
+		all := true
+
+		mockUserService.On("LogoutAll", mock.Anything, user.Id).Return(nil).Once()
+
+		router.POST("/logout", func(c *gin.Context) {
+			c.Set(middlewares.AuthUserKey, user)
+			c.Set(middlewares.AuthTokenKey, "valid_token")
+			handler.PostLogout(c, generated.PostLogoutParams{All: &all})
+		})
+
+		req, _ := http.NewRequest("POST", "/logout?all=true", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUserService.AssertExpectations(t)
+		mockUserService.AssertNotCalled(t, "Logout", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Logout with internal error", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		mockUserService.On("Logout", mock.Anything, "valid_token").Return(custom_errors.ErrRevokeTokens).Once()
+
+		router.POST("/logout", func(c *gin.Context) {
+			c.Set(middlewares.AuthTokenKey, "valid_token")
+			handler.PostLogout(c, generated.PostLogoutParams{})
+		})
+
+		req, _ := http.NewRequest("POST", "/logout", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGetWellKnownJwksJson(t *testing.T) {
+	router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+	handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+	n, e := "modulus", "AQAB"
+	jwks := &generated.Jwks{Keys: []generated.Jwk{
+		{Kty: generated.RSA, Kid: "key-1", Use: "sig", Alg: generated.RS256, N: &n, E: &e},
+	}}
+	mockUserService.On("GetJwks").Return(jwks).Once()
+
+	req, _ := http.NewRequest("GET", "/.well-known/jwks.json", nil)
+	w := httptest.NewRecorder()
+
+	router.GET("/.well-known/jwks.json", handler.GetWellKnownJwksJson)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+	mockUserService.AssertExpectations(t)
+
+	var response generated.Jwks
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, *jwks, response)
+}
 func TestPostProducts(t *testing.T) {
 	t.Run("Create product in reception in progress", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 		pvzId := uuid.New()
 		productReq := generated.PostProductsJSONRequestBody{
 			PvzId: pvzId,
-			Type:  generated.PostProductsJSONBodyTypeОбувь,
+			Type:  "обувь",
 		}
 
 		jsonData, _ := json.Marshal(productReq)
@@ -389,11 +765,11 @@ func TestPostProducts(t *testing.T) {
 		dateTime := time.Now().Add(-time.Hour)
 		receptionId := uuid.New()
 
-		mockProductService.On("CreateProduct", mock.Anything, pvzId, generated.PostProductsJSONBodyTypeОбувь).Return(&generated.Product{
+		mockProductService.On("CreateProduct", mock.Anything, productReq).Return(&generated.Product{
 			Id:          &productId,
 			DateTime:    &dateTime,
 			ReceptionId: receptionId,
-			Type:        generated.ProductTypeОбувь,
+			Type:        "обувь",
 		}, nil).Once()
 
 		req, _ := http.NewRequest("POST", "/products", bytes.NewBuffer(jsonData))
@@ -412,22 +788,22 @@ func TestPostProducts(t *testing.T) {
 		json.Unmarshal(w.Body.Bytes(), &response)
 		assert.Equal(t, &productId, response.Id)
 		assert.Equal(t, receptionId, response.ReceptionId)
-		assert.Equal(t, generated.ProductTypeОбувь, response.Type)
+		assert.Equal(t, "обувь", response.Type)
 	})
 
 	t.Run("Create product with invalid type", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzId := uuid.New()
 		productReq := generated.PostProductsJSONRequestBody{
 			PvzId: pvzId,
-			Type:  generated.PostProductsJSONBodyType("InvalidType"),
+			Type:  "InvalidType",
 		}
 		jsonData, _ := json.Marshal(productReq)
 
 		userError := custom_errors.UserError{Message: "invalid credentials"}
-		mockProductService.On("CreateProduct", mock.Anything, pvzId, generated.PostProductsJSONBodyType("InvalidType")).Return(&generated.Product{}, &userError).Once()
+		mockProductService.On("CreateProduct", mock.Anything, productReq).Return(&generated.Product{}, &userError).Once()
 
 		req, _ := http.NewRequest("POST", "/products", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -446,16 +822,16 @@ func TestPostProducts(t *testing.T) {
 
 	t.Run("Post products with internal error", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 		pvzId := uuid.New()
 		productReq := generated.PostProductsJSONRequestBody{
 			PvzId: pvzId,
-			Type:  generated.PostProductsJSONBodyTypeОбувь,
+			Type:  "обувь",
 		}
 		jsonData, _ := json.Marshal(productReq)
 
 		internalError := errors.New("internal error")
-		mockProductService.On("CreateProduct", mock.Anything, pvzId, generated.PostProductsJSONBodyTypeОбувь).Return(nil, internalError).Once()
+		mockProductService.On("CreateProduct", mock.Anything, productReq).Return(nil, internalError).Once()
 
 		req, _ := http.NewRequest("POST", "/products", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -473,63 +849,230 @@ func TestPostProducts(t *testing.T) {
 	})
 }
 
-func TestGetPvz(t *testing.T) {
-	t.Run("Get pvz with default params", func(t *testing.T) {
+func TestPostProductsBatch(t *testing.T) {
+	t.Run("Create products batch with partial failure", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
-		expectedResp := []map[string]interface{}{
-			{"id": "1", "name": "ПВЗ 1", "address": "Адрес 1"},
-			{"id": "2", "name": "ПВЗ 2", "address": "Адрес 2"},
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+		pvzId := uuid.New()
+		batchReq := generated.PostProductsBatchJSONRequestBody{
+			PvzId: pvzId,
+			Items: []generated.ProductBatchItem{{Type: "обувь"}, {Type: "мебель"}},
 		}
+		jsonData, _ := json.Marshal(batchReq)
 
-		mockPvzService.On("GetPvzFullInfo", mock.Anything, generated.GetPvzParams{}).
-			Return(expectedResp, nil).Once()
+		productId := uuid.New()
+		receptionId := uuid.New()
+		errMessage := custom_errors.ErrProductType.Message
+		mockProductService.On("CreateProducts", mock.Anything, batchReq).Return(&generated.ProductBatchResult{
+			ReceptionId: receptionId,
+			Created:     1,
+			Failed:      1,
+			Items: []generated.ProductBatchItemResult{
+				{Index: 0, Product: &generated.Product{Id: &productId, ReceptionId: receptionId, Type: "обувь"}},
+				{Index: 1, Error: &errMessage},
+			},
+		}, nil).Once()
 
-		req, _ := http.NewRequest("GET", "/pvz", nil)
+		req, _ := http.NewRequest("POST", "/products/batch", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
-		router.GET("/pvz", func(c *gin.Context) {
-			handler.GetPvz(c, generated.GetPvzParams{})
+		router.POST("/products/batch", func(c *gin.Context) {
+			handler.PostProductsBatch(c)
 		})
 		router.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
-		mockPvzService.AssertExpectations(t)
+		mockProductService.AssertExpectations(t)
 
-		var response []map[string]interface{}
+		var response generated.ProductBatchResult
 		json.Unmarshal(w.Body.Bytes(), &response)
-		assert.Equal(t, expectedResp, response)
+		assert.Equal(t, 1, response.Created)
+		assert.Equal(t, 1, response.Failed)
+		assert.Equal(t, &productId, response.Items[0].Product.Id)
+		assert.Equal(t, errMessage, *response.Items[1].Error)
 	})
 
-	t.Run("Get pvz with pagination and date range", func(t *testing.T) {
+	t.Run("Create products batch without open reception", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
-
-		startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
-		endDate := time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC)
-		page := 2
-		limit := 15
-
-		expectedResp := []map[string]interface{}{
-			{"id": "3", "name": "ПВЗ 3", "address": "Адрес 3"},
-			{"id": "4", "name": "ПВЗ 4", "address": "Адрес 4"},
-		}
-
-		params := generated.GetPvzParams{
-			StartDate: &startDate,
-			EndDate:   &endDate,
-			Page:      &page,
-			Limit:     &limit,
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+		batchReq := generated.PostProductsBatchJSONRequestBody{
+			PvzId: uuid.New(),
+			Items: []generated.ProductBatchItem{{Type: "обувь"}},
 		}
+		jsonData, _ := json.Marshal(batchReq)
 
-		mockPvzService.On("GetPvzFullInfo", mock.Anything, params).
-			Return(expectedResp, nil).Once()
+		mockProductService.On("CreateProducts", mock.Anything, batchReq).Return(nil, custom_errors.ErrNoOpenReception).Once()
 
-		req, _ := http.NewRequest("GET", "/pvz?startDate=2023-01-01T00:00:00Z&endDate=2023-12-31T23:59:59Z&page=2&limit=15", nil)
+		req, _ := http.NewRequest("POST", "/products/batch", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
-		router.GET("/pvz", func(c *gin.Context) {
+		router.POST("/products/batch", func(c *gin.Context) {
+			handler.PostProductsBatch(c)
+		})
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, "Invalid request format to create products")
+	})
+}
+
+func TestGetProductsByBarcodeCode(t *testing.T) {
+	t.Run("Get product by barcode", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+		barcode := "4600000000011"
+		pvzId := uuid.New()
+
+		mockProductService.On("GetProductByBarcode", mock.Anything, barcode).Return(&generated.ProductLocation{
+			Product:   generated.Product{Type: "обувь", Barcode: &barcode},
+			Reception: generated.Reception{PvzId: pvzId, Status: generated.InProgress},
+			Pvz:       generated.PVZ{Id: &pvzId, City: "Москва"},
+		}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/products/by-barcode/"+barcode, nil)
+		w := httptest.NewRecorder()
+
+		router.GET("/products/by-barcode/:code", func(c *gin.Context) {
+			handler.GetProductsByBarcodeCode(c, c.Param("code"))
+		})
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockProductService.AssertExpectations(t)
+
+		var response generated.ProductLocation
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, barcode, *response.Product.Barcode)
+		assert.Equal(t, &pvzId, response.Pvz.Id)
+	})
+
+	t.Run("Get product by unknown barcode", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		mockProductService.On("GetProductByBarcode", mock.Anything, "unknown").Return(nil, custom_errors.ErrProductNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/products/by-barcode/unknown", nil)
+		w := httptest.NewRecorder()
+
+		router.GET("/products/by-barcode/:code", func(c *gin.Context) {
+			handler.GetProductsByBarcodeCode(c, c.Param("code"))
+		})
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, custom_errors.ErrProductNotFound.Message)
+	})
+}
+
+func TestGetPvz(t *testing.T) {
+	t.Run("Get pvz with default params", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+		registrationDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		pvzId := uuid.New()
+		receptionId := uuid.New()
+		productId := uuid.New()
+		pvzList := []pvz_model.PvzWithReceptions{
+			{
+				Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: pvzId, Valid: true}, RegistrationDate: registrationDate, City: pvz_model.Moscow},
+				Receptions: []pvz_model.ReceptionWithProducts{
+					{
+						Reception: reception_model.Reception{
+							Id:            pgtype.UUID{Bytes: receptionId, Valid: true},
+							ReceptionTime: registrationDate.Add(time.Hour),
+							PvzId:         pgtype.UUID{Bytes: pvzId, Valid: true},
+							Status:        reception_model.Close,
+						},
+						Products: []product_model.Product{
+							{
+								Id:          pgtype.UUID{Bytes: productId, Valid: true},
+								AddingTime:  registrationDate.Add(2 * time.Hour),
+								ProductType: product_model.Shoes,
+								ReceptionId: pgtype.UUID{Bytes: receptionId, Valid: true},
+							},
+						},
+					},
+				},
+			},
+			{
+				Pvz:        pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, RegistrationDate: registrationDate, City: pvz_model.Kazan},
+				Receptions: []pvz_model.ReceptionWithProducts{},
+			},
+		}
+
+		mockPvzService.On("GetPvzFullInfo", mock.Anything, generated.GetPvzParams{}).
+			Return(&pvz_model.PvzPage{Pvzs: pvzList, Page: 1, Limit: 10, Total: 2}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/pvz", nil)
+		w := httptest.NewRecorder()
+
+		router.GET("/pvz", func(c *gin.Context) {
+			handler.GetPvz(c, generated.GetPvzParams{})
+		})
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockPvzService.AssertExpectations(t)
+
+		var page generated.PVZPage
+		json.Unmarshal(w.Body.Bytes(), &page)
+		assert.Equal(t, 1, page.Page)
+		assert.Equal(t, 10, page.Limit)
+		assert.Equal(t, 2, page.Total)
+		assert.Equal(t, 1, page.TotalPages)
+		assert.Nil(t, page.NextCursor)
+
+		response := page.Pvzs
+		require.Len(t, response, 2)
+		assert.Equal(t, pvzId, *response[0].Pvz.Id)
+		assert.Equal(t, "Москва", response[0].Pvz.City)
+		require.Len(t, response[0].Receptions, 1)
+		assert.Equal(t, receptionId, *response[0].Receptions[0].Reception.Id)
+		assert.Equal(t, registrationDate.Add(time.Hour), response[0].Receptions[0].Reception.DateTime)
+		assert.Equal(t, generated.Close, response[0].Receptions[0].Reception.Status)
+		require.Len(t, response[0].Receptions[0].Products, 1)
+		assert.Equal(t, productId, *response[0].Receptions[0].Products[0].Id)
+		assert.Equal(t, "обувь", response[0].Receptions[0].Products[0].Type)
+		assert.Empty(t, response[1].Receptions)
+		assert.Contains(t, w.Body.String(), `"receptions":[]`)
+	})
+
+	t.Run("Get pvz with pagination and date range", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC)
+		page := 2
+		limit := 15
+
+		pvzId := uuid.New()
+		pvzList := []pvz_model.PvzWithReceptions{
+			{Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: pvzId, Valid: true}, RegistrationDate: startDate, City: pvz_model.SPb}},
+		}
+
+		params := generated.GetPvzParams{
+			StartDate: &startDate,
+			EndDate:   &endDate,
+			Page:      &page,
+			Limit:     &limit,
+		}
+
+		mockPvzService.On("GetPvzFullInfo", mock.Anything, params).
+			Return(&pvz_model.PvzPage{Pvzs: pvzList, Page: page, Limit: limit, Total: 31, NextCursor: "next"}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/pvz?startDate=2023-01-01T00:00:00Z&endDate=2023-12-31T23:59:59Z&page=2&limit=15", nil)
+		w := httptest.NewRecorder()
+
+		router.GET("/pvz", func(c *gin.Context) {
 			c.Request = req
 			handler.GetPvz(c, params)
 		})
@@ -538,14 +1081,22 @@ func TestGetPvz(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		mockPvzService.AssertExpectations(t)
 
-		var response []map[string]interface{}
+		var response generated.PVZPage
 		json.Unmarshal(w.Body.Bytes(), &response)
-		assert.Equal(t, expectedResp, response)
+		assert.Equal(t, page, response.Page)
+		assert.Equal(t, limit, response.Limit)
+		assert.Equal(t, 31, response.Total)
+		assert.Equal(t, 3, response.TotalPages)
+		require.NotNil(t, response.NextCursor)
+		assert.Equal(t, "next", *response.NextCursor)
+		require.Len(t, response.Pvzs, 1)
+		assert.Equal(t, pvzId, *response.Pvzs[0].Pvz.Id)
+		assert.Equal(t, "Санкт-Петербург", response.Pvzs[0].Pvz.City)
 	})
 
 	t.Run("Get pvz with invalid date range", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		startDate := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
 		endDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -578,7 +1129,7 @@ func TestGetPvz(t *testing.T) {
 
 	t.Run("Get pvz with invalid limit", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		limit := 50
 
@@ -609,7 +1160,7 @@ func TestGetPvz(t *testing.T) {
 
 	t.Run("Get pvz with invalid page", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		page := 0
 
@@ -640,7 +1191,7 @@ func TestGetPvz(t *testing.T) {
 
 	t.Run("Get pvz with internal error", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		internalErr := errors.New("database connection error")
 		mockPvzService.On("GetPvzFullInfo", mock.Anything, generated.GetPvzParams{}).
@@ -664,13 +1215,82 @@ func TestGetPvz(t *testing.T) {
 	})
 }
 
+func TestGetPvzNearby(t *testing.T) {
+	t.Run("Get nearby pvz", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+		latitude, longitude := 55.7539, 37.6208
+		radius := 1000.0
+		params := generated.GetPvzNearbyParams{Lat: 55.7558, Lon: 37.6173, Radius: &radius}
+
+		mockPvzService.On("GetNearbyPvz", mock.Anything, params.Lat, params.Lon, &radius, (*int)(nil)).Return([]pvz_model.NearbyPvz{
+			{
+				Pvz: pvz_model.Pvz{
+					Id:        pgtype.UUID{Bytes: pvzId, Valid: true},
+					City:      pvz_model.Moscow,
+					Status:    pvz_model.Active,
+					Latitude:  &latitude,
+					Longitude: &longitude,
+				},
+				Distance: 304.3,
+			},
+		}, nil).Once()
+
+		router.GET("/pvz/nearby", func(c *gin.Context) {
+			handler.GetPvzNearby(c, params)
+		})
+
+		req, _ := http.NewRequest("GET", "/pvz/nearby?lat=55.7558&lon=37.6173&radius=1000", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockPvzService.AssertExpectations(t)
+
+		var response []generated.NearbyPVZ
+		json.Unmarshal(w.Body.Bytes(), &response)
+		require.Len(t, response, 1)
+		assert.Equal(t, &pvzId, response[0].Pvz.Id)
+		assert.Equal(t, &latitude, response[0].Pvz.Latitude)
+		assert.Equal(t, 304.3, response[0].Distance)
+	})
+
+	t.Run("Get nearby pvz with invalid radius", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		radius := 0.0
+		params := generated.GetPvzNearbyParams{Lat: 55.7558, Lon: 37.6173, Radius: &radius}
+
+		mockPvzService.On("GetNearbyPvz", mock.Anything, params.Lat, params.Lon, &radius, (*int)(nil)).
+			Return(nil, custom_errors.ErrRadiusValue).Once()
+
+		router.GET("/pvz/nearby", func(c *gin.Context) {
+			handler.GetPvzNearby(c, params)
+		})
+
+		req, _ := http.NewRequest("GET", "/pvz/nearby?lat=55.7558&lon=37.6173&radius=0", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, custom_errors.ErrRadiusValue.Message)
+	})
+}
+
 func TestPostPvz(t *testing.T) {
 	t.Run("Create pvz", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzReq := generated.PostPvzJSONRequestBody{
-			City: generated.СанктПетербург,
+			City: "Санкт-Петербург",
 		}
 		jsonData, _ := json.Marshal(pvzReq)
 		pvzId := uuid.New()
@@ -678,7 +1298,7 @@ func TestPostPvz(t *testing.T) {
 
 		mockPvzService.On("CreatePvz", mock.Anything, pvzReq).Return(&generated.PVZ{
 			Id:               &pvzId,
-			City:             generated.СанктПетербург,
+			City:             "Санкт-Петербург",
 			RegistrationDate: &registrationDate,
 		}, nil).Once()
 
@@ -695,15 +1315,15 @@ func TestPostPvz(t *testing.T) {
 		var response generated.PVZ
 		json.Unmarshal(w.Body.Bytes(), &response)
 		assert.Equal(t, &pvzId, response.Id)
-		assert.Equal(t, generated.СанктПетербург, response.City)
+		assert.Equal(t, "Санкт-Петербург", response.City)
 	})
 
 	t.Run("Create pvz with user error", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzReq := generated.PostPvzJSONRequestBody{
-			City: generated.PVZCity(""),
+			City: "",
 		}
 		jsonData, _ := json.Marshal(pvzReq)
 
@@ -725,10 +1345,10 @@ func TestPostPvz(t *testing.T) {
 
 	t.Run("Create pvz with internal error", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzReq := generated.PostPvzJSONRequestBody{
-			City: generated.СанктПетербург,
+			City: "Санкт-Петербург",
 		}
 		jsonData, _ := json.Marshal(pvzReq)
 
@@ -749,54 +1369,61 @@ func TestPostPvz(t *testing.T) {
 	})
 }
 
-func TestPostPvzPvzIdCloseLastReception(t *testing.T) {
-	t.Run("Close last reception", func(t *testing.T) {
+func TestPutPvzPvzId(t *testing.T) {
+	t.Run("Update pvz", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzId := uuid.New()
-		receptionId := uuid.New()
-
-		dateTime := time.Now()
-		mockReceptionService.On("CloseReception", mock.Anything, pvzId).Return(&generated.Reception{
-			Id:       &receptionId,
-			PvzId:    pvzId,
-			Status:   generated.Close,
-			DateTime: dateTime,
+		address := "ул. Тверская, 1"
+		capacity := 300
+		pvzReq := generated.PutPvzPvzIdJSONRequestBody{Address: &address, Capacity: &capacity}
+		jsonData, _ := json.Marshal(pvzReq)
+		status := generated.Active
+
+		mockPvzService.On("UpdatePvz", mock.Anything, pvzId, pvzReq).Return(&generated.PVZ{
+			Id:       &pvzId,
+			City:     "Москва",
+			Address:  &address,
+			Capacity: &capacity,
+			Status:   &status,
 		}, nil).Once()
 
-		router.POST("/pvz/"+pvzId.String()+"/close-last-reception", func(c *gin.Context) {
-			handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+		router.PUT("/pvz/"+pvzId.String(), func(c *gin.Context) {
+			handler.PutPvzPvzId(c, pvzId)
 		})
 
-		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/close-last-reception", nil)
+		req, _ := http.NewRequest("PUT", "/pvz/"+pvzId.String(), bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
-		mockReceptionService.AssertExpectations(t)
+		mockPvzService.AssertExpectations(t)
 
-		var response generated.Reception
+		var response generated.PVZ
 		json.Unmarshal(w.Body.Bytes(), &response)
-		assert.Equal(t, &receptionId, response.Id)
-		assert.Equal(t, generated.Close, response.Status)
+		assert.Equal(t, &address, response.Address)
+		assert.Equal(t, &capacity, response.Capacity)
 	})
 
-	t.Run("Close last reception with user error", func(t *testing.T) {
+	t.Run("Update archived pvz", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzId := uuid.New()
+		pvzReq := generated.PutPvzPvzIdJSONRequestBody{}
+		jsonData, _ := json.Marshal(pvzReq)
 
-		userErr := custom_errors.UserError{}
-		mockReceptionService.On("CloseReception", mock.Anything, pvzId).Return(nil, &userErr).Once()
+		mockPvzService.On("UpdatePvz", mock.Anything, pvzId, pvzReq).Return(nil, custom_errors.ErrPvzArchived).Once()
 
-		router.POST("/pvz/"+pvzId.String()+"/close-last-reception", func(c *gin.Context) {
-			handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+		router.PUT("/pvz/"+pvzId.String(), func(c *gin.Context) {
+			handler.PutPvzPvzId(c, pvzId)
 		})
 
-		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/close-last-reception", nil)
+		req, _ := http.NewRequest("PUT", "/pvz/"+pvzId.String(), bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
@@ -804,70 +1431,155 @@ func TestPostPvzPvzIdCloseLastReception(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 		var response generated.Error
 		json.Unmarshal(w.Body.Bytes(), &response)
-		assert.Contains(t, response.Message, "Invalid request format to close last reception error")
+		assert.Contains(t, response.Message, custom_errors.ErrPvzArchived.Message)
 	})
+}
 
-	t.Run("Close last reception with internal error", func(t *testing.T) {
+func TestPostPvzPvzIdDeactivate(t *testing.T) {
+	t.Run("Deactivate pvz", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzId := uuid.New()
+		status := generated.Inactive
 
-		internalErr := errors.New("internal error")
-		mockReceptionService.On("CloseReception", mock.Anything, pvzId).Return(nil, internalErr).Once()
+		mockPvzService.On("DeactivatePvz", mock.Anything, pvzId).Return(&generated.PVZ{
+			Id:     &pvzId,
+			City:   "Москва",
+			Status: &status,
+		}, nil).Once()
 
-		router.POST("/pvz/"+pvzId.String()+"/close-last-reception", func(c *gin.Context) {
-			handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+		router.POST("/pvz/"+pvzId.String()+"/deactivate", func(c *gin.Context) {
+			handler.PostPvzPvzIdDeactivate(c, pvzId)
 		})
 
-		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/close-last-reception", nil)
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/deactivate", nil)
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
 
-		assert.Equal(t, http.StatusInternalServerError, w.Code)
-		var response generated.Error
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockPvzService.AssertExpectations(t)
+
+		var response generated.PVZ
 		json.Unmarshal(w.Body.Bytes(), &response)
-		assert.Contains(t, response.Message, "Close last reception error")
+		assert.Equal(t, generated.Inactive, *response.Status)
+	})
+
+	t.Run("Deactivate inactive pvz", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+
+		mockPvzService.On("DeactivatePvz", mock.Anything, pvzId).Return(nil, custom_errors.ErrPvzStatus).Once()
+
+		router.POST("/pvz/"+pvzId.String()+"/deactivate", func(c *gin.Context) {
+			handler.PostPvzPvzIdDeactivate(c, pvzId)
+		})
+
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/deactivate", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 }
 
-func TestPostPvzPvzIdDeleteLastProduct(t *testing.T) {
-	t.Run("Delete last product", func(t *testing.T) {
+func TestPostPvzPvzIdArchive(t *testing.T) {
+	t.Run("Archive pvz with open reception", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzId := uuid.New()
 
-		mockProductService.On("DeleteLastProduct", mock.Anything, pvzId).Return(nil).Once()
+		mockPvzService.On("ArchivePvz", mock.Anything, pvzId).Return(nil, custom_errors.ErrPvzOpenReception).Once()
 
-		router.POST("/pvz/"+pvzId.String()+"/delete-last-product", func(c *gin.Context) {
-			handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+		router.POST("/pvz/"+pvzId.String()+"/archive", func(c *gin.Context) {
+			handler.PostPvzPvzIdArchive(c, pvzId)
 		})
 
-		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/delete-last-product", nil)
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/archive", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, custom_errors.ErrPvzOpenReception.Message)
+	})
+
+	t.Run("Archive pvz with internal error", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+
+		mockPvzService.On("ArchivePvz", mock.Anything, pvzId).Return(nil, errors.New("internal error")).Once()
+
+		router.POST("/pvz/"+pvzId.String()+"/archive", func(c *gin.Context) {
+			handler.PostPvzPvzIdArchive(c, pvzId)
+		})
+
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/archive", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGetPvzPvzIdOccupancy(t *testing.T) {
+	t.Run("Get pvz occupancy", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+		capacity := 100
+
+		mockPvzService.On("GetPvzOccupancy", mock.Anything, pvzId).Return(&generated.PVZOccupancy{
+			PvzId:        pvzId,
+			Capacity:     &capacity,
+			Occupied:     42,
+			StorageHours: 72,
+			ProductTypes: []generated.ProductTypeOccupancy{{Type: "обувь", Occupied: 42}},
+		}, nil).Once()
+
+		router.GET("/pvz/"+pvzId.String()+"/occupancy", func(c *gin.Context) {
+			handler.GetPvzPvzIdOccupancy(c, pvzId)
+		})
+
+		req, _ := http.NewRequest("GET", "/pvz/"+pvzId.String()+"/occupancy", nil)
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
-		mockProductService.AssertExpectations(t)
+		mockPvzService.AssertExpectations(t)
+
+		var response generated.PVZOccupancy
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, 42, response.Occupied)
+		assert.Equal(t, capacity, *response.Capacity)
+		assert.Len(t, response.ProductTypes, 1)
 	})
 
-	t.Run("Delete last product with user error", func(t *testing.T) {
+	t.Run("Get missing pvz occupancy", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzId := uuid.New()
 
-		userErr := custom_errors.UserError{}
-		mockProductService.On("DeleteLastProduct", mock.Anything, pvzId).Return(&userErr).Once()
+		mockPvzService.On("GetPvzOccupancy", mock.Anything, pvzId).Return(nil, custom_errors.ErrNoPvz).Once()
 
-		router.POST("/pvz/"+pvzId.String()+"/delete-last-product", func(c *gin.Context) {
-			handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+		router.GET("/pvz/"+pvzId.String()+"/occupancy", func(c *gin.Context) {
+			handler.GetPvzPvzIdOccupancy(c, pvzId)
 		})
 
-		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/delete-last-product", nil)
+		req, _ := http.NewRequest("GET", "/pvz/"+pvzId.String()+"/occupancy", nil)
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
@@ -875,38 +1587,640 @@ func TestPostPvzPvzIdDeleteLastProduct(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 		var response generated.Error
 		json.Unmarshal(w.Body.Bytes(), &response)
-		assert.Contains(t, response.Message, "Invalid request format to delete last product error")
+		assert.Contains(t, response.Message, custom_errors.ErrNoPvz.Message)
 	})
+}
 
-	t.Run("Delete last product with internal error", func(t *testing.T) {
+func TestPutPvzPvzIdCapacity(t *testing.T) {
+	t.Run("Update pvz capacity", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzId := uuid.New()
+		capacity := 20
+		capacityReq := generated.PutPvzPvzIdCapacityJSONRequestBody{
+			StorageHours: 48,
+			ProductTypes: []generated.ProductTypeCapacity{{Type: "обувь", Capacity: capacity}},
+		}
+		jsonData, _ := json.Marshal(capacityReq)
 
-		internalErr := errors.New("internal error")
-		mockProductService.On("DeleteLastProduct", mock.Anything, pvzId).Return(internalErr).Once()
+		mockPvzService.On("UpdatePvzCapacity", mock.Anything, pvzId, capacityReq).Return(&generated.PVZOccupancy{
+			PvzId:        pvzId,
+			StorageHours: 48,
+			ProductTypes: []generated.ProductTypeOccupancy{{Type: "обувь", Capacity: &capacity}},
+		}, nil).Once()
 
-		router.POST("/pvz/"+pvzId.String()+"/delete-last-product", func(c *gin.Context) {
-			handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+		router.PUT("/pvz/"+pvzId.String()+"/capacity", func(c *gin.Context) {
+			handler.PutPvzPvzIdCapacity(c, pvzId)
 		})
 
-		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/delete-last-product", nil)
+		req, _ := http.NewRequest("PUT", "/pvz/"+pvzId.String()+"/capacity", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
 
-		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockPvzService.AssertExpectations(t)
+
+		var response generated.PVZOccupancy
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, 48, response.StorageHours)
+		assert.Equal(t, capacity, *response.ProductTypes[0].Capacity)
+	})
+
+	t.Run("Update pvz capacity with invalid storage hours", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+		capacityReq := generated.PutPvzPvzIdCapacityJSONRequestBody{ProductTypes: []generated.ProductTypeCapacity{}}
+		jsonData, _ := json.Marshal(capacityReq)
+
+		mockPvzService.On("UpdatePvzCapacity", mock.Anything, pvzId, capacityReq).Return(nil, custom_errors.ErrStorageHours).Once()
+
+		router.PUT("/pvz/"+pvzId.String()+"/capacity", func(c *gin.Context) {
+			handler.PutPvzPvzIdCapacity(c, pvzId)
+		})
+
+		req, _ := http.NewRequest("PUT", "/pvz/"+pvzId.String()+"/capacity", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
 		var response generated.Error
 		json.Unmarshal(w.Body.Bytes(), &response)
-		assert.Contains(t, response.Message, "Delete last reception error")
+		assert.Contains(t, response.Message, custom_errors.ErrStorageHours.Message)
 	})
 }
 
+func TestPostPvzPvzIdCloseLastReception(t *testing.T) {
+	t.Run("Close last reception", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+		receptionId := uuid.New()
+
+		dateTime := time.Now()
+		mockReceptionService.On("CloseReception", mock.Anything, pvzId).Return(&generated.Reception{
+			Id:       &receptionId,
+			PvzId:    pvzId,
+			Status:   generated.Close,
+			DateTime: dateTime,
+		}, nil).Once()
+
+		router.POST("/pvz/"+pvzId.String()+"/close-last-reception", func(c *gin.Context) {
+			handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+		})
+
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/close-last-reception", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockReceptionService.AssertExpectations(t)
+
+		var response generated.Reception
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, &receptionId, response.Id)
+		assert.Equal(t, generated.Close, response.Status)
+	})
+
+	t.Run("Close last reception with user error", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+
+		userErr := custom_errors.UserError{}
+		mockReceptionService.On("CloseReception", mock.Anything, pvzId).Return(nil, &userErr).Once()
+
+		router.POST("/pvz/"+pvzId.String()+"/close-last-reception", func(c *gin.Context) {
+			handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+		})
+
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/close-last-reception", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, "Invalid request format to close last reception error")
+	})
+
+	t.Run("Close last reception with internal error", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+
+		internalErr := errors.New("internal error")
+		mockReceptionService.On("CloseReception", mock.Anything, pvzId).Return(nil, internalErr).Once()
+
+		router.POST("/pvz/"+pvzId.String()+"/close-last-reception", func(c *gin.Context) {
+			handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+		})
+
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/close-last-reception", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, "Close last reception error")
+	})
+}
+
+func TestPostPvzPvzIdDeleteLastProduct(t *testing.T) {
+	t.Run("Delete last product", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+
+		mockProductService.On("DeleteLastProduct", mock.Anything, pvzId, pgtype.UUID{}).Return(nil).Once()
+
+		router.POST("/pvz/"+pvzId.String()+"/delete-last-product", func(c *gin.Context) {
+			handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+		})
+
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/delete-last-product", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockProductService.AssertExpectations(t)
+	})
+
+	t.Run("Delete last product with user error", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+
+		userErr := custom_errors.UserError{}
+		mockProductService.On("DeleteLastProduct", mock.Anything, pvzId, pgtype.UUID{}).Return(&userErr).Once()
+
+		router.POST("/pvz/"+pvzId.String()+"/delete-last-product", func(c *gin.Context) {
+			handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+		})
+
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/delete-last-product", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, "Invalid request format to delete last product error")
+	})
+
+	t.Run("Delete last product with internal error", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+
+		internalErr := errors.New("internal error")
+		mockProductService.On("DeleteLastProduct", mock.Anything, pvzId, pgtype.UUID{}).Return(internalErr).Once()
+
+		router.POST("/pvz/"+pvzId.String()+"/delete-last-product", func(c *gin.Context) {
+			handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+		})
+
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/delete-last-product", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, "Delete last reception error")
+	})
+}
+
+func TestDeletePvzPvzIdProductsProductId(t *testing.T) {
+	t.Run("Delete product by id", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+		productId := uuid.New()
+		user := &user_model.User{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Role: user_model.Employee}
+
+		mockProductService.On("DeleteProduct", mock.Anything, pvzId, productId, user.Id).Return(nil).Once()
+
+		router.DELETE("/pvz/:pvzId/products/:productId", func(c *gin.Context) {
+			c.Set(middlewares.AuthUserKey, user)
+			handler.DeletePvzPvzIdProductsProductId(c, pvzId, productId)
+		})
+
+		req, _ := http.NewRequest("DELETE", "/pvz/"+pvzId.String()+"/products/"+productId.String(), nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockProductService.AssertExpectations(t)
+	})
+
+	t.Run("Delete product missing in reception", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+		productId := uuid.New()
+
+		mockProductService.On("DeleteProduct", mock.Anything, pvzId, productId, pgtype.UUID{}).
+			Return(custom_errors.ErrProductNotFound).Once()
+
+		router.DELETE("/pvz/:pvzId/products/:productId", func(c *gin.Context) {
+			handler.DeletePvzPvzIdProductsProductId(c, pvzId, productId)
+		})
+
+		req, _ := http.NewRequest("DELETE", "/pvz/"+pvzId.String()+"/products/"+productId.String(), nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, custom_errors.ErrProductNotFound.Message)
+	})
+}
+
+func TestPostPvzPvzIdProductsProductIdRestore(t *testing.T) {
+	t.Run("Restore product", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+		productId := uuid.New()
+		receptionId := uuid.New()
+
+		mockProductService.On("RestoreProduct", mock.Anything, pvzId, productId).
+			Return(&generated.Product{Id: &productId, ReceptionId: receptionId, Type: "обувь"}, nil).Once()
+
+		router.POST("/pvz/:pvzId/products/:productId/restore", func(c *gin.Context) {
+			handler.PostPvzPvzIdProductsProductIdRestore(c, pvzId, productId)
+		})
+
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/products/"+productId.String()+"/restore", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response generated.Product
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, &productId, response.Id)
+		mockProductService.AssertExpectations(t)
+	})
+
+	t.Run("Restore product with internal error", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+		productId := uuid.New()
+
+		mockProductService.On("RestoreProduct", mock.Anything, pvzId, productId).Return(nil, errors.New("internal error")).Once()
+
+		router.POST("/pvz/:pvzId/products/:productId/restore", func(c *gin.Context) {
+			handler.PostPvzPvzIdProductsProductIdRestore(c, pvzId, productId)
+		})
+
+		req, _ := http.NewRequest("POST", "/pvz/"+pvzId.String()+"/products/"+productId.String()+"/restore", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, "Restore product error")
+	})
+}
+
+func TestGetReceptionsReceptionId(t *testing.T) {
+	t.Run("Get reception", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		receptionId := uuid.New()
+		productId := uuid.New()
+		receptionResp := &generated.ReceptionWithProducts{
+			Reception: generated.Reception{Id: &receptionId, PvzId: uuid.New(), Status: generated.Close},
+			Products:  []generated.Product{{Id: &productId, ReceptionId: receptionId, Type: "обувь"}},
+		}
+		mockReceptionService.On("GetReception", mock.Anything, receptionId).Return(receptionResp, nil).Once()
+
+		router.GET("/receptions/:receptionId", func(c *gin.Context) {
+			handler.GetReceptionsReceptionId(c, receptionId)
+		})
+
+		req, _ := http.NewRequest("GET", "/receptions/"+receptionId.String(), nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response generated.ReceptionWithProducts
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, &receptionId, response.Reception.Id)
+		require.Len(t, response.Products, 1)
+		assert.Equal(t, &productId, response.Products[0].Id)
+		mockReceptionService.AssertExpectations(t)
+	})
+
+	t.Run("Get not existing reception", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		receptionId := uuid.New()
+		mockReceptionService.On("GetReception", mock.Anything, receptionId).Return(nil, custom_errors.ErrReceptionNotFound).Once()
+
+		router.GET("/receptions/:receptionId", func(c *gin.Context) {
+			handler.GetReceptionsReceptionId(c, receptionId)
+		})
+
+		req, _ := http.NewRequest("GET", "/receptions/"+receptionId.String(), nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, custom_errors.ErrReceptionNotFound.Message)
+	})
+}
+
+func TestGetPvzPvzIdReceptions(t *testing.T) {
+	t.Run("Get pvz receptions", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+		receptionId := uuid.New()
+		status := "close"
+		params := generated.GetPvzPvzIdReceptionsParams{Status: &status}
+		receptionPage := &generated.ReceptionPage{
+			Receptions: []generated.ReceptionWithProducts{{
+				Reception: generated.Reception{Id: &receptionId, PvzId: pvzId, Status: generated.Close},
+				Products:  []generated.Product{},
+			}},
+			Page:       1,
+			Limit:      10,
+			Total:      1,
+			TotalPages: 1,
+		}
+		mockReceptionService.On("GetPvzReceptions", mock.Anything, pvzId, params).Return(receptionPage, nil).Once()
+
+		router.GET("/pvz/:pvzId/receptions", func(c *gin.Context) {
+			handler.GetPvzPvzIdReceptions(c, pvzId, params)
+		})
+
+		req, _ := http.NewRequest("GET", "/pvz/"+pvzId.String()+"/receptions?status=close", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response generated.ReceptionPage
+		json.Unmarshal(w.Body.Bytes(), &response)
+		require.Len(t, response.Receptions, 1)
+		assert.Equal(t, &receptionId, response.Receptions[0].Reception.Id)
+		assert.Equal(t, 1, response.Total)
+		mockReceptionService.AssertExpectations(t)
+	})
+
+	t.Run("Get pvz receptions with invalid status", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		pvzId := uuid.New()
+		status := "done"
+		params := generated.GetPvzPvzIdReceptionsParams{Status: &status}
+		mockReceptionService.On("GetPvzReceptions", mock.Anything, pvzId, params).Return(nil, custom_errors.ErrReceptionStatus).Once()
+
+		router.GET("/pvz/:pvzId/receptions", func(c *gin.Context) {
+			handler.GetPvzPvzIdReceptions(c, pvzId, params)
+		})
+
+		req, _ := http.NewRequest("GET", "/pvz/"+pvzId.String()+"/receptions?status=done", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPostReceptionsReceptionIdReopen(t *testing.T) {
+	t.Run("Reopen reception", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		receptionId := uuid.New()
+		pvzId := uuid.New()
+
+		mockReceptionService.On("ReopenReception", mock.Anything, receptionId, "miscounted", pgtype.UUID{}).
+			Return(&generated.Reception{Id: &receptionId, PvzId: pvzId, Status: generated.InProgress}, nil).Once()
+
+		router.POST("/receptions/:receptionId/reopen", func(c *gin.Context) {
+			handler.PostReceptionsReceptionIdReopen(c, receptionId)
+		})
+
+		jsonData, _ := json.Marshal(generated.PostReceptionsReceptionIdReopenJSONRequestBody{Reason: "miscounted"})
+		req, _ := http.NewRequest("POST", "/receptions/"+receptionId.String()+"/reopen", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response generated.Reception
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, &receptionId, response.Id)
+		assert.Equal(t, generated.InProgress, response.Status)
+		mockReceptionService.AssertExpectations(t)
+	})
+
+	t.Run("Reopen reception with newer reception", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		receptionId := uuid.New()
+
+		mockReceptionService.On("ReopenReception", mock.Anything, receptionId, "miscounted", pgtype.UUID{}).
+			Return(nil, custom_errors.ErrNewerReception).Once()
+
+		router.POST("/receptions/:receptionId/reopen", func(c *gin.Context) {
+			handler.PostReceptionsReceptionIdReopen(c, receptionId)
+		})
+
+		jsonData, _ := json.Marshal(generated.PostReceptionsReceptionIdReopenJSONRequestBody{Reason: "miscounted"})
+		req, _ := http.NewRequest("POST", "/receptions/"+receptionId.String()+"/reopen", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, custom_errors.ErrNewerReception.Message)
+	})
+
+	t.Run("Reopen reception with invalid body", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		receptionId := uuid.New()
+
+		router.POST("/receptions/:receptionId/reopen", func(c *gin.Context) {
+			handler.PostReceptionsReceptionIdReopen(c, receptionId)
+		})
+
+		req, _ := http.NewRequest("POST", "/receptions/"+receptionId.String()+"/reopen", bytes.NewBufferString("{"))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockReceptionService.AssertNotCalled(t, "ReopenReception")
+	})
+}
+
+func TestPostReceptionsReceptionIdStatus(t *testing.T) {
+	t.Run("Pause reception", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		receptionId := uuid.New()
+		pvzId := uuid.New()
+		user := &user_model.User{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Role: user_model.Employee}
+		statusReq := generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: generated.Paused}
+
+		mockReceptionService.On("ChangeReceptionStatus", mock.Anything, receptionId, statusReq, user.Id, user_model.Employee).
+			Return(&generated.Reception{Id: &receptionId, PvzId: pvzId, Status: generated.Paused}, nil).Once()
+
+		router.POST("/receptions/:receptionId/status", func(c *gin.Context) {
+			c.Set(middlewares.AuthUserKey, user)
+			handler.PostReceptionsReceptionIdStatus(c, receptionId)
+		})
+
+		jsonData, _ := json.Marshal(statusReq)
+		req, _ := http.NewRequest("POST", "/receptions/"+receptionId.String()+"/status", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response generated.Reception
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, &receptionId, response.Id)
+		assert.Equal(t, generated.Paused, response.Status)
+		mockReceptionService.AssertExpectations(t)
+	})
+
+	t.Run("Change reception status with invalid transition", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		receptionId := uuid.New()
+		statusReq := generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: generated.Verified}
+
+		mockReceptionService.On("ChangeReceptionStatus", mock.Anything, receptionId, statusReq, pgtype.UUID{}, user_model.UserRole("")).
+			Return(nil, custom_errors.ErrReceptionFinalized).Once()
+
+		router.POST("/receptions/:receptionId/status", func(c *gin.Context) {
+			handler.PostReceptionsReceptionIdStatus(c, receptionId)
+		})
+
+		jsonData, _ := json.Marshal(statusReq)
+		req, _ := http.NewRequest("POST", "/receptions/"+receptionId.String()+"/status", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var response generated.Error
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Contains(t, response.Message, custom_errors.ErrReceptionFinalized.Message)
+	})
+
+	t.Run("Change reception status with error", func(t *testing.T) {
+		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+		receptionId := uuid.New()
+		statusReq := generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: generated.Cancelled}
+
+		mockReceptionService.On("ChangeReceptionStatus", mock.Anything, receptionId, statusReq, pgtype.UUID{}, user_model.UserRole("")).
+			Return(nil, custom_errors.ErrUpdateReceptionStatus).Once()
+
+		router.POST("/receptions/:receptionId/status", func(c *gin.Context) {
+			handler.PostReceptionsReceptionIdStatus(c, receptionId)
+		})
+
+		jsonData, _ := json.Marshal(statusReq)
+		req, _ := http.NewRequest("POST", "/receptions/"+receptionId.String()+"/status", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGetReceptionsReceptionIdCorrections(t *testing.T) {
+	router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+	handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+	receptionId := uuid.New()
+	corrections := []generated.ReceptionCorrection{{Id: uuid.New(), ReceptionId: receptionId, Reason: "miscounted"}}
+
+	mockReceptionService.On("GetReceptionCorrections", mock.Anything, receptionId).Return(corrections, nil).Once()
+
+	router.GET("/receptions/:receptionId/corrections", func(c *gin.Context) {
+		handler.GetReceptionsReceptionIdCorrections(c, receptionId)
+	})
+
+	req, _ := http.NewRequest("GET", "/receptions/"+receptionId.String()+"/corrections", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response []generated.ReceptionCorrection
+	json.Unmarshal(w.Body.Bytes(), &response)
+	require.Len(t, response, 1)
+	assert.Equal(t, "miscounted", response[0].Reason)
+	mockReceptionService.AssertExpectations(t)
+}
+
 func TestPostReceptions(t *testing.T) {
 	t.Run("Create reception", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzId := uuid.New()
 		receptionReq := generated.PostReceptionsJSONRequestBody{
@@ -941,7 +2255,7 @@ func TestPostReceptions(t *testing.T) {
 
 	t.Run("Create receptions with user error", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzId := uuid.New()
 		receptionReq := generated.PostReceptionsJSONRequestBody{
@@ -967,7 +2281,7 @@ func TestPostReceptions(t *testing.T) {
 
 	t.Run("Create receptions with internal error", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		pvzId := uuid.New()
 		receptionReq := generated.PostReceptionsJSONRequestBody{
@@ -995,7 +2309,7 @@ func TestPostReceptions(t *testing.T) {
 func TestPostRegister(t *testing.T) {
 	t.Run("Register", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		registerReq := generated.PostRegisterJSONRequestBody{
 			Email:    "test@example.com",
@@ -1010,7 +2324,7 @@ func TestPostRegister(t *testing.T) {
 				Id:    &userId,
 				Email: "test@example.com",
 				Role:  generated.UserRoleEmployee,
-			}, "valid_token", nil).Once()
+			}, &user_model.Tokens{AccessToken: "valid_token", RefreshToken: "valid_refresh_token"}, nil).Once()
 
 		req, _ := http.NewRequest("POST", "/register", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -1042,7 +2356,7 @@ func TestPostRegister(t *testing.T) {
 
 	t.Run("Register with invalid role", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		registerReq := generated.PostRegisterJSONRequestBody{
 			Email:    "test@example.com",
@@ -1066,7 +2380,7 @@ func TestPostRegister(t *testing.T) {
 
 	t.Run("register with internal error", func(t *testing.T) {
 		router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
-		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService)
+		handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
 
 		registerReq := generated.PostRegisterJSONRequestBody{
 			Email:    "test@example.com",
@@ -1076,7 +2390,7 @@ func TestPostRegister(t *testing.T) {
 		jsonData, _ := json.Marshal(registerReq)
 
 		internalErr := errors.New("internal error")
-		mockUserService.On("Register", mock.Anything, openapi_types.Email("test@example.com"), "secure_password", generated.UserRoleEmployee).Return(nil, "", internalErr).Once()
+		mockUserService.On("Register", mock.Anything, openapi_types.Email("test@example.com"), "secure_password", generated.UserRoleEmployee).Return(nil, nil, internalErr).Once()
 
 		req, _ := http.NewRequest("POST", "/register", bytes.NewBuffer(jsonData))
 		req.Header.Set("Content-Type", "application/json")
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/Dmitrii-Dmitrii/pvz/api"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_type_model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type MockProductTypeService struct {
+	mock.Mock
+}
+
+func (m *MockProductTypeService) CreateProductType(ctx context.Context, productTypeDto generated.ProductType) (*generated.ProductType, error) {
+	args := m.Called(ctx, productTypeDto)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.ProductType), args.Error(1)
+}
+
+func (m *MockProductTypeService) GetProductTypes(ctx context.Context) ([]generated.ProductType, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]generated.ProductType), args.Error(1)
+}
+
+func (m *MockProductTypeService) GetProductType(ctx context.Context, code string) (*product_type_model.ProductType, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*product_type_model.ProductType), args.Error(1)
+}
+
+func (m *MockProductTypeService) UpdateProductType(ctx context.Context, code string, productTypeReq generated.PutProductTypesCodeJSONRequestBody) (*generated.ProductType, error) {
+	args := m.Called(ctx, code, productTypeReq)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.ProductType), args.Error(1)
+}
+
+func (m *MockProductTypeService) DeleteProductType(ctx context.Context, code string) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+func setupProductTypeTestEnv() (*gin.Engine, *MockProductTypeService, *api.HttpHandler) {
+	router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+	mockProductTypeService := new(MockProductTypeService)
+	handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, mockProductTypeService)
+	return router, mockProductTypeService, handler
+}
+
+func TestPostProductTypes(t *testing.T) {
+	maxPerReception := 10
+	productTypeReq := generated.PostProductTypesJSONRequestBody{
+		Code:            "книги",
+		DisplayNameRu:   "Книги",
+		DisplayNameEn:   "Books",
+		MaxPerReception: &maxPerReception,
+	}
+
+	t.Run("Create product type", func(t *testing.T) {
+		router, mockProductTypeService, handler := setupProductTypeTestEnv()
+
+		mockProductTypeService.On("CreateProductType", mock.Anything, productTypeReq).Return(&productTypeReq, nil).Once()
+
+		jsonData, _ := json.Marshal(productTypeReq)
+		req, _ := http.NewRequest("POST", "/product_types", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.POST("/product_types", handler.PostProductTypes)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockProductTypeService.AssertExpectations(t)
+
+		var response generated.ProductType
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, productTypeReq.Code, response.Code)
+		assert.Equal(t, &maxPerReception, response.MaxPerReception)
+	})
+
+	t.Run("Create existing product type", func(t *testing.T) {
+		router, mockProductTypeService, handler := setupProductTypeTestEnv()
+
+		mockProductTypeService.On("CreateProductType", mock.Anything, productTypeReq).Return(nil, custom_errors.ErrProductTypeExists).Once()
+
+		jsonData, _ := json.Marshal(productTypeReq)
+		req, _ := http.NewRequest("POST", "/product_types", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.POST("/product_types", handler.PostProductTypes)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockProductTypeService.AssertExpectations(t)
+	})
+}
+
+func TestDeleteProductTypesCode(t *testing.T) {
+	router, mockProductTypeService, handler := setupProductTypeTestEnv()
+
+	mockProductTypeService.On("DeleteProductType", mock.Anything, "обувь").Return(custom_errors.ErrProductTypeInUse).Once()
+
+	req, _ := http.NewRequest("DELETE", "/product_types/обувь", nil)
+	w := httptest.NewRecorder()
+
+	router.DELETE("/product_types/:code", func(c *gin.Context) {
+		handler.DeleteProductTypesCode(c, c.Param("code"))
+	})
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockProductTypeService.AssertExpectations(t)
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/Dmitrii-Dmitrii/pvz/api"
+	"github.com/Dmitrii-Dmitrii/pvz/internal"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/middlewares"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRouteFilter(t *testing.T) {
+	router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+	handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, nil, nil, nil)
+
+	generated.RegisterHandlers(middlewares.NewRouteFilter(router.Group("/"), "/dummyLogin"), handler)
+
+	t.Run("Disabled route", func(t *testing.T) {
+		attempts := testutil.ToFloat64(internal.DisabledRouteRequestsTotal.WithLabelValues(http.MethodPost, "/dummyLogin"))
+
+		jsonData, _ := json.Marshal(generated.PostDummyLoginJSONRequestBody{Role: "moderator"})
+		req, _ := http.NewRequest("POST", "/dummyLogin", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, attempts+1, testutil.ToFloat64(internal.DisabledRouteRequestsTotal.WithLabelValues(http.MethodPost, "/dummyLogin")))
+		mockUserService.AssertNotCalled(t, "DummyLogin", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Enabled route", func(t *testing.T) {
+		mockUserService.On("Login", mock.Anything, mock.Anything, "password123").
+			Return(&user_model.Tokens{AccessToken: "valid_token", RefreshToken: "valid_refresh_token"}, nil).Once()
+
+		jsonData, _ := json.Marshal(generated.PostLoginJSONRequestBody{Email: "test@example.com", Password: "password123"})
+		req, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUserService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/api"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type MockWebhookService struct {
+	mock.Mock
+}
+
+func (m *MockWebhookService) CreateSubscription(ctx context.Context, subscriptionReq generated.PostWebhooksJSONRequestBody) (*generated.WebhookSubscription, error) {
+	args := m.Called(ctx, subscriptionReq)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookService) GetSubscriptions(ctx context.Context) ([]generated.WebhookSubscription, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]generated.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookService) DeleteSubscription(ctx context.Context, idDto openapi_types.UUID) error {
+	args := m.Called(ctx, idDto)
+	return args.Error(0)
+}
+
+func (m *MockWebhookService) GetDeliveries(ctx context.Context, subscriptionIdDto openapi_types.UUID, params generated.GetWebhooksWebhookIdDeliveriesParams) ([]generated.WebhookDelivery, error) {
+	args := m.Called(ctx, subscriptionIdDto, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]generated.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookService) ReplayDelivery(ctx context.Context, idDto openapi_types.UUID) (*generated.WebhookDelivery, error) {
+	args := m.Called(ctx, idDto)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookService) DeliverPendingWebhooks(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockWebhookService) Run(ctx context.Context, interval time.Duration) {
+	m.Called(ctx, interval)
+}
+
+func setupWebhookTestEnv() (*gin.Engine, *MockWebhookService, *api.HttpHandler) {
+	router, mockUserService, mockPvzService, mockProductService, mockReceptionService := setupTestEnv()
+	mockWebhookService := new(MockWebhookService)
+	handler := api.NewHttpHandler(mockPvzService, mockReceptionService, mockProductService, mockUserService, mockWebhookService, nil, nil)
+	return router, mockWebhookService, handler
+}
+
+func TestPostWebhooks(t *testing.T) {
+	webhookReq := generated.PostWebhooksJSONRequestBody{
+		Url:        "https://partner.example.com/hooks",
+		Secret:     "secret",
+		EventTypes: []generated.WebhookEventType{generated.ReceptionClosed},
+	}
+
+	t.Run("Create webhook", func(t *testing.T) {
+		router, mockWebhookService, handler := setupWebhookTestEnv()
+		id := uuid.New()
+
+		mockWebhookService.On("CreateSubscription", mock.Anything, webhookReq).Return(&generated.WebhookSubscription{
+			Id:         &id,
+			Url:        webhookReq.Url,
+			EventTypes: webhookReq.EventTypes,
+		}, nil).Once()
+
+		jsonData, _ := json.Marshal(webhookReq)
+		req, _ := http.NewRequest("POST", "/webhooks", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.POST("/webhooks", handler.PostWebhooks)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockWebhookService.AssertExpectations(t)
+
+		var response generated.WebhookSubscription
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, &id, response.Id)
+		assert.NotContains(t, w.Body.String(), "secret")
+	})
+
+	t.Run("Create webhook with user error", func(t *testing.T) {
+		router, mockWebhookService, handler := setupWebhookTestEnv()
+
+		mockWebhookService.On("CreateSubscription", mock.Anything, webhookReq).Return(nil, custom_errors.ErrWebhookUrl).Once()
+
+		jsonData, _ := json.Marshal(webhookReq)
+		req, _ := http.NewRequest("POST", "/webhooks", bytes.NewBuffer(jsonData))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.POST("/webhooks", handler.PostWebhooks)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockWebhookService.AssertExpectations(t)
+	})
+}
+
+func TestGetWebhooks(t *testing.T) {
+	router, mockWebhookService, handler := setupWebhookTestEnv()
+
+	mockWebhookService.On("GetSubscriptions", mock.Anything).Return([]generated.WebhookSubscription{
+		{Url: "https://partner.example.com/hooks", EventTypes: []generated.WebhookEventType{generated.ProductAdded}},
+	}, nil).Once()
+
+	req, _ := http.NewRequest("GET", "/webhooks", nil)
+	w := httptest.NewRecorder()
+
+	router.GET("/webhooks", handler.GetWebhooks)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []generated.WebhookSubscription
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Len(t, response, 1)
+	mockWebhookService.AssertExpectations(t)
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	t.Run("Delete webhook", func(t *testing.T) {
+		router, mockWebhookService, handler := setupWebhookTestEnv()
+		id := uuid.New()
+
+		mockWebhookService.On("DeleteSubscription", mock.Anything, id).Return(nil).Once()
+
+		req, _ := http.NewRequest("DELETE", "/webhooks/"+id.String(), nil)
+		w := httptest.NewRecorder()
+
+		router.DELETE("/webhooks/:webhookId", func(c *gin.Context) {
+			handler.DeleteWebhooksWebhookId(c, id)
+		})
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockWebhookService.AssertExpectations(t)
+	})
+
+	t.Run("Delete missing webhook", func(t *testing.T) {
+		router, mockWebhookService, handler := setupWebhookTestEnv()
+		id := uuid.New()
+
+		mockWebhookService.On("DeleteSubscription", mock.Anything, id).Return(custom_errors.ErrWebhookNotFound).Once()
+
+		req, _ := http.NewRequest("DELETE", "/webhooks/"+id.String(), nil)
+		w := httptest.NewRecorder()
+
+		router.DELETE("/webhooks/:webhookId", func(c *gin.Context) {
+			handler.DeleteWebhooksWebhookId(c, id)
+		})
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockWebhookService.AssertExpectations(t)
+	})
+}
+
+func TestGetWebhookDeliveries(t *testing.T) {
+	router, mockWebhookService, handler := setupWebhookTestEnv()
+	id := uuid.New()
+	status := generated.GetWebhooksWebhookIdDeliveriesParamsStatusDead
+	params := generated.GetWebhooksWebhookIdDeliveriesParams{Status: &status}
+
+	mockWebhookService.On("GetDeliveries", mock.Anything, id, params).
+		Return(nil, errors.New("db error")).Once()
+
+	req, _ := http.NewRequest("GET", "/webhooks/"+id.String()+"/deliveries?status=dead", nil)
+	w := httptest.NewRecorder()
+
+	router.GET("/webhooks/:webhookId/deliveries", func(c *gin.Context) {
+		handler.GetWebhooksWebhookIdDeliveries(c, id, params)
+	})
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	mockWebhookService.AssertExpectations(t)
+}
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	router, mockWebhookService, handler := setupWebhookTestEnv()
+	id := uuid.New()
+
+	mockWebhookService.On("ReplayDelivery", mock.Anything, id).Return(&generated.WebhookDelivery{
+		Id:     id,
+		Status: generated.WebhookDeliveryStatusPending,
+	}, nil).Once()
+
+	req, _ := http.NewRequest("POST", "/webhook_deliveries/"+id.String()+"/replay", nil)
+	w := httptest.NewRecorder()
+
+	router.POST("/webhook_deliveries/:deliveryId/replay", func(c *gin.Context) {
+		handler.PostWebhookDeliveriesDeliveryIdReplay(c, id)
+	})
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response generated.WebhookDelivery
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, generated.WebhookDeliveryStatusPending, response.Status)
+	mockWebhookService.AssertExpectations(t)
+}
@@ -4,11 +4,16 @@ import (
 	"bytes"
 	"encoding/json"
 	"github.com/Dmitrii-Dmitrii/pvz/api"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/city_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/product_driver"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/product_type_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/pvz_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/drivers/reception_driver"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/city_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/event_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_type_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/pvz_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/reception_service"
 	"github.com/Dmitrii-Dmitrii/pvz/test/drivers"
@@ -26,18 +31,23 @@ func TestIntegration(t *testing.T) {
 	pvzDriver := pvz_driver.NewPvzDriver(pool)
 	receptionDriver := reception_driver.NewReceptionDriver(pool)
 	productDriver := product_driver.NewProductDriver(pool)
+	cityDriver := city_driver.NewCityDriver(pool)
+	productTypeDriver := product_type_driver.NewProductTypeDriver(pool)
 
-	pvzService := pvz_service.NewPvzService(pvzDriver)
-	receptionService := reception_service.NewReceptionService(receptionDriver)
-	productService := product_service.NewProductService(productDriver, receptionService)
+	cityService := city_service.NewCityService(cityDriver)
+	productTypeService := product_type_service.NewProductTypeService(productTypeDriver)
+	pvzService := pvz_service.NewPvzService(pvzDriver, cityService, productTypeService)
+	eventService := event_service.NewEventService(nil)
+	receptionService := reception_service.NewReceptionService(receptionDriver, eventService, pvzService)
+	productService := product_service.NewProductService(productDriver, receptionService, eventService, productTypeService)
 
-	handler := api.NewHttpHandler(pvzService, receptionService, productService, nil)
+	handler := api.NewHttpHandler(pvzService, receptionService, productService, nil, nil, cityService, productTypeService)
 
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
 
 	pvzReq := generated.PostPvzJSONRequestBody{
-		City: generated.СанктПетербург,
+		City: "Санкт-Петербург",
 	}
 
 	jsonData, _ := json.Marshal(pvzReq)
@@ -55,7 +65,7 @@ func TestIntegration(t *testing.T) {
 
 	var pvzResp generated.PVZ
 	json.Unmarshal(w.Body.Bytes(), &pvzResp)
-	assert.Equal(t, generated.СанктПетербург, pvzResp.City)
+	assert.Equal(t, "Санкт-Петербург", pvzResp.City)
 
 	pvzId := *pvzResp.Id
 
@@ -84,11 +94,11 @@ func TestIntegration(t *testing.T) {
 		handler.PostProducts(c)
 	})
 	for i := 0; i < 50; i++ {
-		productType := generated.PostProductsJSONBodyTypeОбувь
+		productType := "обувь"
 		if i%3 == 1 {
-			productType = generated.PostProductsJSONBodyTypeОдежда
+			productType = "одежда"
 		} else if i%3 == 2 {
-			productType = generated.PostProductsJSONBodyTypeЭлектроника
+			productType = "электроника"
 		}
 
 		productReq := generated.PostProductsJSONRequestBody{
@@ -109,7 +119,7 @@ func TestIntegration(t *testing.T) {
 		var productResp generated.Product
 		json.Unmarshal(w.Body.Bytes(), &productResp)
 		assert.Equal(t, *receptionResp.Id, productResp.ReceptionId)
-		assert.Equal(t, generated.ProductType(productType), productResp.Type)
+		assert.Equal(t, productType, productResp.Type)
 	}
 
 	router.POST("/pvz/"+pvzId.String()+"/close-last-reception", func(c *gin.Context) {
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/city_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/city_service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type MockCityDriver struct {
+	mock.Mock
+}
+
+func (m *MockCityDriver) CreateCity(ctx context.Context, city *city_model.City) error {
+	args := m.Called(ctx, city)
+	return args.Error(0)
+}
+
+func (m *MockCityDriver) GetCities(ctx context.Context) ([]city_model.City, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]city_model.City), args.Error(1)
+}
+
+func (m *MockCityDriver) GetCityByName(ctx context.Context, name string) (*city_model.City, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*city_model.City), args.Error(1)
+}
+
+func (m *MockCityDriver) UpdateCity(ctx context.Context, city *city_model.City) error {
+	args := m.Called(ctx, city)
+	return args.Error(0)
+}
+
+func (m *MockCityDriver) DeleteCity(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func newCityService() *city_service.CityService {
+	mockCityDriver := new(MockCityDriver)
+	for _, name := range []string{"Москва", "Санкт-Петербург", "Казань"} {
+		mockCityDriver.On("GetCityByName", mock.Anything, name).Return(&city_model.City{Name: name}, nil)
+	}
+	mockCityDriver.On("GetCityByName", mock.Anything, mock.Anything).Return(nil, custom_errors.ErrCityNotFound)
+	return city_service.NewCityService(mockCityDriver)
+}
+
+func TestCreateCity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Create city", func(t *testing.T) {
+		mockDriver := new(MockCityDriver)
+		service := city_service.NewCityService(mockDriver)
+
+		mockDriver.On("CreateCity", ctx, mock.MatchedBy(func(city *city_model.City) bool {
+			return city.Name == "Новосибирск" && city.Region == "Новосибирская область" &&
+				city.Timezone == "Asia/Novosibirsk" && !city.CreatedAt.IsZero()
+		})).Return(nil)
+
+		result, err := service.CreateCity(ctx, generated.City{Name: " Новосибирск ", Region: "Новосибирская область", Timezone: "Asia/Novosibirsk"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Новосибирск", result.Name)
+		assert.NotNil(t, result.CreatedAt)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Create city with invalid params", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			city     generated.City
+			expected error
+		}{
+			{"empty name", generated.City{Name: " ", Region: "Москва", Timezone: "Europe/Moscow"}, custom_errors.ErrCityName},
+			{"empty region", generated.City{Name: "Москва", Timezone: "Europe/Moscow"}, custom_errors.ErrCityRegion},
+			{"empty timezone", generated.City{Name: "Москва", Region: "Москва"}, custom_errors.ErrCityTimezone},
+			{"unknown timezone", generated.City{Name: "Москва", Region: "Москва", Timezone: "Europe/Nowhere"}, custom_errors.ErrCityTimezone},
+			{"zero auto close hours", generated.City{Name: "Москва", Region: "Москва", Timezone: "Europe/Moscow", ReceptionAutoCloseHours: new(int)}, custom_errors.ErrCityAutoCloseHours},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				mockDriver := new(MockCityDriver)
+				service := city_service.NewCityService(mockDriver)
+
+				result, err := service.CreateCity(ctx, tc.city)
+
+				assert.Equal(t, tc.expected, err)
+				assert.Nil(t, result)
+				mockDriver.AssertNotCalled(t, "CreateCity")
+			})
+		}
+	})
+}
+
+func TestUpdateCity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Update missing city", func(t *testing.T) {
+		mockDriver := new(MockCityDriver)
+		service := city_service.NewCityService(mockDriver)
+
+		mockDriver.On("UpdateCity", ctx, &city_model.City{Name: "Владивосток", Region: "Приморский край", Timezone: "Asia/Vladivostok"}).
+			Return(custom_errors.ErrCityNotFound)
+
+		result, err := service.UpdateCity(ctx, "Владивосток", generated.PutCitiesNameJSONRequestBody{Region: "Приморский край", Timezone: "Asia/Vladivostok"})
+
+		assert.Equal(t, custom_errors.ErrCityNotFound, err)
+		assert.Nil(t, result)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Update city auto close hours", func(t *testing.T) {
+		mockDriver := new(MockCityDriver)
+		service := city_service.NewCityService(mockDriver)
+
+		autoCloseHours := 12
+		mockDriver.On("UpdateCity", ctx, &city_model.City{Name: "Казань", Region: "Республика Татарстан", Timezone: "Europe/Moscow", ReceptionAutoCloseHours: &autoCloseHours}).
+			Return(nil)
+
+		result, err := service.UpdateCity(ctx, "Казань", generated.PutCitiesNameJSONRequestBody{
+			Region: "Республика Татарстан", Timezone: "Europe/Moscow", ReceptionAutoCloseHours: &autoCloseHours,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &autoCloseHours, result.ReceptionAutoCloseHours)
+		mockDriver.AssertExpectations(t)
+	})
+}
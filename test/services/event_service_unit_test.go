@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/event_service"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type MockEventService struct {
+	mock.Mock
+}
+
+func (m *MockEventService) Publish(ctx context.Context, event *event_model.Event) {
+	m.Called(ctx, event)
+}
+
+func (m *MockEventService) Subscribe() (<-chan event_model.Event, func()) {
+	args := m.Called()
+	return args.Get(0).(<-chan event_model.Event), args.Get(1).(func())
+}
+
+func newMockEventService() *MockEventService {
+	mockEventService := new(MockEventService)
+	mockEventService.On("Publish", mock.Anything, mock.AnythingOfType("*event_model.Event")).Return()
+	return mockEventService
+}
+
+type MockEventDriver struct {
+	mock.Mock
+}
+
+func (m *MockEventDriver) Notify(ctx context.Context, event *event_model.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockEventDriver) Listen(ctx context.Context, handle func(event event_model.Event)) error {
+	args := m.Called(ctx, handle)
+	return args.Error(0)
+}
+
+func TestEventServicePublish(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Publish to local subscribers", func(t *testing.T) {
+		service := event_service.NewEventService(nil)
+
+		first, unsubscribeFirst := service.Subscribe()
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := service.Subscribe()
+		defer unsubscribeSecond()
+
+		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		service.Publish(ctx, &event_model.Event{Type: event_model.ReceptionOpened, PvzId: pvzId})
+
+		for _, ch := range []<-chan event_model.Event{first, second} {
+			select {
+			case event := <-ch:
+				assert.Equal(t, event_model.ReceptionOpened, event.Type)
+				assert.Equal(t, pvzId, event.PvzId)
+				assert.True(t, event.Id.Valid)
+				assert.False(t, event.OccurredAt.IsZero())
+			case <-time.After(time.Second):
+				t.Fatal("event was not delivered")
+			}
+		}
+	})
+
+	t.Run("Unsubscribed channel is closed", func(t *testing.T) {
+		service := event_service.NewEventService(nil)
+
+		events, unsubscribe := service.Subscribe()
+		unsubscribe()
+
+		service.Publish(ctx, &event_model.Event{Type: event_model.ProductAdded})
+
+		_, ok := <-events
+		assert.False(t, ok)
+	})
+
+	t.Run("Publish through driver", func(t *testing.T) {
+		mockDriver := new(MockEventDriver)
+		service := event_service.NewEventService(mockDriver)
+
+		events, unsubscribe := service.Subscribe()
+		defer unsubscribe()
+
+		mockDriver.On("Notify", ctx, mock.AnythingOfType("*event_model.Event")).Return(nil)
+
+		service.Publish(ctx, &event_model.Event{Type: event_model.ReceptionClosed})
+
+		assert.Empty(t, events)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Listen dispatches driver events", func(t *testing.T) {
+		mockDriver := new(MockEventDriver)
+		service := event_service.NewEventService(mockDriver)
+
+		events, unsubscribe := service.Subscribe()
+		defer unsubscribe()
+
+		listenCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		mockDriver.On("Listen", listenCtx, mock.Anything).
+			Run(func(args mock.Arguments) {
+				handle := args.Get(1).(func(event event_model.Event))
+				handle(event_model.Event{Type: event_model.ProductDeleted})
+				cancel()
+			}).
+			Return(nil)
+
+		service.Listen(listenCtx)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, event_model.ProductDeleted, (<-events).Type)
+		mockDriver.AssertNumberOfCalls(t, "Listen", 1)
+	})
+
+	t.Run("Listen reconnects after driver failure", func(t *testing.T) {
+		driver := &fakeEventDriver{failures: 1}
+		service := event_service.NewEventService(driver)
+
+		events, unsubscribe := service.Subscribe()
+		defer unsubscribe()
+
+		listenCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			service.Listen(listenCtx)
+			close(done)
+		}()
+
+		select {
+		case event := <-events:
+			assert.Equal(t, event_model.ReceptionOpened, event.Type)
+		case <-time.After(5 * time.Second):
+			t.Fatal("event was not delivered after reconnect")
+		}
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("listener did not stop after context cancellation")
+		}
+		assert.Equal(t, int32(2), driver.calls.Load())
+	})
+}
+
+type fakeEventDriver struct {
+	failures int32
+	calls    atomic.Int32
+}
+
+func (d *fakeEventDriver) Notify(ctx context.Context, event *event_model.Event) error {
+	return nil
+}
+
+func (d *fakeEventDriver) Listen(ctx context.Context, handle func(event event_model.Event)) error {
+	if d.calls.Add(1) <= d.failures {
+		return custom_errors.ErrListenEvents
+	}
+
+	handle(event_model.Event{Type: event_model.ReceptionOpened})
+	<-ctx.Done()
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/outbox_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/outbox_service"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+type MockOutboxDriver struct {
+	mock.Mock
+}
+
+func (m *MockOutboxDriver) ClaimPendingEvents(ctx context.Context, limit int, now, leaseUntil time.Time) ([]outbox_model.OutboxEvent, error) {
+	args := m.Called(ctx, limit, now, leaseUntil)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]outbox_model.OutboxEvent), args.Error(1)
+}
+
+func (m *MockOutboxDriver) MarkPublished(ctx context.Context, id pgtype.UUID, publishedAt time.Time) error {
+	args := m.Called(ctx, id, publishedAt)
+	return args.Error(0)
+}
+
+func (m *MockOutboxDriver) MarkFailed(ctx context.Context, event *outbox_model.OutboxEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+type MockSinkDriver struct {
+	mock.Mock
+}
+
+func (m *MockSinkDriver) Send(ctx context.Context, event *outbox_model.OutboxEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func TestRelayPendingEvents(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Relay events", func(t *testing.T) {
+		mockDriver := new(MockOutboxDriver)
+		mockSink := new(MockSinkDriver)
+		service := outbox_service.NewOutboxService(mockDriver, mockSink)
+
+		first := outbox_model.OutboxEvent{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Attempts: 1}
+		second := outbox_model.OutboxEvent{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Attempts: 1}
+
+		mockDriver.On("ClaimPendingEvents", ctx, mock.Anything, mock.Anything, mock.Anything).
+			Return([]outbox_model.OutboxEvent{first, second}, nil)
+		mockSink.On("Send", ctx, mock.AnythingOfType("*outbox_model.OutboxEvent")).Return(nil).Twice()
+		mockDriver.On("MarkPublished", ctx, first.Id, mock.Anything).Return(nil)
+		mockDriver.On("MarkPublished", ctx, second.Id, mock.Anything).Return(nil)
+
+		err := service.RelayPendingEvents(ctx)
+
+		assert.NoError(t, err)
+		mockDriver.AssertExpectations(t)
+		mockSink.AssertExpectations(t)
+		mockDriver.AssertNotCalled(t, "MarkFailed", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Relay events with sink error", func(t *testing.T) {
+		mockDriver := new(MockOutboxDriver)
+		mockSink := new(MockSinkDriver)
+		service := outbox_service.NewOutboxService(mockDriver, mockSink)
+
+		event := outbox_model.OutboxEvent{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Status: outbox_model.Pending, Attempts: 3}
+
+		mockDriver.On("ClaimPendingEvents", ctx, mock.Anything, mock.Anything, mock.Anything).
+			Return([]outbox_model.OutboxEvent{event}, nil)
+		mockSink.On("Send", ctx, mock.AnythingOfType("*outbox_model.OutboxEvent")).
+			Return(custom_errors.ErrUnexpectedStatusCode)
+		mockDriver.On("MarkFailed", ctx, mock.MatchedBy(func(e *outbox_model.OutboxEvent) bool {
+			delay := time.Until(e.NextAttemptAt)
+			return e.Status == outbox_model.Pending &&
+				e.LastError == custom_errors.ErrUnexpectedStatusCode.Message &&
+				delay > 3*time.Second && delay <= 4*time.Second
+		})).Return(nil)
+
+		err := service.RelayPendingEvents(ctx)
+
+		assert.NoError(t, err)
+		mockDriver.AssertExpectations(t)
+		mockDriver.AssertNotCalled(t, "MarkPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Relay events exceeding max attempts", func(t *testing.T) {
+		mockDriver := new(MockOutboxDriver)
+		mockSink := new(MockSinkDriver)
+		service := outbox_service.NewOutboxService(mockDriver, mockSink)
+
+		event := outbox_model.OutboxEvent{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Status: outbox_model.Pending, Attempts: 10}
+
+		mockDriver.On("ClaimPendingEvents", ctx, mock.Anything, mock.Anything, mock.Anything).
+			Return([]outbox_model.OutboxEvent{event}, nil)
+		mockSink.On("Send", ctx, mock.AnythingOfType("*outbox_model.OutboxEvent")).
+			Return(errors.New("sink error"))
+		mockDriver.On("MarkFailed", ctx, mock.MatchedBy(func(e *outbox_model.OutboxEvent) bool {
+			return e.Status == outbox_model.Failed
+		})).Return(nil)
+
+		err := service.RelayPendingEvents(ctx)
+
+		assert.NoError(t, err)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Relay events with claim error", func(t *testing.T) {
+		mockDriver := new(MockOutboxDriver)
+		mockSink := new(MockSinkDriver)
+		service := outbox_service.NewOutboxService(mockDriver, mockSink)
+
+		mockDriver.On("ClaimPendingEvents", ctx, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, custom_errors.ErrClaimOutboxEvents)
+
+		err := service.RelayPendingEvents(ctx)
+
+		assert.Equal(t, custom_errors.ErrClaimOutboxEvents, err)
+		mockSink.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+}
@@ -4,13 +4,19 @@ import (
 	"context"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_type_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_type_service"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -18,23 +24,98 @@ type MockProductDriver struct {
 	mock.Mock
 }
 
-func (m *MockProductDriver) CreateProduct(ctx context.Context, product *product_model.Product, pvzId pgtype.UUID) (*pgtype.UUID, error) {
-	args := m.Called(ctx, product, pvzId)
+func (m *MockProductDriver) CreateProduct(ctx context.Context, product *product_model.Product, pvzId pgtype.UUID, maxPerReception *int) (*pgtype.UUID, error) {
+	args := m.Called(ctx, product, pvzId, maxPerReception)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*pgtype.UUID), args.Error(1)
 }
 
-func (m *MockProductDriver) DeleteLastProduct(ctx context.Context, pvzId pgtype.UUID) error {
-	args := m.Called(ctx, pvzId)
-	return args.Error(0)
+func (m *MockProductDriver) DeleteLastProduct(ctx context.Context, pvzId pgtype.UUID, deletedBy pgtype.UUID) (*product_model.Product, error) {
+	args := m.Called(ctx, pvzId, deletedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*product_model.Product), args.Error(1)
+}
+
+func (m *MockProductDriver) DeleteProduct(ctx context.Context, pvzId pgtype.UUID, productId pgtype.UUID, deletedBy pgtype.UUID) (*product_model.Product, error) {
+	args := m.Called(ctx, pvzId, productId, deletedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*product_model.Product), args.Error(1)
+}
+
+func (m *MockProductDriver) RestoreProduct(ctx context.Context, pvzId pgtype.UUID, productId pgtype.UUID) (*product_model.Product, error) {
+	args := m.Called(ctx, pvzId, productId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*product_model.Product), args.Error(1)
+}
+
+func (m *MockProductDriver) CreateProducts(ctx context.Context, products []*product_model.Product, pvzId pgtype.UUID, maxPerReception map[product_model.ProductType]int) (*pgtype.UUID, []error, error) {
+	args := m.Called(ctx, products, pvzId, maxPerReception)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*pgtype.UUID), args.Get(1).([]error), args.Error(2)
+}
+
+func (m *MockProductDriver) GetProductByBarcode(ctx context.Context, barcode string) (*pvz_model.ProductLocation, error) {
+	args := m.Called(ctx, barcode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pvz_model.ProductLocation), args.Error(1)
 }
 
 type MockReceptionService struct {
 	mock.Mock
 }
 
+func (m *MockReceptionService) GetReception(ctx context.Context, receptionId uuid.UUID) (*generated.ReceptionWithProducts, error) {
+	args := m.Called(ctx, receptionId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.ReceptionWithProducts), args.Error(1)
+}
+
+func (m *MockReceptionService) GetPvzReceptions(ctx context.Context, pvzId uuid.UUID, params generated.GetPvzPvzIdReceptionsParams) (*generated.ReceptionPage, error) {
+	args := m.Called(ctx, pvzId, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.ReceptionPage), args.Error(1)
+}
+
+func (m *MockReceptionService) ReopenReception(ctx context.Context, receptionId uuid.UUID, reason string, reopenedBy pgtype.UUID) (*generated.Reception, error) {
+	args := m.Called(ctx, receptionId, reason, reopenedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.Reception), args.Error(1)
+}
+
+func (m *MockReceptionService) ChangeReceptionStatus(ctx context.Context, receptionId uuid.UUID, statusReq generated.PostReceptionsReceptionIdStatusJSONRequestBody, userId pgtype.UUID, role user_model.UserRole) (*generated.Reception, error) {
+	args := m.Called(ctx, receptionId, statusReq, userId, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*generated.Reception), args.Error(1)
+}
+
+func (m *MockReceptionService) GetReceptionCorrections(ctx context.Context, receptionId uuid.UUID) ([]generated.ReceptionCorrection, error) {
+	args := m.Called(ctx, receptionId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]generated.ReceptionCorrection), args.Error(1)
+}
+
 func (m *MockReceptionService) GetLastReceptionStatus(ctx context.Context, pvzId pgtype.UUID) (*reception_model.ReceptionStatus, error) {
 	args := m.Called(ctx, pvzId)
 	if args.Get(0) == nil {
@@ -65,40 +146,44 @@ func TestCreateProduct(t *testing.T) {
 	t.Run("Create product in reception in progress", func(t *testing.T) {
 		mockDriver := new(MockProductDriver)
 		mockReceptionService := new(MockReceptionService)
-		service := product_service.NewProductService(mockDriver, mockReceptionService)
+		mockEventService := newMockEventService()
+		service := product_service.NewProductService(mockDriver, mockReceptionService, mockEventService, newProductTypeService())
 
 		pvzIdDto := uuid.New()
-		productTypeJson := generated.PostProductsJSONBodyType("электроника")
+		productTypeJson := "электроника"
 		status := reception_model.InProgress
 		receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
 
 		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
-		mockDriver.On("CreateProduct", ctx, mock.AnythingOfType("*product_model.Product"), mock.AnythingOfType("pgtype.UUID")).Return(&receptionId, nil)
+		mockDriver.On("CreateProduct", ctx, mock.AnythingOfType("*product_model.Product"), mock.AnythingOfType("pgtype.UUID"), (*int)(nil)).Return(&receptionId, nil)
 
-		result, err := service.CreateProduct(ctx, pvzIdDto, productTypeJson)
+		result, err := service.CreateProduct(ctx, generated.PostProductsJSONRequestBody{PvzId: pvzIdDto, Type: productTypeJson})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
-		assert.Equal(t, generated.ProductTypeЭлектроника, result.Type)
+		assert.Equal(t, "электроника", result.Type)
 		assert.NotNil(t, result.Id)
 		assert.NotNil(t, result.DateTime)
 		assert.NotEmpty(t, result.ReceptionId)
 		mockDriver.AssertExpectations(t)
 		mockReceptionService.AssertExpectations(t)
+		mockEventService.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event *event_model.Event) bool {
+			return event.Type == event_model.ProductAdded && event.ReceptionId == receptionId
+		}))
 	})
 
 	t.Run("Create product without open reception", func(t *testing.T) {
 		mockDriver := new(MockProductDriver)
 		mockReceptionService := new(MockReceptionService)
-		service := product_service.NewProductService(mockDriver, mockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
 
 		pvzIdDto := uuid.New()
-		productTypeJson := generated.PostProductsJSONBodyType("электроника")
+		productTypeJson := "электроника"
 		status := reception_model.Close
 
 		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
 
-		result, err := service.CreateProduct(ctx, pvzIdDto, productTypeJson)
+		result, err := service.CreateProduct(ctx, generated.PostProductsJSONRequestBody{PvzId: pvzIdDto, Type: productTypeJson})
 
 		assert.Equal(t, custom_errors.ErrNoOpenReception, err)
 		assert.Nil(t, result)
@@ -106,15 +191,30 @@ func TestCreateProduct(t *testing.T) {
 		mockDriver.AssertNotCalled(t, "CreateProduct")
 	})
 
+	t.Run("Create product in paused reception", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
+
+		status := reception_model.Paused
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+
+		result, err := service.CreateProduct(ctx, generated.PostProductsJSONRequestBody{PvzId: uuid.New(), Type: "электроника"})
+
+		assert.Equal(t, custom_errors.ErrReceptionPaused, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "CreateProduct")
+	})
+
 	t.Run("Create product with invalid product type", func(t *testing.T) {
 		mockDriver := new(MockProductDriver)
 		mockReceptionService := new(MockReceptionService)
-		service := product_service.NewProductService(mockDriver, mockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
 
 		pvzIdDto := uuid.New()
-		productTypeJson := generated.PostProductsJSONBodyType("неизвестный_тип")
+		productTypeJson := "неизвестный_тип"
 
-		result, err := service.CreateProduct(ctx, pvzIdDto, productTypeJson)
+		result, err := service.CreateProduct(ctx, generated.PostProductsJSONRequestBody{PvzId: pvzIdDto, Type: productTypeJson})
 
 		assert.Equal(t, custom_errors.ErrProductType, err)
 		assert.Nil(t, result)
@@ -125,14 +225,14 @@ func TestCreateProduct(t *testing.T) {
 	t.Run("Create product with reception service error", func(t *testing.T) {
 		mockDriver := new(MockProductDriver)
 		mockReceptionService := new(MockReceptionService)
-		service := product_service.NewProductService(mockDriver, mockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
 
 		pvzIdDto := uuid.New()
-		productTypeJson := generated.PostProductsJSONBodyType("электроника")
+		productTypeJson := "электроника"
 
 		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(nil, assert.AnError)
 
-		result, err := service.CreateProduct(ctx, pvzIdDto, productTypeJson)
+		result, err := service.CreateProduct(ctx, generated.PostProductsJSONRequestBody{PvzId: pvzIdDto, Type: productTypeJson})
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -143,22 +243,293 @@ func TestCreateProduct(t *testing.T) {
 	t.Run("Create product with error", func(t *testing.T) {
 		mockDriver := new(MockProductDriver)
 		mockReceptionService := new(MockReceptionService)
-		service := product_service.NewProductService(mockDriver, mockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
 
 		pvzIdDto := uuid.New()
-		productTypeJson := generated.PostProductsJSONBodyType("электроника")
+		productTypeJson := "электроника"
 		status := reception_model.InProgress
 
 		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
-		mockDriver.On("CreateProduct", ctx, mock.AnythingOfType("*product_model.Product"), mock.AnythingOfType("pgtype.UUID")).Return(nil, assert.AnError)
+		mockDriver.On("CreateProduct", ctx, mock.AnythingOfType("*product_model.Product"), mock.AnythingOfType("pgtype.UUID"), (*int)(nil)).Return(nil, assert.AnError)
 
-		result, err := service.CreateProduct(ctx, pvzIdDto, productTypeJson)
+		result, err := service.CreateProduct(ctx, generated.PostProductsJSONRequestBody{PvzId: pvzIdDto, Type: productTypeJson})
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
 		mockDriver.AssertExpectations(t)
 		mockReceptionService.AssertExpectations(t)
 	})
+
+	t.Run("Create product over product type limit", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		mockProductTypeDriver := new(MockProductTypeDriver)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(),
+			product_type_service.NewProductTypeService(mockProductTypeDriver))
+
+		maxPerReception := 2
+		status := reception_model.InProgress
+
+		mockProductTypeDriver.On("GetProductTypeByCode", ctx, "мебель").
+			Return(&product_type_model.ProductType{Code: "мебель", Oversize: true, MaxPerReception: &maxPerReception}, nil)
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+		mockDriver.On("CreateProduct", ctx, mock.MatchedBy(func(product *product_model.Product) bool {
+			return product.ProductType == "мебель"
+		}), mock.AnythingOfType("pgtype.UUID"), &maxPerReception).Return(nil, custom_errors.ErrProductTypeLimit)
+
+		result, err := service.CreateProduct(ctx, generated.PostProductsJSONRequestBody{PvzId: uuid.New(), Type: "мебель"})
+
+		assert.Equal(t, custom_errors.ErrProductTypeLimit, err)
+		assert.Nil(t, result)
+		mockDriver.AssertExpectations(t)
+		mockProductTypeDriver.AssertExpectations(t)
+	})
+
+	t.Run("Create product in full pvz", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
+
+		status := reception_model.InProgress
+
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+		mockDriver.On("CreateProduct", ctx, mock.AnythingOfType("*product_model.Product"), mock.AnythingOfType("pgtype.UUID"),
+			(*int)(nil)).Return(nil, custom_errors.ErrPvzFull)
+
+		result, err := service.CreateProduct(ctx, generated.PostProductsJSONRequestBody{PvzId: uuid.New(), Type: "обувь"})
+
+		assert.Equal(t, custom_errors.ErrPvzFull, err)
+		assert.Nil(t, result)
+		mockDriver.AssertExpectations(t)
+	})
+}
+
+func TestCreateProductWithDetails(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Create product with barcode and dimensions", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
+
+		barcode := " 4600000000011 "
+		weight := 1200
+		status := reception_model.InProgress
+		receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+		mockDriver.On("CreateProduct", ctx, mock.MatchedBy(func(product *product_model.Product) bool {
+			return product.Barcode != nil && *product.Barcode == "4600000000011" &&
+				product.WeightGrams != nil && *product.WeightGrams == weight &&
+				product.Dimensions != nil && product.Dimensions.HeightMm == 30
+		}), mock.AnythingOfType("pgtype.UUID"), (*int)(nil)).Return(&receptionId, nil)
+
+		result, err := service.CreateProduct(ctx, generated.PostProductsJSONRequestBody{
+			PvzId:       uuid.New(),
+			Type:        "электроника",
+			Barcode:     &barcode,
+			WeightGrams: &weight,
+			Dimensions:  &generated.ProductDimensions{LengthMm: 100, WidthMm: 50, HeightMm: 30},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "4600000000011", *result.Barcode)
+		assert.Equal(t, 30, result.Dimensions.HeightMm)
+		mockDriver.AssertExpectations(t)
+	})
+
+	blank := "  "
+	zero := 0
+	testCases := []struct {
+		name        string
+		productReq  generated.PostProductsJSONRequestBody
+		expectedErr error
+	}{
+		{
+			name:        "Blank barcode",
+			productReq:  generated.PostProductsJSONRequestBody{PvzId: uuid.New(), Type: "электроника", Barcode: &blank},
+			expectedErr: custom_errors.ErrProductBarcode,
+		},
+		{
+			name:        "Zero weight",
+			productReq:  generated.PostProductsJSONRequestBody{PvzId: uuid.New(), Type: "электроника", WeightGrams: &zero},
+			expectedErr: custom_errors.ErrProductWeight,
+		},
+		{
+			name: "Zero dimension",
+			productReq: generated.PostProductsJSONRequestBody{PvzId: uuid.New(), Type: "электроника",
+				Dimensions: &generated.ProductDimensions{LengthMm: 100, WidthMm: 0, HeightMm: 30}},
+			expectedErr: custom_errors.ErrProductDimensions,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDriver := new(MockProductDriver)
+			mockReceptionService := new(MockReceptionService)
+			service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
+
+			result, err := service.CreateProduct(ctx, tc.productReq)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Nil(t, result)
+			mockReceptionService.AssertNotCalled(t, "GetLastReceptionStatus")
+			mockDriver.AssertNotCalled(t, "CreateProduct")
+		})
+	}
+}
+
+func TestCreateProducts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Create products with partial failure", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		mockEventService := newMockEventService()
+		mockProductTypeDriver := new(MockProductTypeDriver)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, mockEventService,
+			product_type_service.NewProductTypeService(mockProductTypeDriver))
+
+		status := reception_model.InProgress
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+		maxPerReception := 1
+		zero := 0
+		barcode := "4600000000011"
+		receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		mockProductTypeDriver.On("GetProductTypeByCode", ctx, "мебель").
+			Return(&product_type_model.ProductType{Code: "мебель", MaxPerReception: &maxPerReception}, nil).Once()
+		mockProductTypeDriver.On("GetProductTypeByCode", ctx, "обувь").
+			Return(&product_type_model.ProductType{Code: "обувь"}, nil).Once()
+		mockProductTypeDriver.On("GetProductTypeByCode", ctx, "неизвестный_тип").
+			Return(nil, custom_errors.ErrProductTypeNotFound).Once()
+		mockDriver.On("CreateProducts", ctx, mock.MatchedBy(func(products []*product_model.Product) bool {
+			return len(products) == 3 && products[0].ProductType == "мебель" &&
+				products[1].ProductType == "мебель" && *products[2].Barcode == barcode
+		}), mock.AnythingOfType("pgtype.UUID"), map[product_model.ProductType]int{"мебель": maxPerReception}).
+			Return(&receptionId, []error{nil, custom_errors.ErrProductTypeLimit, nil}, nil)
+
+		result, err := service.CreateProducts(ctx, generated.PostProductsBatchJSONRequestBody{
+			PvzId: uuid.New(),
+			Items: []generated.ProductBatchItem{
+				{Type: "мебель"},
+				{Type: "неизвестный_тип"},
+				{Type: "мебель"},
+				{Type: "обувь", WeightGrams: &zero},
+				{Type: "обувь", Barcode: &barcode},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Created)
+		assert.Equal(t, 3, result.Failed)
+		assert.Equal(t, uuid.UUID(receptionId.Bytes), result.ReceptionId)
+		require.Len(t, result.Items, 5)
+		assert.NotNil(t, result.Items[0].Product)
+		assert.Equal(t, custom_errors.ErrProductType.Message, *result.Items[1].Error)
+		assert.Equal(t, custom_errors.ErrProductTypeLimit.Message, *result.Items[2].Error)
+		assert.Equal(t, custom_errors.ErrProductWeight.Message, *result.Items[3].Error)
+		assert.Equal(t, barcode, *result.Items[4].Product.Barcode)
+		assert.Equal(t, 4, result.Items[4].Index)
+		mockDriver.AssertExpectations(t)
+		mockProductTypeDriver.AssertExpectations(t)
+		mockEventService.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("Create products with empty batch", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		service := product_service.NewProductService(mockDriver, new(MockReceptionService), newMockEventService(), newProductTypeService())
+
+		result, err := service.CreateProducts(ctx, generated.PostProductsBatchJSONRequestBody{PvzId: uuid.New()})
+
+		assert.Equal(t, custom_errors.ErrBatchSize, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "CreateProducts")
+	})
+
+	t.Run("Create products without open reception", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
+
+		status := reception_model.InProgress
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+		mockDriver.On("CreateProducts", ctx, mock.Anything, mock.AnythingOfType("pgtype.UUID"), mock.Anything).
+			Return(nil, nil, custom_errors.ErrNoOpenReception)
+
+		result, err := service.CreateProducts(ctx, generated.PostProductsBatchJSONRequestBody{
+			PvzId: uuid.New(),
+			Items: []generated.ProductBatchItem{{Type: "обувь"}},
+		})
+
+		assert.Equal(t, custom_errors.ErrNoOpenReception, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Create products in paused reception", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
+
+		status := reception_model.Paused
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+
+		result, err := service.CreateProducts(ctx, generated.PostProductsBatchJSONRequestBody{
+			PvzId: uuid.New(),
+			Items: []generated.ProductBatchItem{{Type: "обувь"}},
+		})
+
+		assert.Equal(t, custom_errors.ErrReceptionPaused, err)
+		assert.Nil(t, result)
+		mockReceptionService.AssertExpectations(t)
+		mockDriver.AssertNotCalled(t, "CreateProducts")
+	})
+}
+
+func TestGetProductByBarcode(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get product by barcode", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		service := product_service.NewProductService(mockDriver, new(MockReceptionService), newMockEventService(), newProductTypeService())
+
+		barcode := "4600000000011"
+		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		location := &pvz_model.ProductLocation{
+			Product: product_model.Product{
+				Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				ProductType: "электроника",
+				ReceptionId: receptionId,
+				Barcode:     &barcode,
+			},
+			Reception: reception_model.Reception{Id: receptionId, PvzId: pvzId, Status: reception_model.InProgress},
+			Pvz:       pvz_model.Pvz{Id: pvzId, City: "Москва"},
+		}
+
+		mockDriver.On("GetProductByBarcode", ctx, barcode).Return(location, nil)
+
+		result, err := service.GetProductByBarcode(ctx, barcode)
+
+		assert.NoError(t, err)
+		assert.Equal(t, barcode, *result.Product.Barcode)
+		assert.Equal(t, generated.InProgress, result.Reception.Status)
+		assert.Equal(t, uuid.UUID(pvzId.Bytes), *result.Pvz.Id)
+		assert.Equal(t, "Москва", result.Pvz.City)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Get product by unknown barcode", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		service := product_service.NewProductService(mockDriver, new(MockReceptionService), newMockEventService(), newProductTypeService())
+
+		mockDriver.On("GetProductByBarcode", ctx, "unknown").Return(nil, custom_errors.ErrProductNotFound)
+
+		result, err := service.GetProductByBarcode(ctx, "unknown")
+
+		assert.Equal(t, custom_errors.ErrProductNotFound, err)
+		assert.Nil(t, result)
+	})
 }
 
 func TestDeleteLastProduct(t *testing.T) {
@@ -167,32 +538,41 @@ func TestDeleteLastProduct(t *testing.T) {
 	t.Run("Delete last product in reception in progress", func(t *testing.T) {
 		mockDriver := new(MockProductDriver)
 		mockReceptionService := new(MockReceptionService)
-		service := product_service.NewProductService(mockDriver, mockReceptionService)
+		mockEventService := newMockEventService()
+		service := product_service.NewProductService(mockDriver, mockReceptionService, mockEventService, newProductTypeService())
 
 		pvzIdDto := uuid.New()
 		status := reception_model.InProgress
+		deletedProduct := &product_model.Product{
+			Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			ProductType: product_model.Shoes,
+			ReceptionId: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		}
 
 		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
-		mockDriver.On("DeleteLastProduct", ctx, mock.AnythingOfType("pgtype.UUID")).Return(nil)
+		mockDriver.On("DeleteLastProduct", ctx, mock.AnythingOfType("pgtype.UUID"), pgtype.UUID{}).Return(deletedProduct, nil)
 
-		err := service.DeleteLastProduct(ctx, pvzIdDto)
+		err := service.DeleteLastProduct(ctx, pvzIdDto, pgtype.UUID{})
 
 		assert.NoError(t, err)
 		mockDriver.AssertExpectations(t)
 		mockReceptionService.AssertExpectations(t)
+		mockEventService.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event *event_model.Event) bool {
+			return event.Type == event_model.ProductDeleted && event.ProductId == deletedProduct.Id
+		}))
 	})
 
 	t.Run("Delete last product without open reception", func(t *testing.T) {
 		mockDriver := new(MockProductDriver)
 		mockReceptionService := new(MockReceptionService)
-		service := product_service.NewProductService(mockDriver, mockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
 
 		pvzIdDto := uuid.New()
 		status := reception_model.Close
 
 		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
 
-		err := service.DeleteLastProduct(ctx, pvzIdDto)
+		err := service.DeleteLastProduct(ctx, pvzIdDto, pgtype.UUID{})
 
 		assert.Equal(t, custom_errors.ErrNoOpenReception, err)
 		mockReceptionService.AssertExpectations(t)
@@ -202,13 +582,13 @@ func TestDeleteLastProduct(t *testing.T) {
 	t.Run("Delete last product with reception service error", func(t *testing.T) {
 		mockDriver := new(MockProductDriver)
 		mockReceptionService := new(MockReceptionService)
-		service := product_service.NewProductService(mockDriver, mockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
 
 		pvzIdDto := uuid.New()
 
 		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(nil, assert.AnError)
 
-		err := service.DeleteLastProduct(ctx, pvzIdDto)
+		err := service.DeleteLastProduct(ctx, pvzIdDto, pgtype.UUID{})
 
 		assert.Error(t, err)
 		mockReceptionService.AssertExpectations(t)
@@ -218,18 +598,110 @@ func TestDeleteLastProduct(t *testing.T) {
 	t.Run("Delete last product with error", func(t *testing.T) {
 		mockDriver := new(MockProductDriver)
 		mockReceptionService := new(MockReceptionService)
-		service := product_service.NewProductService(mockDriver, mockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
 
 		pvzIdDto := uuid.New()
 		status := reception_model.InProgress
 
 		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
-		mockDriver.On("DeleteLastProduct", ctx, mock.AnythingOfType("pgtype.UUID")).Return(assert.AnError)
+		mockDriver.On("DeleteLastProduct", ctx, mock.AnythingOfType("pgtype.UUID"), pgtype.UUID{}).Return(nil, assert.AnError)
 
-		err := service.DeleteLastProduct(ctx, pvzIdDto)
+		err := service.DeleteLastProduct(ctx, pvzIdDto, pgtype.UUID{})
 
 		assert.Error(t, err)
 		mockDriver.AssertExpectations(t)
 		mockReceptionService.AssertExpectations(t)
 	})
 }
+
+func TestDeleteProduct(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Delete product by id", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		mockEventService := newMockEventService()
+		service := product_service.NewProductService(mockDriver, mockReceptionService, mockEventService, newProductTypeService())
+
+		productIdDto := uuid.New()
+		userId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		status := reception_model.InProgress
+		deletedProduct := &product_model.Product{
+			Id:          pgtype.UUID{Bytes: productIdDto, Valid: true},
+			ProductType: product_model.Shoes,
+			ReceptionId: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		}
+
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+		mockDriver.On("DeleteProduct", ctx, mock.AnythingOfType("pgtype.UUID"), deletedProduct.Id, userId).Return(deletedProduct, nil)
+
+		err := service.DeleteProduct(ctx, uuid.New(), productIdDto, userId)
+
+		assert.NoError(t, err)
+		mockDriver.AssertExpectations(t)
+		mockEventService.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event *event_model.Event) bool {
+			return event.Type == event_model.ProductDeleted && event.ProductId == deletedProduct.Id
+		}))
+	})
+
+	t.Run("Delete product without open reception", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
+
+		status := reception_model.Close
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+
+		err := service.DeleteProduct(ctx, uuid.New(), uuid.New(), pgtype.UUID{})
+
+		assert.Equal(t, custom_errors.ErrNoOpenReception, err)
+		mockDriver.AssertNotCalled(t, "DeleteProduct")
+	})
+}
+
+func TestRestoreProduct(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Restore deleted product", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		mockEventService := newMockEventService()
+		service := product_service.NewProductService(mockDriver, mockReceptionService, mockEventService, newProductTypeService())
+
+		productIdDto := uuid.New()
+		status := reception_model.InProgress
+		restoredProduct := &product_model.Product{
+			Id:          pgtype.UUID{Bytes: productIdDto, Valid: true},
+			ProductType: product_model.Shoes,
+			ReceptionId: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		}
+
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+		mockDriver.On("RestoreProduct", ctx, mock.AnythingOfType("pgtype.UUID"), restoredProduct.Id).Return(restoredProduct, nil)
+
+		result, err := service.RestoreProduct(ctx, uuid.New(), productIdDto)
+
+		require.NoError(t, err)
+		assert.Equal(t, productIdDto, *result.Id)
+		assert.Equal(t, string(product_model.Shoes), result.Type)
+		mockEventService.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event *event_model.Event) bool {
+			return event.Type == event_model.ProductAdded && event.ProductId == restoredProduct.Id
+		}))
+	})
+
+	t.Run("Restore product that is not deleted", func(t *testing.T) {
+		mockDriver := new(MockProductDriver)
+		mockReceptionService := new(MockReceptionService)
+		service := product_service.NewProductService(mockDriver, mockReceptionService, newMockEventService(), newProductTypeService())
+
+		status := reception_model.InProgress
+		mockReceptionService.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+		mockDriver.On("RestoreProduct", ctx, mock.AnythingOfType("pgtype.UUID"), mock.AnythingOfType("pgtype.UUID")).
+			Return(nil, custom_errors.ErrDeletedProduct)
+
+		result, err := service.RestoreProduct(ctx, uuid.New(), uuid.New())
+
+		assert.Equal(t, custom_errors.ErrDeletedProduct, err)
+		assert.Nil(t, result)
+	})
+}
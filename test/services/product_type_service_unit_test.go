@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_type_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/product_type_service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type MockProductTypeDriver struct {
+	mock.Mock
+}
+
+func (m *MockProductTypeDriver) CreateProductType(ctx context.Context, productType *product_type_model.ProductType) error {
+	args := m.Called(ctx, productType)
+	return args.Error(0)
+}
+
+func (m *MockProductTypeDriver) GetProductTypes(ctx context.Context) ([]product_type_model.ProductType, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]product_type_model.ProductType), args.Error(1)
+}
+
+func (m *MockProductTypeDriver) GetProductTypeByCode(ctx context.Context, code string) (*product_type_model.ProductType, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*product_type_model.ProductType), args.Error(1)
+}
+
+func (m *MockProductTypeDriver) UpdateProductType(ctx context.Context, productType *product_type_model.ProductType) error {
+	args := m.Called(ctx, productType)
+	return args.Error(0)
+}
+
+func (m *MockProductTypeDriver) DeleteProductType(ctx context.Context, code string) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+func newProductTypeService() *product_type_service.ProductTypeService {
+	mockProductTypeDriver := new(MockProductTypeDriver)
+	for _, code := range []string{"электроника", "одежда", "обувь"} {
+		mockProductTypeDriver.On("GetProductTypeByCode", mock.Anything, code).Return(&product_type_model.ProductType{Code: code}, nil)
+	}
+	mockProductTypeDriver.On("GetProductTypeByCode", mock.Anything, mock.Anything).Return(nil, custom_errors.ErrProductTypeNotFound)
+	return product_type_service.NewProductTypeService(mockProductTypeDriver)
+}
+
+func TestCreateProductType(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Create product type", func(t *testing.T) {
+		mockDriver := new(MockProductTypeDriver)
+		service := product_type_service.NewProductTypeService(mockDriver)
+
+		oversize := true
+		maxPerReception := 5
+		mockDriver.On("CreateProductType", ctx, mock.MatchedBy(func(productType *product_type_model.ProductType) bool {
+			return productType.Code == "мебель" && productType.Oversize && !productType.Fragile &&
+				*productType.MaxPerReception == maxPerReception && !productType.CreatedAt.IsZero()
+		})).Return(nil)
+
+		result, err := service.CreateProductType(ctx, generated.ProductType{
+			Code:            "мебель",
+			DisplayNameRu:   "Мебель",
+			DisplayNameEn:   "Furniture",
+			Oversize:        &oversize,
+			MaxPerReception: &maxPerReception,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "мебель", result.Code)
+		assert.False(t, *result.Fragile)
+		assert.True(t, *result.Oversize)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Create product type with invalid params", func(t *testing.T) {
+		zero := 0
+		testCases := []struct {
+			name        string
+			productType generated.ProductType
+			expected    error
+		}{
+			{"empty code", generated.ProductType{Code: " ", DisplayNameRu: "Книги", DisplayNameEn: "Books"}, custom_errors.ErrProductTypeCode},
+			{"empty display name", generated.ProductType{Code: "книги", DisplayNameRu: "Книги"}, custom_errors.ErrProductTypeName},
+			{"zero limit", generated.ProductType{Code: "книги", DisplayNameRu: "Книги", DisplayNameEn: "Books", MaxPerReception: &zero}, custom_errors.ErrProductTypeMaxValue},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				mockDriver := new(MockProductTypeDriver)
+				service := product_type_service.NewProductTypeService(mockDriver)
+
+				result, err := service.CreateProductType(ctx, tc.productType)
+
+				assert.Equal(t, tc.expected, err)
+				assert.Nil(t, result)
+				mockDriver.AssertNotCalled(t, "CreateProductType")
+			})
+		}
+	})
+}
+
+func TestUpdateProductType(t *testing.T) {
+	ctx := context.Background()
+	mockDriver := new(MockProductTypeDriver)
+	service := product_type_service.NewProductTypeService(mockDriver)
+
+	mockDriver.On("UpdateProductType", ctx, &product_type_model.ProductType{Code: "книги", DisplayNameRu: "Книги", DisplayNameEn: "Books"}).
+		Return(custom_errors.ErrProductTypeNotFound)
+
+	result, err := service.UpdateProductType(ctx, "книги", generated.PutProductTypesCodeJSONRequestBody{DisplayNameRu: "Книги", DisplayNameEn: "Books"})
+
+	assert.Equal(t, custom_errors.ErrProductTypeNotFound, err)
+	assert.Nil(t, result)
+	mockDriver.AssertExpectations(t)
+}
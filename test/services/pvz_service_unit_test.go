@@ -5,12 +5,16 @@ import (
 	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/pvz_service"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"math"
 	"testing"
 	"time"
 )
@@ -32,12 +36,69 @@ func (m *MockPvzDriver) CreatePvz(ctx context.Context, pvz *pvz_model.Pvz) error
 	return args.Error(0)
 }
 
-func (m *MockPvzDriver) GetPvzFullInfo(ctx context.Context, limit uint32, offset uint32, startDate *time.Time, endDate *time.Time) ([]map[string]interface{}, error) {
-	args := m.Called(ctx, limit, offset, startDate, endDate)
+func (m *MockPvzDriver) UpdatePvz(ctx context.Context, pvz *pvz_model.Pvz) error {
+	args := m.Called(ctx, pvz)
+	return args.Error(0)
+}
+
+func (m *MockPvzDriver) UpdatePvzStatus(ctx context.Context, id pgtype.UUID, from, to pvz_model.PvzStatus) error {
+	args := m.Called(ctx, id, from, to)
+	return args.Error(0)
+}
+
+func (m *MockPvzDriver) ArchivePvz(ctx context.Context, id pgtype.UUID, archivedAt time.Time) error {
+	args := m.Called(ctx, id, archivedAt)
+	return args.Error(0)
+}
+
+func (m *MockPvzDriver) GetPvzFullInfo(ctx context.Context, filter pvz_model.PvzFilter) ([]pvz_model.PvzWithReceptions, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]pvz_model.PvzWithReceptions), args.Error(1)
+}
+
+func (m *MockPvzDriver) GetPvzPage(ctx context.Context, limit uint32, after *pvz_model.PvzCursor) ([]pvz_model.Pvz, error) {
+	args := m.Called(ctx, limit, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]pvz_model.Pvz), args.Error(1)
+}
+
+func (m *MockPvzDriver) GetNearbyPvz(ctx context.Context, latitude, longitude, radius float64, limit uint32) ([]pvz_model.NearbyPvz, error) {
+	args := m.Called(ctx, latitude, longitude, radius, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]pvz_model.NearbyPvz), args.Error(1)
+}
+
+func (m *MockPvzDriver) UpdatePvzCapacity(ctx context.Context, id pgtype.UUID, storageHours int, typeCapacities map[product_model.ProductType]int) error {
+	args := m.Called(ctx, id, storageHours, typeCapacities)
+	return args.Error(0)
+}
+
+func (m *MockPvzDriver) GetPvzOccupancy(ctx context.Context, id pgtype.UUID, now time.Time) (*pvz_model.PvzOccupancy, error) {
+	args := m.Called(ctx, id, now)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]map[string]interface{}), args.Error(1)
+	return args.Get(0).(*pvz_model.PvzOccupancy), args.Error(1)
+}
+
+func (m *MockPvzDriver) GetAllPvzOccupancy(ctx context.Context, now time.Time) ([]pvz_model.PvzOccupancy, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]pvz_model.PvzOccupancy), args.Error(1)
+}
+
+func (m *MockPvzDriver) CountPvz(ctx context.Context, filter pvz_model.PvzFilter) (int, error) {
+	args := m.Called(ctx, filter)
+	return args.Int(0), args.Error(1)
 }
 
 func (m *MockPvzDriver) GetAllPvz(ctx context.Context) ([]pvz_model.Pvz, error) {
@@ -48,13 +109,19 @@ func (m *MockPvzDriver) GetAllPvz(ctx context.Context) ([]pvz_model.Pvz, error)
 	return args.Get(0).([]pvz_model.Pvz), args.Error(1)
 }
 
+func newPvzService(status pvz_model.PvzStatus) *pvz_service.PvzService {
+	mockPvzDriver := new(MockPvzDriver)
+	mockPvzDriver.On("GetPvzById", mock.Anything, mock.Anything).Return(&pvz_model.Pvz{Status: status}, nil)
+	return pvz_service.NewPvzService(mockPvzDriver, newCityService(), newProductTypeService())
+}
+
 func TestCreatePvz(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("Create pvz with new id", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
-		city := generated.Москва
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+		city := "Москва"
 		pvzDto := generated.PVZ{
 			City: city,
 		}
@@ -72,9 +139,9 @@ func TestCreatePvz(t *testing.T) {
 
 	t.Run("Create pvz with provided id", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 		id := uuid.New()
-		city := generated.СанктПетербург
+		city := "Санкт-Петербург"
 		pvzDto := generated.PVZ{
 			Id:   &id,
 			City: city,
@@ -94,9 +161,9 @@ func TestCreatePvz(t *testing.T) {
 
 	t.Run("Create already exists pvz", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 		id := uuid.New()
-		city := generated.Казань
+		city := "Казань"
 		pvzDto := generated.PVZ{
 			Id:   &id,
 			City: city,
@@ -119,10 +186,10 @@ func TestCreatePvz(t *testing.T) {
 
 	t.Run("Create pvz with invalid city", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
 		id := uuid.New()
-		invalidCity := generated.PVZCity("Неизвестный_город")
+		invalidCity := "Неизвестный_город"
 		pvzDto := generated.PVZ{
 			Id:   &id,
 			City: invalidCity,
@@ -141,9 +208,9 @@ func TestCreatePvz(t *testing.T) {
 
 	t.Run("Create pvz with driver error", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
-		city := generated.Москва
+		city := "Москва"
 		pvzDto := generated.PVZ{
 			City: city,
 		}
@@ -162,31 +229,430 @@ func TestCreatePvz(t *testing.T) {
 	})
 }
 
+func TestCreatePvzWithDetails(t *testing.T) {
+	ctx := context.Background()
+	address := "  ул. Тверская, 1 "
+	workingHours := "09:00-21:00"
+
+	t.Run("Create pvz with details", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+		capacity := 500
+
+		mockDriver.On("GetPvzById", ctx, mock.AnythingOfType("pgtype.UUID")).Return(nil, custom_errors.ErrPvzNotFound)
+		mockDriver.On("CreatePvz", ctx, mock.MatchedBy(func(pvz *pvz_model.Pvz) bool {
+			return *pvz.Address == "ул. Тверская, 1" && *pvz.WorkingHours == workingHours &&
+				*pvz.Capacity == capacity && pvz.Status == pvz_model.Active
+		})).Return(nil)
+
+		result, err := service.CreatePvz(ctx, generated.PVZ{City: "Москва", Address: &address, WorkingHours: &workingHours, Capacity: &capacity})
+
+		require.NoError(t, err)
+		assert.Equal(t, "ул. Тверская, 1", *result.Address)
+		assert.Equal(t, generated.Active, *result.Status)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Create pvz with latitude only", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+		latitude := 55.75
+
+		mockDriver.On("GetPvzById", ctx, mock.AnythingOfType("pgtype.UUID")).Return(nil, custom_errors.ErrPvzNotFound)
+
+		result, err := service.CreatePvz(ctx, generated.PVZ{City: "Москва", Latitude: &latitude})
+
+		assert.Equal(t, custom_errors.ErrPvzLocation, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "CreatePvz")
+	})
+
+	t.Run("Create pvz with invalid capacity", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+		capacity := 0
+
+		mockDriver.On("GetPvzById", ctx, mock.AnythingOfType("pgtype.UUID")).Return(nil, custom_errors.ErrPvzNotFound)
+
+		result, err := service.CreatePvz(ctx, generated.PVZ{City: "Москва", Capacity: &capacity})
+
+		assert.Equal(t, custom_errors.ErrPvzCapacity, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "CreatePvz")
+	})
+}
+
+func TestUpdatePvz(t *testing.T) {
+	ctx := context.Background()
+	pvzIdDto := uuid.New()
+	pvzId := pgtype.UUID{Bytes: pvzIdDto, Valid: true}
+	address := "ул. Тверская, 1"
+	capacity := 300
+
+	t.Run("Update pvz", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		mockDriver.On("GetPvzById", ctx, pvzId).
+			Return(&pvz_model.Pvz{Id: pvzId, City: pvz_model.Moscow, Status: pvz_model.Inactive}, nil)
+		mockDriver.On("UpdatePvz", ctx, mock.MatchedBy(func(pvz *pvz_model.Pvz) bool {
+			return pvz.Id == pvzId && *pvz.Address == address && pvz.WorkingHours == nil && *pvz.Capacity == capacity
+		})).Return(nil)
+
+		result, err := service.UpdatePvz(ctx, pvzIdDto, generated.PutPvzPvzIdJSONRequestBody{Address: &address, Capacity: &capacity})
+
+		require.NoError(t, err)
+		assert.Equal(t, pvzIdDto, *result.Id)
+		assert.Equal(t, string(pvz_model.Moscow), result.City)
+		assert.Equal(t, &address, result.Address)
+		assert.Equal(t, generated.Inactive, *result.Status)
+		mockDriver.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name        string
+		pvz         *pvz_model.Pvz
+		getErr      error
+		capacity    int
+		expectedErr error
+	}{
+		{"Update missing pvz", nil, custom_errors.ErrPvzNotFound, 1, custom_errors.ErrNoPvz},
+		{"Update archived pvz", &pvz_model.Pvz{Id: pvzId, Status: pvz_model.Archived}, nil, 1, custom_errors.ErrPvzArchived},
+		{"Update pvz with invalid capacity", &pvz_model.Pvz{Id: pvzId, Status: pvz_model.Active}, nil, -1, custom_errors.ErrPvzCapacity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDriver := new(MockPvzDriver)
+			service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+			if tt.pvz == nil {
+				mockDriver.On("GetPvzById", ctx, pvzId).Return(nil, tt.getErr)
+			} else {
+				mockDriver.On("GetPvzById", ctx, pvzId).Return(tt.pvz, tt.getErr)
+			}
+
+			result, err := service.UpdatePvz(ctx, pvzIdDto, generated.PutPvzPvzIdJSONRequestBody{Capacity: &tt.capacity})
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Nil(t, result)
+			mockDriver.AssertNotCalled(t, "UpdatePvz")
+		})
+	}
+}
+
+func TestChangePvzStatus(t *testing.T) {
+	ctx := context.Background()
+	pvzIdDto := uuid.New()
+	pvzId := pgtype.UUID{Bytes: pvzIdDto, Valid: true}
+
+	tests := []struct {
+		name        string
+		current     pvz_model.PvzStatus
+		activate    bool
+		expectedErr error
+	}{
+		{"Deactivate active pvz", pvz_model.Active, false, nil},
+		{"Deactivate inactive pvz", pvz_model.Inactive, false, custom_errors.ErrPvzStatus},
+		{"Activate inactive pvz", pvz_model.Inactive, true, nil},
+		{"Activate active pvz", pvz_model.Active, true, custom_errors.ErrPvzStatus},
+		{"Activate archived pvz", pvz_model.Archived, true, custom_errors.ErrPvzArchived},
+		{"Deactivate archived pvz", pvz_model.Archived, false, custom_errors.ErrPvzArchived},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDriver := new(MockPvzDriver)
+			service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+			from, to := pvz_model.Active, pvz_model.Inactive
+			changeStatus := service.DeactivatePvz
+			if tt.activate {
+				from, to = pvz_model.Inactive, pvz_model.Active
+				changeStatus = service.ActivatePvz
+			}
+
+			mockDriver.On("GetPvzById", ctx, pvzId).Return(&pvz_model.Pvz{Id: pvzId, Status: tt.current}, nil)
+			if tt.expectedErr == nil {
+				mockDriver.On("UpdatePvzStatus", ctx, pvzId, from, to).Return(nil)
+			}
+
+			result, err := changeStatus(ctx, pvzIdDto)
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				assert.Nil(t, result)
+				mockDriver.AssertNotCalled(t, "UpdatePvzStatus")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, generated.PVZStatus(to), *result.Status)
+			mockDriver.AssertExpectations(t)
+		})
+	}
+}
+
+func TestArchivePvz(t *testing.T) {
+	ctx := context.Background()
+	pvzIdDto := uuid.New()
+	pvzId := pgtype.UUID{Bytes: pvzIdDto, Valid: true}
+
+	t.Run("Archive pvz", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		mockDriver.On("GetPvzById", ctx, pvzId).Return(&pvz_model.Pvz{Id: pvzId, Status: pvz_model.Active}, nil)
+		mockDriver.On("ArchivePvz", ctx, pvzId, mock.AnythingOfType("time.Time")).Return(nil)
+
+		result, err := service.ArchivePvz(ctx, pvzIdDto)
+
+		require.NoError(t, err)
+		assert.Equal(t, generated.Archived, *result.Status)
+		assert.NotNil(t, result.ArchivedAt)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Archive pvz with open reception", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		mockDriver.On("GetPvzById", ctx, pvzId).Return(&pvz_model.Pvz{Id: pvzId, Status: pvz_model.Active}, nil)
+		mockDriver.On("ArchivePvz", ctx, pvzId, mock.AnythingOfType("time.Time")).Return(custom_errors.ErrPvzOpenReception)
+
+		result, err := service.ArchivePvz(ctx, pvzIdDto)
+
+		assert.Equal(t, custom_errors.ErrPvzOpenReception, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestGetPvzOccupancy(t *testing.T) {
+	ctx := context.Background()
+	pvzIdDto := uuid.New()
+	pvzId := pgtype.UUID{Bytes: pvzIdDto, Valid: true}
+
+	t.Run("Get pvz occupancy", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+		capacity := 100
+		shoesCapacity := 10
+
+		mockDriver.On("GetPvzOccupancy", ctx, pvzId, mock.AnythingOfType("time.Time")).Return(&pvz_model.PvzOccupancy{
+			PvzId:          pvzId,
+			Capacity:       &capacity,
+			Occupied:       7,
+			StorageHours:   72,
+			TypeCapacities: map[product_model.ProductType]int{product_model.Shoes: shoesCapacity},
+			TypeOccupied:   map[product_model.ProductType]int{product_model.Clothes: 7},
+		}, nil)
+
+		result, err := service.GetPvzOccupancy(ctx, pvzIdDto)
+
+		require.NoError(t, err)
+		assert.Equal(t, pvzIdDto, result.PvzId)
+		assert.Equal(t, capacity, *result.Capacity)
+		assert.Equal(t, 7, result.Occupied)
+		assert.Equal(t, 72, result.StorageHours)
+		require.Len(t, result.ProductTypes, 2)
+		assert.Equal(t, generated.ProductTypeOccupancy{Type: "обувь", Capacity: &shoesCapacity}, result.ProductTypes[0])
+		assert.Equal(t, generated.ProductTypeOccupancy{Type: "одежда", Occupied: 7}, result.ProductTypes[1])
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Get missing pvz occupancy", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		mockDriver.On("GetPvzOccupancy", ctx, pvzId, mock.AnythingOfType("time.Time")).Return(nil, custom_errors.ErrPvzNotFound)
+
+		result, err := service.GetPvzOccupancy(ctx, pvzIdDto)
+
+		assert.Equal(t, custom_errors.ErrNoPvz, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestUpdatePvzCapacity(t *testing.T) {
+	ctx := context.Background()
+	pvzIdDto := uuid.New()
+	pvzId := pgtype.UUID{Bytes: pvzIdDto, Valid: true}
+
+	newCapacityReq := func(storageHours int, productTypes ...generated.ProductTypeCapacity) generated.PutPvzPvzIdCapacityJSONRequestBody {
+		return generated.PutPvzPvzIdCapacityJSONRequestBody{StorageHours: storageHours, ProductTypes: productTypes}
+	}
+
+	t.Run("Update pvz capacity", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		mockDriver.On("GetPvzById", ctx, pvzId).Return(&pvz_model.Pvz{Id: pvzId, Status: pvz_model.Active}, nil)
+		mockDriver.On("UpdatePvzCapacity", ctx, pvzId, 48, map[product_model.ProductType]int{product_model.Shoes: 20}).Return(nil)
+		mockDriver.On("GetPvzOccupancy", ctx, pvzId, mock.AnythingOfType("time.Time")).Return(&pvz_model.PvzOccupancy{
+			PvzId:          pvzId,
+			StorageHours:   48,
+			TypeCapacities: map[product_model.ProductType]int{product_model.Shoes: 20},
+			TypeOccupied:   map[product_model.ProductType]int{},
+		}, nil)
+
+		result, err := service.UpdatePvzCapacity(ctx, pvzIdDto, newCapacityReq(48, generated.ProductTypeCapacity{Type: "обувь", Capacity: 20}))
+
+		require.NoError(t, err)
+		assert.Equal(t, 48, result.StorageHours)
+		require.Len(t, result.ProductTypes, 1)
+		assert.Equal(t, 20, *result.ProductTypes[0].Capacity)
+		mockDriver.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name        string
+		status      pvz_model.PvzStatus
+		capacityReq generated.PutPvzPvzIdCapacityJSONRequestBody
+		expectedErr error
+	}{
+		{
+			name:        "Update archived pvz capacity",
+			status:      pvz_model.Archived,
+			capacityReq: newCapacityReq(48),
+			expectedErr: custom_errors.ErrPvzArchived,
+		},
+		{
+			name:        "Update pvz capacity with zero storage hours",
+			status:      pvz_model.Active,
+			capacityReq: newCapacityReq(0),
+			expectedErr: custom_errors.ErrStorageHours,
+		},
+		{
+			name:        "Update pvz capacity with unknown product type",
+			status:      pvz_model.Active,
+			capacityReq: newCapacityReq(48, generated.ProductTypeCapacity{Type: "мебель", Capacity: 5}),
+			expectedErr: custom_errors.ErrProductType,
+		},
+		{
+			name:        "Update pvz capacity with zero capacity",
+			status:      pvz_model.Active,
+			capacityReq: newCapacityReq(48, generated.ProductTypeCapacity{Type: "обувь", Capacity: 0}),
+			expectedErr: custom_errors.ErrPvzCapacity,
+		},
+		{
+			name:   "Update pvz capacity with duplicate product type",
+			status: pvz_model.Inactive,
+			capacityReq: newCapacityReq(48,
+				generated.ProductTypeCapacity{Type: "обувь", Capacity: 5},
+				generated.ProductTypeCapacity{Type: "обувь", Capacity: 10}),
+			expectedErr: custom_errors.ErrDuplicateCapacity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDriver := new(MockPvzDriver)
+			service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+			mockDriver.On("GetPvzById", ctx, pvzId).Return(&pvz_model.Pvz{Id: pvzId, Status: tt.status}, nil)
+
+			result, err := service.UpdatePvzCapacity(ctx, pvzIdDto, tt.capacityReq)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Nil(t, result)
+			mockDriver.AssertNotCalled(t, "UpdatePvzCapacity", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGetNearbyPvz(t *testing.T) {
+	ctx := context.Background()
+	radius := 1500.0
+	limit := 5
+
+	t.Run("Get nearby pvz", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+		pvzList := []pvz_model.NearbyPvz{{Pvz: pvz_model.Pvz{City: pvz_model.Moscow}, Distance: 120}}
+
+		mockDriver.On("GetNearbyPvz", ctx, 55.75, 37.61, radius, uint32(limit)).Return(pvzList, nil)
+
+		result, err := service.GetNearbyPvz(ctx, 55.75, 37.61, &radius, &limit)
+
+		require.NoError(t, err)
+		assert.Equal(t, pvzList, result)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Get nearby pvz with default radius and limit", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		mockDriver.On("GetNearbyPvz", ctx, -33.86, 151.2, 5000.0, uint32(10)).Return([]pvz_model.NearbyPvz{}, nil)
+
+		result, err := service.GetNearbyPvz(ctx, -33.86, 151.2, nil, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		mockDriver.AssertExpectations(t)
+	})
+
+	tests := []struct {
+		name        string
+		latitude    float64
+		longitude   float64
+		radius      float64
+		limit       int
+		expectedErr error
+	}{
+		{"Get nearby pvz with invalid latitude", 91, 37.61, radius, limit, custom_errors.ErrLatitudeValue},
+		{"Get nearby pvz with NaN latitude", math.NaN(), 37.61, radius, limit, custom_errors.ErrLatitudeValue},
+		{"Get nearby pvz with invalid longitude", 55.75, -180.5, radius, limit, custom_errors.ErrLongitudeValue},
+		{"Get nearby pvz with zero radius", 55.75, 37.61, 0, limit, custom_errors.ErrRadiusValue},
+		{"Get nearby pvz with too large radius", 55.75, 37.61, 50001, limit, custom_errors.ErrRadiusValue},
+		{"Get nearby pvz with invalid limit", 55.75, 37.61, radius, 31, custom_errors.ErrLimitValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDriver := new(MockPvzDriver)
+			service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+			result, err := service.GetNearbyPvz(ctx, tt.latitude, tt.longitude, &tt.radius, &tt.limit)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Nil(t, result)
+			mockDriver.AssertNotCalled(t, "GetNearbyPvz")
+		})
+	}
+}
+
 func TestGetPvz(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("Get pvz with default params", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
 		params := generated.GetPvzParams{}
-		expectedPvzList := []map[string]interface{}{
-			{"id": "123", "city": "Москва"},
-			{"id": "456", "city": "Санкт-Петербург"},
+		expectedPvzList := []pvz_model.PvzWithReceptions{
+			{Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, City: pvz_model.Moscow}},
+			{Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, City: pvz_model.SPb}},
 		}
 
-		mockDriver.On("GetPvzFullInfo", ctx, uint32(10), uint32(0), (*time.Time)(nil), (*time.Time)(nil)).Return(expectedPvzList, nil)
+		mockDriver.On("CountPvz", ctx, mock.Anything).Return(2, nil)
+		mockDriver.On("GetPvzFullInfo", ctx, pvz_model.PvzFilter{Limit: 11, SortBy: pvz_model.SortByRegistrationDate}).Return(expectedPvzList, nil)
 
 		result, err := service.GetPvzFullInfo(ctx, params)
 
 		assert.NoError(t, err)
-		assert.Equal(t, expectedPvzList, result)
+		assert.Equal(t, expectedPvzList, result.Pvzs)
+		assert.Equal(t, 1, result.Page)
+		assert.Equal(t, 10, result.Limit)
+		assert.Equal(t, 2, result.Total)
+		assert.Equal(t, 1, result.TotalPages())
+		assert.Empty(t, result.NextCursor)
 		mockDriver.AssertExpectations(t)
 	})
 
 	t.Run("Get pvz with custom params", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
 		limit := 20
 		page := 2
@@ -201,22 +667,27 @@ func TestGetPvz(t *testing.T) {
 			EndDate:   &endDate,
 		}
 
-		expectedPvzList := []map[string]interface{}{
-			{"id": "789", "city": "Казань"},
+		expectedPvzList := []pvz_model.PvzWithReceptions{
+			{Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, City: pvz_model.Kazan}},
 		}
 
-		mockDriver.On("GetPvzFullInfo", ctx, uint32(20), uint32(20), &startDate, &endDate).Return(expectedPvzList, nil)
+		mockDriver.On("CountPvz", ctx, mock.Anything).Return(21, nil)
+		mockDriver.On("GetPvzFullInfo", ctx, pvz_model.PvzFilter{Limit: 21, Offset: 20, StartInterval: &startDate, EndInterval: &endDate, SortBy: pvz_model.SortByRegistrationDate}).Return(expectedPvzList, nil)
 
 		result, err := service.GetPvzFullInfo(ctx, params)
 
 		assert.NoError(t, err)
-		assert.Equal(t, expectedPvzList, result)
+		assert.Equal(t, expectedPvzList, result.Pvzs)
+		assert.Equal(t, 2, result.Page)
+		assert.Equal(t, 20, result.Limit)
+		assert.Equal(t, 21, result.Total)
+		assert.Equal(t, 2, result.TotalPages())
 		mockDriver.AssertExpectations(t)
 	})
 
 	t.Run("Get pvz with invalid date range", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
 		endDate := time.Now().AddDate(0, -2, 0)
 		startDate := time.Now().AddDate(0, -1, 0)
@@ -236,7 +707,7 @@ func TestGetPvz(t *testing.T) {
 
 	t.Run("Get pvz with invalid limit", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
 		t.Run("Get pvz with too large limit", func(t *testing.T) {
 			tooLargeLimit := 50
@@ -254,7 +725,7 @@ func TestGetPvz(t *testing.T) {
 
 		t.Run("Get pvz with too small limit", func(t *testing.T) {
 			mockDriver := new(MockPvzDriver)
-			service := pvz_service.NewPvzService(mockDriver)
+			service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
 			tooSmallLimit := 0
 			params := generated.GetPvzParams{
@@ -272,7 +743,7 @@ func TestGetPvz(t *testing.T) {
 
 	t.Run("Get pvz with invalid page", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
 		invalidPage := 0
 		params := generated.GetPvzParams{
@@ -289,12 +760,13 @@ func TestGetPvz(t *testing.T) {
 
 	t.Run("Get pvz with driver error", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
 		params := generated.GetPvzParams{}
 		expectedError := errors.New("database connection error")
 
-		mockDriver.On("GetPvzFullInfo", ctx, uint32(10), uint32(0), (*time.Time)(nil), (*time.Time)(nil)).Return(nil, expectedError)
+		mockDriver.On("CountPvz", ctx, mock.Anything).Return(1, nil)
+		mockDriver.On("GetPvzFullInfo", ctx, pvz_model.PvzFilter{Limit: 11, SortBy: pvz_model.SortByRegistrationDate}).Return(nil, expectedError)
 
 		result, err := service.GetPvzFullInfo(ctx, params)
 
@@ -303,6 +775,256 @@ func TestGetPvz(t *testing.T) {
 		assert.Nil(t, result)
 		mockDriver.AssertExpectations(t)
 	})
+
+	t.Run("Get pvz pages by cursor", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		limit := 2
+		registrationDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		pvzList := make([]pvz_model.PvzWithReceptions, 0, 3)
+		for i := 0; i < 3; i++ {
+			pvzList = append(pvzList, pvz_model.PvzWithReceptions{Pvz: pvz_model.Pvz{
+				Id:               pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				RegistrationDate: registrationDate.Add(time.Duration(i) * time.Hour),
+				City:             pvz_model.Moscow,
+			}})
+		}
+
+		mockDriver.On("CountPvz", ctx, mock.Anything).Return(3, nil)
+		mockDriver.On("GetPvzFullInfo", ctx, pvz_model.PvzFilter{Limit: 3, SortBy: pvz_model.SortByRegistrationDate}).Return(pvzList, nil).Once()
+
+		firstPage, err := service.GetPvzFullInfo(ctx, generated.GetPvzParams{Limit: &limit})
+
+		require.NoError(t, err)
+		assert.Equal(t, pvzList[:2], firstPage.Pvzs)
+		require.NotEmpty(t, firstPage.NextCursor)
+
+		expectedCursor := &pvz_model.PvzCursor{SortValue: pvzList[1].Pvz.RegistrationDate, Id: pvzList[1].Pvz.Id}
+		mockDriver.On("GetPvzFullInfo", ctx, pvz_model.PvzFilter{Limit: 3, SortBy: pvz_model.SortByRegistrationDate, After: expectedCursor}).Return(pvzList[2:], nil).Once()
+
+		secondPage, err := service.GetPvzFullInfo(ctx, generated.GetPvzParams{Limit: &limit, Cursor: &firstPage.NextCursor})
+
+		require.NoError(t, err)
+		assert.Equal(t, pvzList[2:], secondPage.Pvzs)
+		assert.Empty(t, secondPage.NextCursor)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Get pvz with invalid cursor", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		cursor := "not a cursor"
+		result, err := service.GetPvzFullInfo(ctx, generated.GetPvzParams{Cursor: &cursor})
+
+		assert.Equal(t, custom_errors.ErrCursorValue, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "GetPvzFullInfo")
+	})
+
+	t.Run("Get pvz with cursor and page", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		cursor := "eyJyIjoiMjAyNC0wMS0wMVQwMDowMDowMFoifQ"
+		page := 2
+		result, err := service.GetPvzFullInfo(ctx, generated.GetPvzParams{Cursor: &cursor, Page: &page})
+
+		assert.Equal(t, custom_errors.ErrCursorWithPage, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "GetPvzFullInfo")
+	})
+
+	t.Run("Get pvz with filters and last activity sort", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		limit := 1
+		city := "Казань"
+		receptionStatus := "in_progress"
+		productType := "обувь"
+		minProducts := 3
+		sortBy := generated.LastActivity
+		params := generated.GetPvzParams{
+			Limit:           &limit,
+			City:            &city,
+			ReceptionStatus: &receptionStatus,
+			ProductType:     &productType,
+			MinProducts:     &minProducts,
+			SortBy:          &sortBy,
+		}
+
+		expectedCity := pvz_model.Kazan
+		expectedStatus := reception_model.InProgress
+		expectedProductType := product_model.Shoes
+		expectedFilter := pvz_model.PvzFilter{
+			Limit:           2,
+			City:            &expectedCity,
+			ReceptionStatus: &expectedStatus,
+			ProductType:     &expectedProductType,
+			MinProducts:     &minProducts,
+			SortBy:          pvz_model.SortByLastActivity,
+		}
+
+		lastActivity := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		pvzList := []pvz_model.PvzWithReceptions{
+			{Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, City: pvz_model.Kazan}, LastActivity: lastActivity},
+			{Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, City: pvz_model.Kazan}, LastActivity: lastActivity.Add(-time.Hour)},
+		}
+
+		mockDriver.On("CountPvz", ctx, expectedFilter).Return(2, nil)
+		mockDriver.On("GetPvzFullInfo", ctx, expectedFilter).Return(pvzList, nil).Once()
+
+		firstPage, err := service.GetPvzFullInfo(ctx, params)
+
+		require.NoError(t, err)
+		assert.Equal(t, pvzList[:1], firstPage.Pvzs)
+		assert.Equal(t, 2, firstPage.Total)
+
+		cursorFilter := expectedFilter
+		cursorFilter.After = &pvz_model.PvzCursor{SortValue: lastActivity, Id: pvzList[0].Pvz.Id}
+		mockDriver.On("GetPvzFullInfo", ctx, cursorFilter).Return(pvzList[1:], nil).Once()
+
+		params.Cursor = &firstPage.NextCursor
+		secondPage, err := service.GetPvzFullInfo(ctx, params)
+
+		require.NoError(t, err)
+		assert.Equal(t, pvzList[1:], secondPage.Pvzs)
+		assert.Empty(t, secondPage.NextCursor)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Get pvz with cursor from another sort", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		limit := 1
+		pvzList := []pvz_model.PvzWithReceptions{
+			{Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, City: pvz_model.Moscow}},
+			{Pvz: pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, City: pvz_model.Moscow}},
+		}
+
+		mockDriver.On("CountPvz", ctx, mock.Anything).Return(2, nil)
+		mockDriver.On("GetPvzFullInfo", ctx, mock.Anything).Return(pvzList, nil).Once()
+
+		firstPage, err := service.GetPvzFullInfo(ctx, generated.GetPvzParams{Limit: &limit})
+		require.NoError(t, err)
+
+		sortBy := generated.LastActivity
+		result, err := service.GetPvzFullInfo(ctx, generated.GetPvzParams{Limit: &limit, SortBy: &sortBy, Cursor: &firstPage.NextCursor})
+
+		assert.Equal(t, custom_errors.ErrCursorValue, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Get pvz with invalid filters", func(t *testing.T) {
+		city := "Владивосток"
+		receptionStatus := "unknown"
+		productType := "мебель"
+		minProducts := 0
+		sortBy := generated.GetPvzParamsSortBy("name")
+
+		testCases := []struct {
+			name     string
+			params   generated.GetPvzParams
+			expected error
+		}{
+			{"city", generated.GetPvzParams{City: &city}, custom_errors.ErrPvzCity},
+			{"reception status", generated.GetPvzParams{ReceptionStatus: &receptionStatus}, custom_errors.ErrReceptionStatus},
+			{"product type", generated.GetPvzParams{ProductType: &productType}, custom_errors.ErrProductType},
+			{"min products", generated.GetPvzParams{MinProducts: &minProducts}, custom_errors.ErrMinProductsValue},
+			{"sort", generated.GetPvzParams{SortBy: &sortBy}, custom_errors.ErrSortValue},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				mockDriver := new(MockPvzDriver)
+				service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+				result, err := service.GetPvzFullInfo(ctx, tc.params)
+
+				assert.Equal(t, tc.expected, err)
+				assert.Nil(t, result)
+				mockDriver.AssertNotCalled(t, "GetPvzFullInfo")
+			})
+		}
+	})
+
+	t.Run("Get pvz with count error", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		params := generated.GetPvzParams{}
+
+		mockDriver.On("CountPvz", ctx, mock.Anything).Return(0, custom_errors.ErrGetPvz)
+
+		result, err := service.GetPvzFullInfo(ctx, params)
+
+		assert.Equal(t, custom_errors.ErrGetPvz, err)
+		assert.Nil(t, result)
+		mockDriver.AssertExpectations(t)
+		mockDriver.AssertNotCalled(t, "GetPvzFullInfo")
+	})
+}
+
+func TestGetPvzList(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get pvz list without paging", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		expectedPvzList := []pvz_model.Pvz{{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, City: pvz_model.Moscow}}
+		mockDriver.On("GetAllPvz", ctx).Return(expectedPvzList, nil)
+
+		pvzList, nextCursor, err := service.GetPvzList(ctx, nil, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, expectedPvzList, pvzList)
+		assert.Empty(t, nextCursor)
+		mockDriver.AssertNotCalled(t, "GetPvzPage")
+	})
+
+	t.Run("Get pvz list pages", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		limit := 1
+		registrationDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		first := pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, RegistrationDate: registrationDate, City: pvz_model.Moscow}
+		second := pvz_model.Pvz{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, RegistrationDate: registrationDate, City: pvz_model.SPb}
+
+		mockDriver.On("GetPvzPage", ctx, uint32(2), (*pvz_model.PvzCursor)(nil)).Return([]pvz_model.Pvz{first, second}, nil).Once()
+
+		pvzList, nextCursor, err := service.GetPvzList(ctx, &limit, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, []pvz_model.Pvz{first}, pvzList)
+		require.NotEmpty(t, nextCursor)
+
+		expectedCursor := &pvz_model.PvzCursor{SortValue: registrationDate, Id: first.Id}
+		mockDriver.On("GetPvzPage", ctx, uint32(2), expectedCursor).Return([]pvz_model.Pvz{second}, nil).Once()
+
+		pvzList, nextCursor, err = service.GetPvzList(ctx, &limit, nextCursor)
+
+		require.NoError(t, err)
+		assert.Equal(t, []pvz_model.Pvz{second}, pvzList)
+		assert.Empty(t, nextCursor)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Get pvz list with invalid limit", func(t *testing.T) {
+		mockDriver := new(MockPvzDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
+
+		limit := 31
+		pvzList, _, err := service.GetPvzList(ctx, &limit, "")
+
+		assert.Equal(t, custom_errors.ErrLimitValue, err)
+		assert.Nil(t, pvzList)
+		mockDriver.AssertNotCalled(t, "GetPvzPage")
+	})
 }
 
 func TestGetAllPvz(t *testing.T) {
@@ -310,7 +1032,7 @@ func TestGetAllPvz(t *testing.T) {
 
 	t.Run("Get all pvz", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
 		expectedPvzList := []pvz_model.Pvz{
 			{
@@ -331,7 +1053,7 @@ func TestGetAllPvz(t *testing.T) {
 
 	t.Run("Get all pvz with error", func(t *testing.T) {
 		mockDriver := new(MockPvzDriver)
-		service := pvz_service.NewPvzService(mockDriver)
+		service := pvz_service.NewPvzService(mockDriver, newCityService(), newProductTypeService())
 
 		internalErr := errors.New("database connection error")
 		mockDriver.On("GetAllPvz", ctx).Return(nil, internalErr)
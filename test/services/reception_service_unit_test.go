@@ -5,7 +5,12 @@ import (
 	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/product_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/reception_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/user_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/pvz_service"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/services/reception_service"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -33,6 +38,64 @@ func (m *MockReceptionDriver) CloseReception(ctx context.Context, pvzId pgtype.U
 	return args.Get(0).(*reception_model.Reception), args.Error(1)
 }
 
+func (m *MockReceptionDriver) GetReceptionWithProducts(ctx context.Context, id pgtype.UUID) (*pvz_model.ReceptionWithProducts, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pvz_model.ReceptionWithProducts), args.Error(1)
+}
+
+func (m *MockReceptionDriver) GetReceptions(ctx context.Context, filter reception_model.ReceptionFilter) ([]pvz_model.ReceptionWithProducts, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]pvz_model.ReceptionWithProducts), args.Error(1)
+}
+
+func (m *MockReceptionDriver) CountReceptions(ctx context.Context, filter reception_model.ReceptionFilter) (int, error) {
+	args := m.Called(ctx, filter)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockReceptionDriver) GetReception(ctx context.Context, id pgtype.UUID) (*reception_model.Reception, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reception_model.Reception), args.Error(1)
+}
+
+func (m *MockReceptionDriver) UpdateReceptionStatus(ctx context.Context, id pgtype.UUID, from, to reception_model.ReceptionStatus, eventType event_model.EventType) error {
+	args := m.Called(ctx, id, from, to, eventType)
+	return args.Error(0)
+}
+
+func (m *MockReceptionDriver) ReopenReception(ctx context.Context, correction *reception_model.ReceptionCorrection, from reception_model.ReceptionStatus) (*reception_model.Reception, error) {
+	args := m.Called(ctx, correction, from)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reception_model.Reception), args.Error(1)
+}
+
+func (m *MockReceptionDriver) AutoCloseStaleReceptions(ctx context.Context, now time.Time, defaultThreshold time.Duration, reason string, statuses []reception_model.ReceptionStatus) ([]reception_model.Reception, error) {
+	args := m.Called(ctx, now, defaultThreshold, reason, statuses)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]reception_model.Reception), args.Error(1)
+}
+
+func (m *MockReceptionDriver) GetReceptionCorrections(ctx context.Context, receptionId pgtype.UUID) ([]reception_model.ReceptionCorrection, error) {
+	args := m.Called(ctx, receptionId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]reception_model.ReceptionCorrection), args.Error(1)
+}
+
 func (m *MockReceptionDriver) GetLastReceptionStatus(ctx context.Context, pvzId pgtype.UUID) (*reception_model.ReceptionStatus, error) {
 	args := m.Called(ctx, pvzId)
 	if args.Get(0) == nil {
@@ -46,7 +109,8 @@ func TestCreateReception(t *testing.T) {
 
 	t.Run("Create reception with previous receptions close", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		mockEventService := newMockEventService()
+		service := reception_service.NewReceptionService(mockDriver, mockEventService, newPvzService(pvz_model.Active))
 
 		pvzIdDto := uuid.New()
 
@@ -61,11 +125,14 @@ func TestCreateReception(t *testing.T) {
 		assert.Equal(t, pvzIdDto, result.PvzId)
 		assert.Equal(t, generated.InProgress, result.Status)
 		mockDriver.AssertExpectations(t)
+		mockEventService.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event *event_model.Event) bool {
+			return event.Type == event_model.ReceptionOpened
+		}))
 	})
 
 	t.Run("Create reception without previous receptions", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzIdDto := uuid.New()
 
@@ -83,7 +150,7 @@ func TestCreateReception(t *testing.T) {
 
 	t.Run("Create reception with previous receptions in progress", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzIdDto := uuid.New()
 
@@ -99,9 +166,59 @@ func TestCreateReception(t *testing.T) {
 		mockDriver.AssertNotCalled(t, "CreateReception")
 	})
 
+	t.Run("Create reception with previous reception paused", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		status := reception_model.Paused
+		mockDriver.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+
+		result, err := service.CreateReception(ctx, uuid.New())
+
+		assert.Equal(t, custom_errors.ErrInProgressReception, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "CreateReception")
+	})
+
+	for _, tt := range []struct {
+		name        string
+		status      pvz_model.PvzStatus
+		expectedErr error
+	}{
+		{"Create reception in deactivated pvz", pvz_model.Inactive, custom_errors.ErrPvzInactive},
+		{"Create reception in archived pvz", pvz_model.Archived, custom_errors.ErrPvzArchived},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDriver := new(MockReceptionDriver)
+			service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(tt.status))
+
+			result, err := service.CreateReception(ctx, uuid.New())
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Nil(t, result)
+			mockDriver.AssertNotCalled(t, "GetLastReceptionStatus")
+			mockDriver.AssertNotCalled(t, "CreateReception")
+		})
+	}
+
+	t.Run("Create reception in missing pvz", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		mockPvzDriver := new(MockPvzDriver)
+		pvzService := pvz_service.NewPvzService(mockPvzDriver, newCityService(), newProductTypeService())
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), pvzService)
+
+		mockPvzDriver.On("GetPvzById", ctx, mock.AnythingOfType("pgtype.UUID")).Return(nil, custom_errors.ErrPvzNotFound)
+
+		result, err := service.CreateReception(ctx, uuid.New())
+
+		assert.Equal(t, custom_errors.ErrNoPvz, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "CreateReception")
+	})
+
 	t.Run("Create reception with GetLastReceptionStatus error", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzIdDto := uuid.New()
 
@@ -119,7 +236,7 @@ func TestCreateReception(t *testing.T) {
 
 	t.Run("Create reception with error", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzIdDto := uuid.New()
 
@@ -143,7 +260,8 @@ func TestCloseReception(t *testing.T) {
 
 	t.Run("Close reception with previous receptions close", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		mockEventService := newMockEventService()
+		service := reception_service.NewReceptionService(mockDriver, mockEventService, newPvzService(pvz_model.Active))
 
 		pvzIdDto := uuid.New()
 		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
@@ -171,11 +289,14 @@ func TestCloseReception(t *testing.T) {
 		assert.Equal(t, generated.Close, result.Status)
 		assert.Equal(t, receptionTime, result.DateTime)
 		mockDriver.AssertExpectations(t)
+		mockEventService.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event *event_model.Event) bool {
+			return event.Type == event_model.ReceptionClosed && event.ReceptionId == receptionId
+		}))
 	})
 
 	t.Run("Close reception without previous receptions", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzIdDto := uuid.New()
 
@@ -192,7 +313,7 @@ func TestCloseReception(t *testing.T) {
 
 	t.Run("Close reception with previous receptions in progress", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzIdDto := uuid.New()
 
@@ -208,9 +329,23 @@ func TestCloseReception(t *testing.T) {
 		mockDriver.AssertNotCalled(t, "CloseReception")
 	})
 
+	t.Run("Close paused reception", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		status := reception_model.Paused
+		mockDriver.On("GetLastReceptionStatus", ctx, mock.AnythingOfType("pgtype.UUID")).Return(&status, nil)
+
+		result, err := service.CloseReception(ctx, uuid.New())
+
+		assert.Equal(t, custom_errors.ErrReceptionPaused, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "CloseReception")
+	})
+
 	t.Run("Close reception with GetLastReceptionStatus error", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzIdDto := uuid.New()
 
@@ -228,7 +363,7 @@ func TestCloseReception(t *testing.T) {
 
 	t.Run("Close reception with error", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzIdDto := uuid.New()
 
@@ -247,12 +382,534 @@ func TestCloseReception(t *testing.T) {
 	})
 }
 
+func TestGetReception(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get reception with products", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		receptionIdDto := uuid.New()
+		receptionId := pgtype.UUID{Bytes: receptionIdDto, Valid: true}
+		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		barcode := "4601234567890"
+
+		reception := &pvz_model.ReceptionWithProducts{
+			Reception: reception_model.Reception{Id: receptionId, ReceptionTime: time.Now(), PvzId: pvzId, Status: reception_model.Close},
+			Products: []product_model.Product{{
+				Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				AddingTime:  time.Now(),
+				ProductType: product_model.Shoes,
+				ReceptionId: receptionId,
+				Barcode:     &barcode,
+				Dimensions:  &product_model.Dimensions{LengthMm: 300, WidthMm: 200, HeightMm: 100},
+			}},
+		}
+		mockDriver.On("GetReceptionWithProducts", ctx, receptionId).Return(reception, nil)
+
+		result, err := service.GetReception(ctx, receptionIdDto)
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionIdDto, *result.Reception.Id)
+		assert.Equal(t, uuid.UUID(pvzId.Bytes), result.Reception.PvzId)
+		assert.Equal(t, generated.Close, result.Reception.Status)
+		require.Len(t, result.Products, 1)
+		assert.Equal(t, &barcode, result.Products[0].Barcode)
+		assert.Equal(t, 300, result.Products[0].Dimensions.LengthMm)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Get not existing reception", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		mockDriver.On("GetReceptionWithProducts", ctx, mock.AnythingOfType("pgtype.UUID")).Return(nil, custom_errors.ErrReceptionNotFound)
+
+		result, err := service.GetReception(ctx, uuid.New())
+
+		assert.Equal(t, custom_errors.ErrReceptionNotFound, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestGetPvzReceptions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get pvz receptions with filters", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		pvzIdDto := uuid.New()
+		pvzId := pgtype.UUID{Bytes: pvzIdDto, Valid: true}
+		status := "close"
+		startDate := time.Now().Add(-24 * time.Hour)
+		page := 2
+		limit := 5
+
+		closeStatus := reception_model.Close
+		expectedFilter := reception_model.ReceptionFilter{
+			PvzId:         pvzId,
+			Status:        &closeStatus,
+			StartInterval: &startDate,
+			Limit:         5,
+			Offset:        5,
+		}
+		receptionId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		receptions := []pvz_model.ReceptionWithProducts{{
+			Reception: reception_model.Reception{Id: receptionId, ReceptionTime: time.Now(), PvzId: pvzId, Status: reception_model.Close},
+			Products:  []product_model.Product{},
+		}}
+		mockDriver.On("CountReceptions", ctx, expectedFilter).Return(6, nil)
+		mockDriver.On("GetReceptions", ctx, expectedFilter).Return(receptions, nil)
+
+		result, err := service.GetPvzReceptions(ctx, pvzIdDto, generated.GetPvzPvzIdReceptionsParams{
+			Status:    &status,
+			StartDate: &startDate,
+			Page:      &page,
+			Limit:     &limit,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Receptions, 1)
+		assert.Equal(t, uuid.UUID(receptionId.Bytes), *result.Receptions[0].Reception.Id)
+		assert.Empty(t, result.Receptions[0].Products)
+		assert.Equal(t, 2, result.Page)
+		assert.Equal(t, 5, result.Limit)
+		assert.Equal(t, 6, result.Total)
+		assert.Equal(t, 2, result.TotalPages)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Get pvz receptions with invalid status", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		status := "done"
+		result, err := service.GetPvzReceptions(ctx, uuid.New(), generated.GetPvzPvzIdReceptionsParams{Status: &status})
+
+		assert.Equal(t, custom_errors.ErrReceptionStatus, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "GetReceptions")
+	})
+
+	t.Run("Get pvz receptions with invalid date range", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		startDate := time.Now()
+		endDate := startDate.Add(-time.Hour)
+		result, err := service.GetPvzReceptions(ctx, uuid.New(), generated.GetPvzPvzIdReceptionsParams{StartDate: &startDate, EndDate: &endDate})
+
+		assert.Equal(t, custom_errors.ErrDateRange, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Get pvz receptions with invalid limit", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		limit := 31
+		result, err := service.GetPvzReceptions(ctx, uuid.New(), generated.GetPvzPvzIdReceptionsParams{Limit: &limit})
+
+		assert.Equal(t, custom_errors.ErrLimitValue, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestReopenReception(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Reopen closed reception", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		mockEventService := newMockEventService()
+		service := reception_service.NewReceptionService(mockDriver, mockEventService, newPvzService(pvz_model.Active))
+
+		receptionIdDto := uuid.New()
+		receptionId := pgtype.UUID{Bytes: receptionIdDto, Valid: true}
+		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		moderatorId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+		receptionTime := time.Now()
+
+		reopenedReception := &reception_model.Reception{
+			Id:            receptionId,
+			ReceptionTime: receptionTime,
+			PvzId:         pvzId,
+			Status:        reception_model.InProgress,
+		}
+		mockDriver.On("GetReception", ctx, receptionId).
+			Return(&reception_model.Reception{Id: receptionId, ReceptionTime: receptionTime, PvzId: pvzId, Status: reception_model.AutoClosed}, nil)
+		mockDriver.On("ReopenReception", ctx, mock.MatchedBy(func(correction *reception_model.ReceptionCorrection) bool {
+			return correction.ReceptionId == receptionId && correction.ReopenedBy == moderatorId &&
+				correction.Reason == "miscounted" && correction.Id.Valid
+		}), reception_model.AutoClosed).Return(reopenedReception, nil)
+
+		result, err := service.ReopenReception(ctx, receptionIdDto, "  miscounted ", moderatorId)
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionIdDto, *result.Id)
+		assert.Equal(t, uuid.UUID(pvzId.Bytes), result.PvzId)
+		assert.Equal(t, generated.InProgress, result.Status)
+		assert.Equal(t, receptionTime, result.DateTime)
+		mockDriver.AssertExpectations(t)
+		mockEventService.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event *event_model.Event) bool {
+			return event.Type == event_model.ReceptionReopened && event.ReceptionId == receptionId
+		}))
+	})
+
+	t.Run("Reopen reception with empty reason", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		result, err := service.ReopenReception(ctx, uuid.New(), "   ", pgtype.UUID{Bytes: uuid.New(), Valid: true})
+
+		assert.Equal(t, custom_errors.ErrReopenReason, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "ReopenReception")
+	})
+
+	t.Run("Reopen reception in progress", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		mockDriver.On("GetReception", ctx, mock.AnythingOfType("pgtype.UUID")).
+			Return(&reception_model.Reception{Status: reception_model.InProgress}, nil)
+
+		result, err := service.ReopenReception(ctx, uuid.New(), "miscounted", pgtype.UUID{Bytes: uuid.New(), Valid: true})
+
+		assert.Equal(t, custom_errors.ErrReceptionNotClosed, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "ReopenReception")
+	})
+
+	pvzStatusTests := []struct {
+		name        string
+		status      pvz_model.PvzStatus
+		expectedErr error
+	}{
+		{"Reopen reception in archived pvz", pvz_model.Archived, custom_errors.ErrPvzArchived},
+		{"Reopen reception in inactive pvz", pvz_model.Inactive, custom_errors.ErrPvzInactive},
+	}
+
+	for _, tt := range pvzStatusTests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDriver := new(MockReceptionDriver)
+			service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(tt.status))
+
+			mockDriver.On("GetReception", ctx, mock.AnythingOfType("pgtype.UUID")).
+				Return(&reception_model.Reception{Status: reception_model.Close}, nil)
+
+			result, err := service.ReopenReception(ctx, uuid.New(), "miscounted", pgtype.UUID{Bytes: uuid.New(), Valid: true})
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Nil(t, result)
+			mockDriver.AssertNotCalled(t, "ReopenReception")
+		})
+	}
+
+	t.Run("Reopen reception with newer reception", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		mockEventService := newMockEventService()
+		service := reception_service.NewReceptionService(mockDriver, mockEventService, newPvzService(pvz_model.Active))
+
+		mockDriver.On("GetReception", ctx, mock.AnythingOfType("pgtype.UUID")).
+			Return(&reception_model.Reception{Status: reception_model.Close}, nil)
+		mockDriver.On("ReopenReception", ctx, mock.Anything, reception_model.Close).Return(nil, custom_errors.ErrNewerReception)
+
+		result, err := service.ReopenReception(ctx, uuid.New(), "miscounted", pgtype.UUID{Bytes: uuid.New(), Valid: true})
+
+		assert.Equal(t, custom_errors.ErrNewerReception, err)
+		assert.Nil(t, result)
+		mockDriver.AssertExpectations(t)
+		mockEventService.AssertNotCalled(t, "Publish")
+	})
+}
+
+func TestChangeReceptionStatus(t *testing.T) {
+	ctx := context.Background()
+	receptionIdDto := uuid.New()
+	receptionId := pgtype.UUID{Bytes: receptionIdDto, Valid: true}
+	pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	userId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	receptionTime := time.Now()
+
+	newReception := func(status reception_model.ReceptionStatus) *reception_model.Reception {
+		return &reception_model.Reception{Id: receptionId, ReceptionTime: receptionTime, PvzId: pvzId, Status: status}
+	}
+
+	t.Run("Pause reception in progress", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		mockEventService := newMockEventService()
+		service := reception_service.NewReceptionService(mockDriver, mockEventService, newPvzService(pvz_model.Active))
+
+		mockDriver.On("GetReception", ctx, receptionId).Return(newReception(reception_model.InProgress), nil)
+		mockDriver.On("UpdateReceptionStatus", ctx, receptionId, reception_model.InProgress, reception_model.Paused, event_model.ReceptionPaused).Return(nil)
+
+		result, err := service.ChangeReceptionStatus(ctx, receptionIdDto,
+			generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: generated.Paused}, userId, user_model.Employee)
+
+		require.NoError(t, err)
+		assert.Equal(t, receptionIdDto, *result.Id)
+		assert.Equal(t, uuid.UUID(pvzId.Bytes), result.PvzId)
+		assert.Equal(t, generated.Paused, result.Status)
+		mockDriver.AssertExpectations(t)
+		mockEventService.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event *event_model.Event) bool {
+			return event.Type == event_model.ReceptionPaused && event.ReceptionId == receptionId && event.PvzId == pvzId
+		}))
+	})
+
+	t.Run("Cancel paused reception", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		mockEventService := newMockEventService()
+		service := reception_service.NewReceptionService(mockDriver, mockEventService, newPvzService(pvz_model.Active))
+
+		mockDriver.On("GetReception", ctx, receptionId).Return(newReception(reception_model.Paused), nil)
+		mockDriver.On("UpdateReceptionStatus", ctx, receptionId, reception_model.Paused, reception_model.Cancelled, event_model.ReceptionCancelled).Return(nil)
+
+		result, err := service.ChangeReceptionStatus(ctx, receptionIdDto,
+			generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: generated.Cancelled}, userId, user_model.Moderator)
+
+		require.NoError(t, err)
+		assert.Equal(t, generated.Cancelled, result.Status)
+		mockDriver.AssertExpectations(t)
+		mockEventService.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event *event_model.Event) bool {
+			return event.Type == event_model.ReceptionCancelled && event.ReceptionId == receptionId && event.PvzId == pvzId
+		}))
+	})
+
+	t.Run("Verify closed reception", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		mockDriver.On("GetReception", ctx, receptionId).Return(newReception(reception_model.Close), nil)
+		mockDriver.On("UpdateReceptionStatus", ctx, receptionId, reception_model.Close, reception_model.Verified, event_model.ReceptionVerified).Return(nil)
+
+		result, err := service.ChangeReceptionStatus(ctx, receptionIdDto,
+			generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: generated.Verified}, userId, user_model.Moderator)
+
+		require.NoError(t, err)
+		assert.Equal(t, generated.Verified, result.Status)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Reopen closed reception through status change", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		reason := "miscounted"
+		mockDriver.On("GetReception", ctx, receptionId).Return(newReception(reception_model.Close), nil)
+		mockDriver.On("ReopenReception", ctx, mock.MatchedBy(func(correction *reception_model.ReceptionCorrection) bool {
+			return correction.ReceptionId == receptionId && correction.ReopenedBy == userId && correction.Reason == reason
+		}), reception_model.Close).Return(newReception(reception_model.InProgress), nil)
+
+		result, err := service.ChangeReceptionStatus(ctx, receptionIdDto,
+			generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: generated.InProgress, Reason: &reason}, userId, user_model.Moderator)
+
+		require.NoError(t, err)
+		assert.Equal(t, generated.InProgress, result.Status)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Change reception status with invalid transition", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			from     reception_model.ReceptionStatus
+			to       generated.ReceptionStatus
+			role     user_model.UserRole
+			expected error
+		}{
+			{"verify reception in progress", reception_model.InProgress, generated.Verified, user_model.Moderator, custom_errors.ErrReceptionTransition},
+			{"close paused reception", reception_model.Paused, generated.Close, user_model.Employee, custom_errors.ErrReceptionPaused},
+			{"cancel verified reception", reception_model.Verified, generated.Cancelled, user_model.Moderator, custom_errors.ErrReceptionFinalized},
+			{"resume cancelled reception", reception_model.Cancelled, generated.InProgress, user_model.Employee, custom_errors.ErrReceptionFinalized},
+			{"auto close reception manually", reception_model.InProgress, generated.AutoClosed, user_model.Moderator, custom_errors.ErrReceptionTransition},
+			{"verify reception by employee", reception_model.Close, generated.Verified, user_model.Employee, custom_errors.ErrTransitionRole},
+			{"pause reception by moderator", reception_model.InProgress, generated.Paused, user_model.Moderator, custom_errors.ErrTransitionRole},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				mockDriver := new(MockReceptionDriver)
+				service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+				mockDriver.On("GetReception", ctx, receptionId).Return(newReception(tc.from), nil)
+
+				result, err := service.ChangeReceptionStatus(ctx, receptionIdDto,
+					generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: tc.to}, userId, tc.role)
+
+				assert.Equal(t, tc.expected, err)
+				assert.Nil(t, result)
+				mockDriver.AssertNotCalled(t, "UpdateReceptionStatus")
+			})
+		}
+	})
+
+	t.Run("Change reception status with unknown status", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		result, err := service.ChangeReceptionStatus(ctx, receptionIdDto,
+			generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: "archived"}, userId, user_model.Moderator)
+
+		assert.Equal(t, custom_errors.ErrReceptionStatus, err)
+		assert.Nil(t, result)
+		mockDriver.AssertNotCalled(t, "GetReception")
+	})
+
+	t.Run("Change reception status changed concurrently", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		mockEventService := newMockEventService()
+		service := reception_service.NewReceptionService(mockDriver, mockEventService, newPvzService(pvz_model.Active))
+
+		mockDriver.On("GetReception", ctx, receptionId).Return(newReception(reception_model.Paused), nil)
+		mockDriver.On("UpdateReceptionStatus", ctx, receptionId, reception_model.Paused, reception_model.InProgress, event_model.ReceptionResumed).
+			Return(custom_errors.ErrReceptionChanged)
+
+		result, err := service.ChangeReceptionStatus(ctx, receptionIdDto,
+			generated.PostReceptionsReceptionIdStatusJSONRequestBody{Status: generated.InProgress}, userId, user_model.Employee)
+
+		assert.Equal(t, custom_errors.ErrReceptionChanged, err)
+		assert.Nil(t, result)
+		mockDriver.AssertExpectations(t)
+		mockEventService.AssertNotCalled(t, "Publish")
+	})
+}
+
+func TestGetReceptionCorrections(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Get reception corrections", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		receptionIdDto := uuid.New()
+		receptionId := pgtype.UUID{Bytes: receptionIdDto, Valid: true}
+		closedAt := time.Now().Add(-time.Hour)
+		reopenedAt := time.Now()
+
+		corrections := []reception_model.ReceptionCorrection{
+			{
+				Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				ReceptionId: receptionId,
+				ClosedAt:    &closedAt,
+				ReopenedBy:  pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				ReopenedAt:  reopenedAt,
+				Reason:      "miscounted",
+			},
+			{
+				Id:          pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				ReceptionId: receptionId,
+				ReopenedAt:  reopenedAt,
+				Reason:      "wrong pvz",
+			},
+		}
+		mockDriver.On("GetReceptionCorrections", ctx, receptionId).Return(corrections, nil)
+
+		result, err := service.GetReceptionCorrections(ctx, receptionIdDto)
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, receptionIdDto, result[0].ReceptionId)
+		assert.Equal(t, &closedAt, result[0].ClosedAt)
+		assert.Equal(t, uuid.UUID(corrections[0].ReopenedBy.Bytes), *result[0].ReopenedBy)
+		assert.Equal(t, "miscounted", result[0].Reason)
+		assert.Nil(t, result[1].ClosedAt)
+		assert.Nil(t, result[1].ReopenedBy)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Get reception corrections with error", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
+
+		mockDriver.On("GetReceptionCorrections", ctx, mock.AnythingOfType("pgtype.UUID")).Return(nil, custom_errors.ErrGetCorrections)
+
+		result, err := service.GetReceptionCorrections(ctx, uuid.New())
+
+		assert.Equal(t, custom_errors.ErrGetCorrections, err)
+		assert.Nil(t, result)
+		mockDriver.AssertExpectations(t)
+	})
+}
+
+func TestAutoCloseStaleReceptions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Auto close stale receptions", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		mockEventService := newMockEventService()
+		service := reception_service.NewReceptionService(mockDriver, mockEventService, newPvzService(pvz_model.Active))
+
+		reason := "reception was not closed within the allowed time"
+		receptions := []reception_model.Reception{
+			{
+				Id:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				ReceptionTime: time.Now().Add(-30 * time.Hour),
+				PvzId:         pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				Status:        reception_model.AutoClosed,
+				CloseReason:   &reason,
+			},
+			{
+				Id:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				ReceptionTime: time.Now().Add(-48 * time.Hour),
+				PvzId:         pgtype.UUID{Bytes: uuid.New(), Valid: true},
+				Status:        reception_model.AutoClosed,
+				CloseReason:   &reason,
+			},
+		}
+		mockDriver.On("AutoCloseStaleReceptions", ctx, mock.AnythingOfType("time.Time"), 24*time.Hour, reason,
+			[]reception_model.ReceptionStatus{reception_model.InProgress, reception_model.Paused}).Return(receptions, nil)
+
+		closed, err := service.AutoCloseStaleReceptions(ctx, 24*time.Hour)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, closed)
+		mockDriver.AssertExpectations(t)
+		for _, reception := range receptions {
+			mockEventService.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event *event_model.Event) bool {
+				return event.Type == event_model.ReceptionClosed && event.ReceptionId == reception.Id && event.PvzId == reception.PvzId
+			}))
+		}
+	})
+
+	t.Run("Auto close without stale receptions", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		mockEventService := newMockEventService()
+		service := reception_service.NewReceptionService(mockDriver, mockEventService, newPvzService(pvz_model.Active))
+
+		mockDriver.On("AutoCloseStaleReceptions", ctx, mock.AnythingOfType("time.Time"), time.Hour, mock.Anything, mock.Anything).
+			Return([]reception_model.Reception{}, nil)
+
+		closed, err := service.AutoCloseStaleReceptions(ctx, time.Hour)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, closed)
+		mockDriver.AssertExpectations(t)
+		mockEventService.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("Auto close with error", func(t *testing.T) {
+		mockDriver := new(MockReceptionDriver)
+		mockEventService := newMockEventService()
+		service := reception_service.NewReceptionService(mockDriver, mockEventService, newPvzService(pvz_model.Active))
+
+		mockDriver.On("AutoCloseStaleReceptions", ctx, mock.AnythingOfType("time.Time"), time.Hour, mock.Anything, mock.Anything).
+			Return(nil, custom_errors.ErrAutoCloseLock)
+
+		closed, err := service.AutoCloseStaleReceptions(ctx, time.Hour)
+
+		assert.Equal(t, custom_errors.ErrAutoCloseLock, err)
+		assert.Equal(t, 0, closed)
+		mockDriver.AssertExpectations(t)
+		mockEventService.AssertNotCalled(t, "Publish")
+	})
+}
+
 func TestGetLastReceptionStatus(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("Get last reception status close", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
 
@@ -268,7 +925,7 @@ func TestGetLastReceptionStatus(t *testing.T) {
 
 	t.Run("Get last reception status in progress", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
 
@@ -284,7 +941,7 @@ func TestGetLastReceptionStatus(t *testing.T) {
 
 	t.Run("Get last reception status without existing Receptions", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
 
@@ -300,7 +957,7 @@ func TestGetLastReceptionStatus(t *testing.T) {
 
 	t.Run("Get last reception status with error", func(t *testing.T) {
 		mockDriver := new(MockReceptionDriver)
-		service := reception_service.NewReceptionService(mockDriver)
+		service := reception_service.NewReceptionService(mockDriver, newMockEventService(), newPvzService(pvz_model.Active))
 
 		pvzId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
 
@@ -2,6 +2,12 @@ package services
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
 	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
@@ -15,7 +21,6 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
-	"os"
 	"testing"
 	"time"
 )
@@ -45,30 +50,57 @@ func (m *MockUserDriver) CreateUser(ctx context.Context, user *user_model.User)
 	return args.Error(0)
 }
 
-func setupJwtSecret(t *testing.T) {
-	originalSecret := os.Getenv("JWT_SECRET")
+func (m *MockUserDriver) CreateRefreshToken(ctx context.Context, token *user_model.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockUserDriver) RotateRefreshToken(ctx context.Context, tokenHash []byte, newToken *user_model.RefreshToken, now time.Time) error {
+	args := m.Called(ctx, tokenHash, newToken, now)
+	return args.Error(0)
+}
+
+func (m *MockUserDriver) RevokeSession(ctx context.Context, jti pgtype.UUID, expiresAt time.Time, now time.Time) error {
+	args := m.Called(ctx, jti, expiresAt, now)
+	return args.Error(0)
+}
+
+func (m *MockUserDriver) RevokeUserSessions(ctx context.Context, userId pgtype.UUID, now time.Time) error {
+	args := m.Called(ctx, userId, now)
+	return args.Error(0)
+}
 
-	err := os.Setenv("JWT_SECRET", "test-secret-key")
+func (m *MockUserDriver) IsTokenRevoked(ctx context.Context, jti pgtype.UUID) (bool, error) {
+	args := m.Called(ctx, jti)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserDriver) DeleteExpiredTokens(ctx context.Context, now time.Time) error {
+	args := m.Called(ctx, now)
+	return args.Error(0)
+}
+
+func newTestJwtKeySet(t *testing.T) *user_model.JwtKeySet {
+	keys, err := user_model.NewJwtKeySet(user_model.JwtKeysConfig{Secret: []byte("test-secret-key")})
 	require.NoError(t, err)
 
-	t.Cleanup(func() {
-		_ = os.Setenv("JWT_SECRET", originalSecret)
-	})
+	return keys
 }
 
 func TestDummyLogin(t *testing.T) {
 	ctx := context.Background()
-	setupJwtSecret(t)
+	keys := newTestJwtKeySet(t)
 
 	t.Run("Dummy login with non-existing user", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		role := generated.UserRoleEmployee
 		email := "dummy.employee@example.com"
 
 		mockDriver.On("GetUserByEmail", ctx, email).Return(nil, nil)
 		mockDriver.On("CreateUser", ctx, mock.AnythingOfType("*user_model.User")).Return(nil)
+		mockDriver.On("CreateRefreshToken", ctx, mock.AnythingOfType("*user_model.RefreshToken")).Return(nil)
 
 		token, err := service.DummyLogin(ctx, role)
 
@@ -79,7 +111,7 @@ func TestDummyLogin(t *testing.T) {
 
 	t.Run("Dummy login with existing user", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		role := generated.UserRoleModerator
 		email := "dummy.moderator@example.com"
@@ -92,6 +124,9 @@ func TestDummyLogin(t *testing.T) {
 		}
 
 		mockDriver.On("GetUserByEmail", ctx, email).Return(existingUser, nil)
+		mockDriver.On("CreateRefreshToken", ctx, mock.MatchedBy(func(token *user_model.RefreshToken) bool {
+			return token.UserId == existingUser.Id && len(token.TokenHash) == 32 && token.ExpiresAt.After(token.AccessExpiresAt)
+		})).Return(nil)
 
 		token, err := service.DummyLogin(ctx, role)
 
@@ -102,7 +137,7 @@ func TestDummyLogin(t *testing.T) {
 
 	t.Run("Dummy login with invalid role", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		invalidRole := generated.UserRole("invalid")
 
@@ -116,7 +151,7 @@ func TestDummyLogin(t *testing.T) {
 
 	t.Run("Dummy login with driver error", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		role := generated.UserRoleEmployee
 		email := "dummy.employee@example.com"
@@ -131,15 +166,27 @@ func TestDummyLogin(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 		mockDriver.AssertExpectations(t)
 	})
+
+	t.Run("Dummy login disabled", func(t *testing.T) {
+		mockDriver := new(MockUserDriver)
+		service := user_service.NewUserService(mockDriver, keys, false)
+
+		token, err := service.DummyLogin(ctx, generated.UserRoleModerator)
+
+		assert.Equal(t, custom_errors.ErrDummyLoginDisabled, err)
+		assert.Nil(t, token)
+		mockDriver.AssertNotCalled(t, "GetUserByEmail", mock.Anything, mock.Anything)
+		mockDriver.AssertNotCalled(t, "CreateUser", mock.Anything, mock.Anything)
+	})
 }
 
 func TestRegister(t *testing.T) {
 	ctx := context.Background()
-	setupJwtSecret(t)
+	keys := newTestJwtKeySet(t)
 
 	t.Run("Register user", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		email := openapi_types.Email("test@example.com")
 		password := "password123"
@@ -147,6 +194,7 @@ func TestRegister(t *testing.T) {
 
 		mockDriver.On("GetUserByEmail", ctx, string(email)).Return(nil, custom_errors.ErrUserNotFound)
 		mockDriver.On("CreateUser", ctx, mock.AnythingOfType("*user_model.User")).Return(nil)
+		mockDriver.On("CreateRefreshToken", ctx, mock.AnythingOfType("*user_model.RefreshToken")).Return(nil)
 
 		userDto, token, err := service.Register(ctx, email, password, role)
 
@@ -160,7 +208,7 @@ func TestRegister(t *testing.T) {
 
 	t.Run("Register user with invalid email", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		invalidEmail := openapi_types.Email("invalid-email")
 		password := "password123"
@@ -176,7 +224,7 @@ func TestRegister(t *testing.T) {
 
 	t.Run("Register user with invalid role", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		email := openapi_types.Email("test@example.com")
 		password := "password123"
@@ -193,7 +241,7 @@ func TestRegister(t *testing.T) {
 
 	t.Run("Register user with existing email in db", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		email := openapi_types.Email("test@example.com")
 		password := "password123"
@@ -212,7 +260,7 @@ func TestRegister(t *testing.T) {
 
 	t.Run("Register user with driver error", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		email := openapi_types.Email("test@example.com")
 		password := "password123"
@@ -234,11 +282,11 @@ func TestRegister(t *testing.T) {
 
 func TestLogin(t *testing.T) {
 	ctx := context.Background()
-	setupJwtSecret(t)
+	keys := newTestJwtKeySet(t)
 
 	t.Run("Login existing user", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		email := openapi_types.Email("test@example.com")
 		password := "password123"
@@ -253,17 +301,19 @@ func TestLogin(t *testing.T) {
 		}
 
 		mockDriver.On("GetUserByEmail", ctx, string(email)).Return(existingUser, nil)
+		mockDriver.On("CreateRefreshToken", ctx, mock.AnythingOfType("*user_model.RefreshToken")).Return(nil)
 
 		token, err := service.Login(ctx, email, password)
 
 		assert.NoError(t, err)
-		assert.NotEmpty(t, token)
+		assert.NotEmpty(t, token.AccessToken)
+		assert.NotEmpty(t, token.RefreshToken)
 		mockDriver.AssertExpectations(t)
 	})
 
 	t.Run("Login non-existing user", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		email := openapi_types.Email("nonexistent@example.com")
 		password := "password123"
@@ -279,9 +329,31 @@ func TestLogin(t *testing.T) {
 		mockDriver.AssertExpectations(t)
 	})
 
+	t.Run("Login user without password hash", func(t *testing.T) {
+		mockDriver := new(MockUserDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
+
+		email := openapi_types.Email("dummy.moderator@example.com")
+		user := &user_model.User{
+			Id:           pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			Email:        string(email),
+			PasswordHash: []byte{},
+			Role:         user_model.Moderator,
+		}
+
+		mockDriver.On("GetUserByEmail", ctx, string(email)).Return(user, nil)
+
+		token, err := service.Login(ctx, email, "")
+
+		assert.Equal(t, custom_errors.ErrLoginPassword, err)
+		assert.Nil(t, token)
+		mockDriver.AssertExpectations(t)
+		mockDriver.AssertNotCalled(t, "CreateRefreshToken", mock.Anything, mock.Anything)
+	})
+
 	t.Run("Login existing user with invalid password", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		email := openapi_types.Email("test@example.com")
 		correctPassword := "password123"
@@ -308,7 +380,7 @@ func TestLogin(t *testing.T) {
 
 	t.Run("Login existing user with invalid email format", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		invalidEmail := openapi_types.Email("invalid-email")
 		password := "password123"
@@ -323,13 +395,14 @@ func TestLogin(t *testing.T) {
 
 func TestValidateToken(t *testing.T) {
 	ctx := context.Background()
-	setupJwtSecret(t)
+	keys := newTestJwtKeySet(t)
 
 	t.Run("Validate valid token", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		userID := uuid.New().String()
+		jti := uuid.New()
 		email := "test@example.com"
 		role := "employee"
 
@@ -338,6 +411,7 @@ func TestValidateToken(t *testing.T) {
 			Email:  email,
 			Role:   role,
 			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        jti.String(),
 				ExpiresAt: jwt.NewNumericDate(time.Now().Add(1 * time.Hour)),
 				IssuedAt:  jwt.NewNumericDate(time.Now()),
 				NotBefore: jwt.NewNumericDate(time.Now()),
@@ -357,6 +431,7 @@ func TestValidateToken(t *testing.T) {
 			Role:         user_model.Employee,
 		}
 
+		mockDriver.On("IsTokenRevoked", ctx, pgtype.UUID{Bytes: jti, Valid: true}).Return(false, nil)
 		mockDriver.On("GetUserById", ctx, mock.AnythingOfType("pgtype.UUID")).Return(existingUser, nil)
 
 		user, err := service.ValidateToken(ctx, signedToken)
@@ -369,7 +444,7 @@ func TestValidateToken(t *testing.T) {
 
 	t.Run("Validate invalid token", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		invalidToken := "invalid.token.string"
 
@@ -382,9 +457,10 @@ func TestValidateToken(t *testing.T) {
 
 	t.Run("Validate expired token", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		userID := uuid.New().String()
+		jti := uuid.New()
 		email := "test@example.com"
 		role := "employee"
 
@@ -393,6 +469,7 @@ func TestValidateToken(t *testing.T) {
 			Email:  email,
 			Role:   role,
 			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        jti.String(),
 				ExpiresAt: jwt.NewNumericDate(time.Now().Add(-1 * time.Hour)),
 				IssuedAt:  jwt.NewNumericDate(time.Now().Add(-2 * time.Hour)),
 				NotBefore: jwt.NewNumericDate(time.Now().Add(-2 * time.Hour)),
@@ -405,16 +482,46 @@ func TestValidateToken(t *testing.T) {
 
 		user, err := service.ValidateToken(ctx, signedToken)
 
-		assert.Error(t, err)
+		assert.Equal(t, custom_errors.ErrTokenExpired, err)
 		assert.Nil(t, user)
 		mockDriver.AssertNotCalled(t, "GetUserById")
 	})
 
+	t.Run("Validate revoked token", func(t *testing.T) {
+		mockDriver := new(MockUserDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
+
+		jti := uuid.New()
+		signedToken := signTestToken(t, uuid.New().String(), jti.String(), time.Hour)
+
+		mockDriver.On("IsTokenRevoked", ctx, pgtype.UUID{Bytes: jti, Valid: true}).Return(true, nil)
+
+		user, err := service.ValidateToken(ctx, signedToken)
+
+		assert.Equal(t, custom_errors.ErrTokenRevoked, err)
+		assert.Nil(t, user)
+		mockDriver.AssertNotCalled(t, "GetUserById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Validate token without jti", func(t *testing.T) {
+		mockDriver := new(MockUserDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
+
+		signedToken := signTestToken(t, uuid.New().String(), "", time.Hour)
+
+		user, err := service.ValidateToken(ctx, signedToken)
+
+		assert.Equal(t, custom_errors.ErrAccessToken, err)
+		assert.Nil(t, user)
+		mockDriver.AssertNotCalled(t, "IsTokenRevoked", mock.Anything, mock.Anything)
+	})
+
 	t.Run("Validate toke: User not found", func(t *testing.T) {
 		mockDriver := new(MockUserDriver)
-		service := user_service.NewUserService(mockDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
 
 		userID := uuid.New().String()
+		jti := uuid.New()
 		email := "test@example.com"
 		role := "employee"
 
@@ -423,6 +530,7 @@ func TestValidateToken(t *testing.T) {
 			Email:  email,
 			Role:   role,
 			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        jti.String(),
 				ExpiresAt: jwt.NewNumericDate(time.Now().Add(1 * time.Hour)),
 				IssuedAt:  jwt.NewNumericDate(time.Now()),
 				NotBefore: jwt.NewNumericDate(time.Now()),
@@ -433,6 +541,7 @@ func TestValidateToken(t *testing.T) {
 		signedToken, err := token.SignedString([]byte("test-secret-key"))
 		require.NoError(t, err)
 
+		mockDriver.On("IsTokenRevoked", ctx, pgtype.UUID{Bytes: jti, Valid: true}).Return(false, nil)
 		mockDriver.On("GetUserById", ctx, mock.AnythingOfType("pgtype.UUID")).Return(nil, custom_errors.ErrUserNotFound)
 
 		user, err := service.ValidateToken(ctx, signedToken)
@@ -443,3 +552,278 @@ func TestValidateToken(t *testing.T) {
 		mockDriver.AssertExpectations(t)
 	})
 }
+
+func TestRefresh(t *testing.T) {
+	ctx := context.Background()
+	keys := newTestJwtKeySet(t)
+
+	t.Run("Refresh tokens", func(t *testing.T) {
+		mockDriver := new(MockUserDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
+
+		refreshToken := "refresh-token"
+		tokenHash := sha256.Sum256([]byte(refreshToken))
+		user := &user_model.User{
+			Id:    pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			Email: "test@example.com",
+			Role:  user_model.Employee,
+		}
+
+		mockDriver.On("RotateRefreshToken", ctx, tokenHash[:], mock.AnythingOfType("*user_model.RefreshToken"), mock.AnythingOfType("time.Time")).
+			Run(func(args mock.Arguments) {
+				args.Get(2).(*user_model.RefreshToken).UserId = user.Id
+			}).
+			Return(nil)
+		mockDriver.On("GetUserById", ctx, user.Id).Return(user, nil)
+
+		tokens, err := service.Refresh(ctx, refreshToken)
+
+		require.NoError(t, err)
+		assert.NotEqual(t, refreshToken, tokens.RefreshToken)
+
+		claims, err := keys.ValidateToken(tokens.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, user.Id.String(), claims.UserId)
+		assert.NotEmpty(t, claims.ID)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Refresh with reused token", func(t *testing.T) {
+		mockDriver := new(MockUserDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
+
+		mockDriver.On("RotateRefreshToken", ctx, mock.Anything, mock.Anything, mock.Anything).Return(custom_errors.ErrRefreshTokenReused)
+
+		tokens, err := service.Refresh(ctx, "used-refresh-token")
+
+		assert.Equal(t, custom_errors.ErrRefreshTokenReused, err)
+		assert.Nil(t, tokens)
+		mockDriver.AssertNotCalled(t, "GetUserById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Refresh without token", func(t *testing.T) {
+		mockDriver := new(MockUserDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
+
+		tokens, err := service.Refresh(ctx, "")
+
+		assert.Equal(t, custom_errors.ErrRefreshToken, err)
+		assert.Nil(t, tokens)
+		mockDriver.AssertNotCalled(t, "RotateRefreshToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestLogout(t *testing.T) {
+	ctx := context.Background()
+	keys := newTestJwtKeySet(t)
+
+	t.Run("Logout current session", func(t *testing.T) {
+		mockDriver := new(MockUserDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
+
+		jti := uuid.New()
+		signedToken := signTestToken(t, uuid.New().String(), jti.String(), time.Hour)
+
+		mockDriver.On("RevokeSession", ctx, pgtype.UUID{Bytes: jti, Valid: true}, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(nil)
+
+		err := service.Logout(ctx, signedToken)
+
+		require.NoError(t, err)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Logout all sessions", func(t *testing.T) {
+		mockDriver := new(MockUserDriver)
+		service := user_service.NewUserService(mockDriver, keys, true)
+
+		userId := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+
+		mockDriver.On("RevokeUserSessions", ctx, userId, mock.AnythingOfType("time.Time")).Return(nil)
+
+		err := service.LogoutAll(ctx, userId)
+
+		require.NoError(t, err)
+		mockDriver.AssertExpectations(t)
+	})
+}
+
+func TestJwtKeySet(t *testing.T) {
+	rsaPrivateKey, rsaPublicKey := generateRsaKeyPem(t)
+	edPrivateKey, _ := generateEd25519KeyPem(t)
+
+	claims := user_model.JwtClaims{
+		UserId: uuid.New().String(),
+		Role:   "employee",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+
+	t.Run("Sign with RS256 key", func(t *testing.T) {
+		keys, err := user_model.NewJwtKeySet(user_model.JwtKeysConfig{
+			PrivateKeys: map[string][]byte{"rsa-1": rsaPrivateKey},
+		})
+		require.NoError(t, err)
+
+		signedToken, err := keys.Sign(claims)
+		require.NoError(t, err)
+
+		token, _, err := jwt.NewParser().ParseUnverified(signedToken, &user_model.JwtClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, "RS256", token.Method.Alg())
+		assert.Equal(t, "rsa-1", token.Header["kid"])
+
+		parsedClaims, err := keys.ValidateToken(signedToken)
+		require.NoError(t, err)
+		assert.Equal(t, claims.UserId, parsedClaims.UserId)
+	})
+
+	t.Run("Validate tokens during key rotation", func(t *testing.T) {
+		oldKeys, err := user_model.NewJwtKeySet(user_model.JwtKeysConfig{
+			PrivateKeys: map[string][]byte{"rsa-1": rsaPrivateKey},
+		})
+		require.NoError(t, err)
+		oldToken, err := oldKeys.Sign(claims)
+		require.NoError(t, err)
+
+		keys, err := user_model.NewJwtKeySet(user_model.JwtKeysConfig{
+			Secret:       []byte("test-secret-key"),
+			SigningKeyId: "ed-2",
+			PrivateKeys:  map[string][]byte{"ed-2": edPrivateKey},
+			PublicKeys:   map[string][]byte{"rsa-1": rsaPublicKey},
+		})
+		require.NoError(t, err)
+
+		newToken, err := keys.Sign(claims)
+		require.NoError(t, err)
+		token, _, err := jwt.NewParser().ParseUnverified(newToken, &user_model.JwtClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, "EdDSA", token.Method.Alg())
+		assert.Equal(t, "ed-2", token.Header["kid"])
+
+		for _, signedToken := range []string{oldToken, newToken, signTestToken(t, claims.UserId, claims.ID, time.Hour)} {
+			parsedClaims, err := keys.ValidateToken(signedToken)
+			require.NoError(t, err)
+			assert.Equal(t, claims.UserId, parsedClaims.UserId)
+		}
+	})
+
+	t.Run("Reject token with unknown kid", func(t *testing.T) {
+		otherKeys, err := user_model.NewJwtKeySet(user_model.JwtKeysConfig{
+			PrivateKeys: map[string][]byte{"ed-2": edPrivateKey},
+		})
+		require.NoError(t, err)
+		signedToken, err := otherKeys.Sign(claims)
+		require.NoError(t, err)
+
+		keys, err := user_model.NewJwtKeySet(user_model.JwtKeysConfig{
+			PrivateKeys: map[string][]byte{"rsa-1": rsaPrivateKey},
+		})
+		require.NoError(t, err)
+
+		_, err = keys.ValidateToken(signedToken)
+		assert.ErrorIs(t, err, custom_errors.ErrUnknownJwtKey)
+	})
+
+	t.Run("Reject HS256 token without secret", func(t *testing.T) {
+		keys, err := user_model.NewJwtKeySet(user_model.JwtKeysConfig{
+			PrivateKeys: map[string][]byte{"rsa-1": rsaPrivateKey},
+		})
+		require.NoError(t, err)
+
+		_, err = keys.ValidateToken(signTestToken(t, claims.UserId, claims.ID, time.Hour))
+		assert.ErrorIs(t, err, custom_errors.ErrSigningMethod)
+	})
+
+	t.Run("Reject invalid configuration", func(t *testing.T) {
+		_, err := user_model.NewJwtKeySet(user_model.JwtKeysConfig{})
+		assert.Equal(t, custom_errors.ErrJwtKeysNotConfigured, err)
+
+		_, err = user_model.NewJwtKeySet(user_model.JwtKeysConfig{
+			SigningKeyId: "rsa-1",
+			PublicKeys:   map[string][]byte{"rsa-1": rsaPublicKey},
+		})
+		assert.Equal(t, custom_errors.ErrUnknownJwtKey, err)
+
+		_, err = user_model.NewJwtKeySet(user_model.JwtKeysConfig{
+			PrivateKeys: map[string][]byte{"rsa-1": []byte("not a pem")},
+		})
+		assert.Equal(t, custom_errors.ErrParseJwtKey, err)
+	})
+}
+
+func TestGetJwks(t *testing.T) {
+	rsaPrivateKey, _ := generateRsaKeyPem(t)
+	_, edPublicKey := generateEd25519KeyPem(t)
+
+	keys, err := user_model.NewJwtKeySet(user_model.JwtKeysConfig{
+		Secret:      []byte("test-secret-key"),
+		PrivateKeys: map[string][]byte{"rsa-1": rsaPrivateKey},
+		PublicKeys:  map[string][]byte{"ed-0": edPublicKey},
+	})
+	require.NoError(t, err)
+
+	service := user_service.NewUserService(new(MockUserDriver), keys, true)
+
+	jwks := service.GetJwks()
+
+	require.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "ed-0", jwks.Keys[0].Kid)
+	assert.Equal(t, generated.OKP, jwks.Keys[0].Kty)
+	assert.Equal(t, generated.EdDSA, jwks.Keys[0].Alg)
+	assert.Equal(t, "Ed25519", *jwks.Keys[0].Crv)
+	assert.NotEmpty(t, *jwks.Keys[0].X)
+	assert.Equal(t, "rsa-1", jwks.Keys[1].Kid)
+	assert.Equal(t, generated.RSA, jwks.Keys[1].Kty)
+	assert.Equal(t, generated.RS256, jwks.Keys[1].Alg)
+	assert.Equal(t, "AQAB", *jwks.Keys[1].E)
+	assert.NotEmpty(t, *jwks.Keys[1].N)
+	assert.Equal(t, "sig", jwks.Keys[1].Use)
+}
+
+func generateRsaKeyPem(t *testing.T) ([]byte, []byte) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	privateBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	publicBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateBytes}),
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicBytes})
+}
+
+func generateEd25519KeyPem(t *testing.T) ([]byte, []byte) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	privateBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	publicBytes, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateBytes}),
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicBytes})
+}
+
+func signTestToken(t *testing.T, userID, jti string, expiresIn time.Duration) string {
+	claims := user_model.JwtClaims{
+		UserId: userID,
+		Email:  "test@example.com",
+		Role:   "employee",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresIn)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	signedToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test-secret-key"))
+	require.NoError(t, err)
+
+	return signedToken
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/generated"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/custom_errors"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/event_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/pvz_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/models/webhook_model"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/pvz_service"
+	"github.com/Dmitrii-Dmitrii/pvz/internal/services/webhook_service"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type MockWebhookDriver struct {
+	mock.Mock
+}
+
+func (m *MockWebhookDriver) CreateSubscription(ctx context.Context, subscription *webhook_model.Subscription) error {
+	args := m.Called(ctx, subscription)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDriver) GetSubscriptions(ctx context.Context) ([]webhook_model.Subscription, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]webhook_model.Subscription), args.Error(1)
+}
+
+func (m *MockWebhookDriver) DeleteSubscription(ctx context.Context, id pgtype.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDriver) GetDeliveries(ctx context.Context, subscriptionId pgtype.UUID, status *webhook_model.DeliveryStatus, limit int) ([]webhook_model.Delivery, error) {
+	args := m.Called(ctx, subscriptionId, status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]webhook_model.Delivery), args.Error(1)
+}
+
+func (m *MockWebhookDriver) ClaimPendingDeliveries(ctx context.Context, limit int, now, leaseUntil time.Time) ([]webhook_model.Delivery, error) {
+	args := m.Called(ctx, limit, now, leaseUntil)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]webhook_model.Delivery), args.Error(1)
+}
+
+func (m *MockWebhookDriver) MarkDelivered(ctx context.Context, id pgtype.UUID, deliveredAt time.Time) error {
+	args := m.Called(ctx, id, deliveredAt)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDriver) MarkFailed(ctx context.Context, delivery *webhook_model.Delivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDriver) ReplayDelivery(ctx context.Context, id pgtype.UUID, now time.Time) (*webhook_model.Delivery, error) {
+	args := m.Called(ctx, id, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*webhook_model.Delivery), args.Error(1)
+}
+
+type MockWebhookSenderDriver struct {
+	mock.Mock
+}
+
+func (m *MockWebhookSenderDriver) Send(ctx context.Context, delivery *webhook_model.Delivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func TestCreateWebhookSubscription(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Create subscription", func(t *testing.T) {
+		mockDriver := new(MockWebhookDriver)
+		mockPvzDriver := new(MockPvzDriver)
+		service := webhook_service.NewWebhookService(mockDriver, new(MockWebhookSenderDriver), pvz_service.NewPvzService(mockPvzDriver, newCityService(), newProductTypeService()))
+
+		pvzIdDto := uuid.New()
+		pvzId := pgtype.UUID{Bytes: pvzIdDto, Valid: true}
+		mockPvzDriver.On("GetPvzById", ctx, pvzId).Return(&pvz_model.Pvz{Id: pvzId}, nil)
+		mockDriver.On("CreateSubscription", ctx, mock.MatchedBy(func(s *webhook_model.Subscription) bool {
+			return s.Url == "https://partner.example.com/hooks" && s.Secret == "secret" && s.PvzId == pvzId &&
+				assert.ObjectsAreEqual([]event_model.EventType{event_model.ReceptionClosed}, s.EventTypes)
+		})).Return(nil)
+
+		subscription, err := service.CreateSubscription(ctx, generated.PostWebhooksJSONRequestBody{
+			Url:        "https://partner.example.com/hooks",
+			Secret:     "secret",
+			EventTypes: []generated.WebhookEventType{generated.ReceptionClosed, generated.ReceptionClosed},
+			PvzId:      &pvzIdDto,
+		})
+
+		require.NoError(t, err)
+		assert.NotNil(t, subscription.Id)
+		assert.Equal(t, pvzIdDto, *subscription.PvzId)
+		assert.Equal(t, []generated.WebhookEventType{generated.ReceptionClosed}, subscription.EventTypes)
+		mockDriver.AssertExpectations(t)
+	})
+
+	t.Run("Create subscription with invalid url", func(t *testing.T) {
+		mockDriver := new(MockWebhookDriver)
+		service := webhook_service.NewWebhookService(mockDriver, new(MockWebhookSenderDriver), nil)
+
+		subscription, err := service.CreateSubscription(ctx, generated.PostWebhooksJSONRequestBody{
+			Url:        "ftp://partner.example.com",
+			Secret:     "secret",
+			EventTypes: []generated.WebhookEventType{generated.ProductAdded},
+		})
+
+		assert.Nil(t, subscription)
+		assert.Equal(t, custom_errors.ErrWebhookUrl, err)
+		mockDriver.AssertNotCalled(t, "CreateSubscription", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Create subscription with empty secret", func(t *testing.T) {
+		service := webhook_service.NewWebhookService(new(MockWebhookDriver), new(MockWebhookSenderDriver), nil)
+
+		_, err := service.CreateSubscription(ctx, generated.PostWebhooksJSONRequestBody{
+			Url:        "https://partner.example.com/hooks",
+			EventTypes: []generated.WebhookEventType{generated.ProductAdded},
+		})
+
+		assert.Equal(t, custom_errors.ErrWebhookSecret, err)
+	})
+
+	t.Run("Create subscription with invalid event type", func(t *testing.T) {
+		service := webhook_service.NewWebhookService(new(MockWebhookDriver), new(MockWebhookSenderDriver), nil)
+
+		_, err := service.CreateSubscription(ctx, generated.PostWebhooksJSONRequestBody{
+			Url:        "https://partner.example.com/hooks",
+			Secret:     "secret",
+			EventTypes: []generated.WebhookEventType{"pvz_created"},
+		})
+
+		assert.Equal(t, custom_errors.ErrWebhookEventType, err)
+	})
+
+	t.Run("Create subscription for unknown pvz", func(t *testing.T) {
+		mockPvzDriver := new(MockPvzDriver)
+		service := webhook_service.NewWebhookService(new(MockWebhookDriver), new(MockWebhookSenderDriver), pvz_service.NewPvzService(mockPvzDriver, newCityService(), newProductTypeService()))
+
+		pvzIdDto := uuid.New()
+		mockPvzDriver.On("GetPvzById", ctx, pgtype.UUID{Bytes: pvzIdDto, Valid: true}).Return(nil, custom_errors.ErrPvzNotFound)
+
+		_, err := service.CreateSubscription(ctx, generated.PostWebhooksJSONRequestBody{
+			Url:        "https://partner.example.com/hooks",
+			Secret:     "secret",
+			EventTypes: []generated.WebhookEventType{generated.ProductAdded},
+			PvzId:      &pvzIdDto,
+		})
+
+		assert.Equal(t, custom_errors.ErrWebhookPvzNotFound, err)
+	})
+}
+
+func TestGetWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	mockDriver := new(MockWebhookDriver)
+	service := webhook_service.NewWebhookService(mockDriver, new(MockWebhookSenderDriver), nil)
+
+	subscriptionIdDto := uuid.New()
+	subscriptionId := pgtype.UUID{Bytes: subscriptionIdDto, Valid: true}
+	dead := webhook_model.Dead
+	lastError := "unexpected status code"
+	delivery := webhook_model.Delivery{
+		Id:             pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		SubscriptionId: subscriptionId,
+		EventId:        pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		EventType:      event_model.ReceptionClosed,
+		Payload:        json.RawMessage(`{"type":"reception_closed"}`),
+		Status:         webhook_model.Dead,
+		Attempts:       10,
+		LastError:      &lastError,
+	}
+
+	mockDriver.On("GetDeliveries", ctx, subscriptionId, &dead, mock.Anything).Return([]webhook_model.Delivery{delivery}, nil)
+
+	status := generated.GetWebhooksWebhookIdDeliveriesParamsStatusDead
+	deliveries, err := service.GetDeliveries(ctx, subscriptionIdDto, generated.GetWebhooksWebhookIdDeliveriesParams{Status: &status})
+
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, generated.WebhookDeliveryStatusDead, deliveries[0].Status)
+	assert.Equal(t, "reception_closed", deliveries[0].Payload["type"])
+	assert.Equal(t, &lastError, deliveries[0].LastError)
+	mockDriver.AssertExpectations(t)
+}
+
+func TestDeliverPendingWebhooks(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Deliver webhooks", func(t *testing.T) {
+		mockDriver := new(MockWebhookDriver)
+		mockSender := new(MockWebhookSenderDriver)
+		service := webhook_service.NewWebhookService(mockDriver, mockSender, nil)
+
+		delivery := webhook_model.Delivery{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Attempts: 1}
+
+		mockDriver.On("ClaimPendingDeliveries", ctx, mock.Anything, mock.Anything, mock.Anything).
+			Return([]webhook_model.Delivery{delivery}, nil)
+		mockSender.On("Send", ctx, mock.AnythingOfType("*webhook_model.Delivery")).Return(nil)
+		mockDriver.On("MarkDelivered", ctx, delivery.Id, mock.Anything).Return(nil)
+
+		err := service.DeliverPendingWebhooks(ctx)
+
+		assert.NoError(t, err)
+		mockDriver.AssertExpectations(t)
+		mockSender.AssertExpectations(t)
+	})
+
+	t.Run("Deliver webhooks with retry", func(t *testing.T) {
+		mockDriver := new(MockWebhookDriver)
+		mockSender := new(MockWebhookSenderDriver)
+		service := webhook_service.NewWebhookService(mockDriver, mockSender, nil)
+
+		delivery := webhook_model.Delivery{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Status: webhook_model.Pending, Attempts: 2}
+
+		mockDriver.On("ClaimPendingDeliveries", ctx, mock.Anything, mock.Anything, mock.Anything).
+			Return([]webhook_model.Delivery{delivery}, nil)
+		mockSender.On("Send", ctx, mock.AnythingOfType("*webhook_model.Delivery")).Return(custom_errors.ErrUnexpectedStatusCode)
+		mockDriver.On("MarkFailed", ctx, mock.MatchedBy(func(d *webhook_model.Delivery) bool {
+			delay := time.Until(d.NextAttemptAt)
+			return d.Status == webhook_model.Pending && d.LastError != nil && delay > time.Second && delay <= 2*time.Second
+		})).Return(nil)
+
+		err := service.DeliverPendingWebhooks(ctx)
+
+		assert.NoError(t, err)
+		mockDriver.AssertExpectations(t)
+		mockDriver.AssertNotCalled(t, "MarkDelivered", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Deliver webhooks to dead letter", func(t *testing.T) {
+		mockDriver := new(MockWebhookDriver)
+		mockSender := new(MockWebhookSenderDriver)
+		service := webhook_service.NewWebhookService(mockDriver, mockSender, nil)
+
+		delivery := webhook_model.Delivery{Id: pgtype.UUID{Bytes: uuid.New(), Valid: true}, Status: webhook_model.Pending, Attempts: 10}
+
+		mockDriver.On("ClaimPendingDeliveries", ctx, mock.Anything, mock.Anything, mock.Anything).
+			Return([]webhook_model.Delivery{delivery}, nil)
+		mockSender.On("Send", ctx, mock.AnythingOfType("*webhook_model.Delivery")).Return(custom_errors.ErrSendWebhook)
+		mockDriver.On("MarkFailed", ctx, mock.MatchedBy(func(d *webhook_model.Delivery) bool {
+			return d.Status == webhook_model.Dead
+		})).Return(nil)
+
+		err := service.DeliverPendingWebhooks(ctx)
+
+		assert.NoError(t, err)
+		mockDriver.AssertExpectations(t)
+	})
+}
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	ctx := context.Background()
+	idDto := uuid.New()
+	id := pgtype.UUID{Bytes: idDto, Valid: true}
+
+	t.Run("Replay delivery not in dead state", func(t *testing.T) {
+		mockDriver := new(MockWebhookDriver)
+		service := webhook_service.NewWebhookService(mockDriver, new(MockWebhookSenderDriver), nil)
+
+		mockDriver.On("ReplayDelivery", ctx, id, mock.Anything).Return(nil, custom_errors.ErrWebhookDeliveryDead)
+
+		delivery, err := service.ReplayDelivery(ctx, idDto)
+
+		assert.Nil(t, delivery)
+		assert.Equal(t, custom_errors.ErrWebhookDeliveryDead, err)
+	})
+
+	t.Run("Replay missing delivery", func(t *testing.T) {
+		mockDriver := new(MockWebhookDriver)
+		service := webhook_service.NewWebhookService(mockDriver, new(MockWebhookSenderDriver), nil)
+
+		mockDriver.On("ReplayDelivery", ctx, id, mock.Anything).Return(nil, custom_errors.ErrWebhookDeliveryNotFound)
+
+		delivery, err := service.ReplayDelivery(ctx, idDto)
+
+		assert.Nil(t, delivery)
+		assert.Equal(t, custom_errors.ErrWebhookDeliveryNotFound, err)
+	})
+}